@@ -0,0 +1,130 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hempflower/go-wayland/protocol"
+)
+
+// protocolFS holds the protocol xml files vendored in the protocols
+// directory, so that known protocols can be generated by name without
+// network access or a checkout of wayland-protocols.
+//
+//go:embed protocols
+var protocolFS embed.FS
+
+// catalogue maps the names of the vendored protocols, the file name
+// without the .xml extension, to their path in protocolFS
+func catalogue() (map[string]string, error) {
+	names := map[string]string{}
+	err := fs.WalkDir(protocolFS, "protocols", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != ".xml" {
+			return nil
+		}
+
+		names[strings.TrimSuffix(path.Base(p), ".xml")] = p
+		return nil
+	})
+
+	return names, err
+}
+
+// isCatalogueName reports whether file refers to a vendored protocol
+// rather than a local path
+func isCatalogueName(file string) bool {
+	return !strings.HasSuffix(file, ".xml") && !strings.ContainsAny(file, `/\`)
+}
+
+// openInput opens the protocol xml file, which is either the name of
+// a vendored protocol or a local path
+func openInput(file string) (io.ReadCloser, error) {
+	if strings.Contains(file, "://") {
+		return nil, fmt.Errorf("%s: remote protocol files are not supported, vendor the file or use a known protocol name (see -list)", file)
+	}
+
+	if !isCatalogueName(file) {
+		return os.Open(file)
+	}
+
+	names, err := catalogue()
+	if err != nil {
+		return nil, err
+	}
+	p, ok := names[file]
+	if !ok {
+		return nil, fmt.Errorf("unknown protocol %q (see -list)", file)
+	}
+
+	return protocolFS.Open(p)
+}
+
+// listCatalogue prints the names of the vendored protocols along with
+// the path they are vendored at
+func listCatalogue(w io.Writer) error {
+	names, err := catalogue()
+	if err != nil {
+		return err
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		fmt.Fprintf(w, "%s\t%s\n", name, names[name])
+	}
+
+	return nil
+}
+
+// indexCatalogue indexes the interfaces of the vendored protocols with
+// the packages they are generated to by protocols/protocols.json, so
+// that a protocol generated alone resolves the interfaces it references
+// to the bindings of this module.
+func indexCatalogue() error {
+	b, err := protocolFS.ReadFile("protocols/protocols.json")
+	if err != nil {
+		return err
+	}
+
+	var config Config
+	if err := json.Unmarshal(b, &config); err != nil {
+		return fmt.Errorf("unable to decode vendored protocols.json: %w", err)
+	}
+
+	for i := range config.Protocols {
+		pc := &config.Protocols[i]
+		if pc.Side == "" {
+			pc.Side = "client"
+		}
+
+		b, err := protocolFS.ReadFile(path.Join("protocols", pc.Input))
+		if err != nil {
+			return err
+		}
+		p, err := protocol.Parse(b)
+		if err != nil {
+			return fmt.Errorf("unable to decode vendored protocol %s: %w", pc.Input, err)
+		}
+		pc.parsed = p
+
+		if err := indexProtocol(pc); err != nil {
+			return fmt.Errorf("vendored protocols.json: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hempflower/go-wayland/protocol"
+)
+
+// Config lists protocols generated in a single run. All of them are
+// indexed before generating, so that interfaces referenced across
+// protocols resolve to the package declaring them.
+//
+//	{
+//		"protocols": [
+//			{
+//				"input": "protocols/wayland.xml",
+//				"package": "client",
+//				"import": "github.com/hempflower/go-wayland/wayland/client",
+//				"prefix": "wl_"
+//			},
+//			{
+//				"input": "protocols/xdg-shell.xml",
+//				"output": "../../wayland/stable/xdg-shell/xdg_shell.go",
+//				"package": "xdg_shell",
+//				"import": "github.com/hempflower/go-wayland/wayland/stable/xdg-shell",
+//				"prefix": "xdg_"
+//			}
+//		]
+//	}
+//
+// Relative paths are resolved from the directory of the config file.
+type Config struct {
+	Protocols []ProtocolConfig `json:"protocols"`
+}
+
+// ProtocolConfig describes a single protocol of a Config.
+type ProtocolConfig struct {
+	// Input is the local path of the protocol xml file, or the name of
+	// a vendored protocol
+	Input string `json:"input"`
+	// Output is the path of the generated go file, protocols without
+	// one are only used to resolve references
+	Output string `json:"output"`
+	// Package is the go package name
+	Package string `json:"package"`
+	// Import is the import path of the package
+	Import string `json:"import"`
+	// Prefix and Suffix are trimmed from interface names
+	Prefix string `json:"prefix"`
+	Suffix string `json:"suffix"`
+	// Side is client, the default, or server. Interfaces referenced by
+	// a protocol resolve to packages of the same side.
+	Side string `json:"side"`
+
+	parsed *protocol.Protocol
+}
+
+// interfaceIndex maps interface names of all protocols of the config
+// to the protocol declaring them on each side
+var interfaceIndex = map[indexKey]*ProtocolConfig{}
+
+type indexKey struct {
+	side  string
+	iface string
+}
+
+// currentImport is the import path of the package being generated
+var currentImport string
+
+func readConfig(file string) (Config, error) {
+	var config Config
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return config, fmt.Errorf("unable to read config: %w", err)
+	}
+
+	if err := json.Unmarshal(b, &config); err != nil {
+		return config, fmt.Errorf("unable to decode config %s: %w", file, err)
+	}
+
+	return config, nil
+}
+
+// configInputs returns the input of every protocol of the config,
+// resolved from the directory of the config file
+func configInputs(file string) ([]string, error) {
+	config, err := readConfig(file)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(file)
+	inputs := make([]string, len(config.Protocols))
+	for i, pc := range config.Protocols {
+		inputs[i] = resolvePath(dir, pc.Input)
+	}
+
+	return inputs, nil
+}
+
+func runConfig(file string) error {
+	config, err := readConfig(file)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(file)
+	for i := range config.Protocols {
+		pc := &config.Protocols[i]
+		if pc.Input == "" || pc.Package == "" || pc.Import == "" {
+			return fmt.Errorf("config %s: protocol %d: input, package and import are required", file, i)
+		}
+		if pc.Side == "" {
+			pc.Side = "client"
+		}
+		if _, ok := runtimeImports[pc.Side]; !ok {
+			return fmt.Errorf("config %s: protocol %d: invalid side %q, must be client or server", file, i, pc.Side)
+		}
+
+		p, err := readProtocol(resolvePath(dir, pc.Input))
+		if err != nil {
+			return err
+		}
+		pc.parsed = p
+
+		if err := indexProtocol(pc); err != nil {
+			return fmt.Errorf("config %s: %w", file, err)
+		}
+	}
+
+	for i := range config.Protocols {
+		pc := &config.Protocols[i]
+		if pc.Output == "" {
+			continue
+		}
+
+		currentProtocol = pc.parsed
+		packageName = pc.Package
+		prefix = pc.Prefix
+		suffix = pc.Suffix
+		currentImport = pc.Import
+		side = pc.Side
+
+		if err := loadTemplate(side, ""); err != nil {
+			return err
+		}
+
+		if err := generate(pc.Input, resolvePath(dir, pc.Output)); err != nil {
+			return fmt.Errorf("%s: %w", pc.Input, err)
+		}
+	}
+
+	return nil
+}
+
+// indexProtocol adds the interfaces of the parsed protocol of pc to
+// interfaceIndex
+func indexProtocol(pc *ProtocolConfig) error {
+	if pc.parsed.Name == "wayland" {
+		runtimeImports[pc.Side] = pc.Import
+	}
+	for _, v := range pc.parsed.Interfaces {
+		key := indexKey{pc.Side, v.Name}
+		if other, ok := interfaceIndex[key]; ok {
+			return fmt.Errorf("interface %s declared by both %s and %s", v.Name, other.Input, pc.Input)
+		}
+		interfaceIndex[key] = pc
+	}
+
+	return nil
+}
+
+// resolvePath returns path relative to dir, unless it is absolute or
+// the name of a vendored protocol
+func resolvePath(dir string, path string) string {
+	if isCatalogueName(path) || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestConfigCrossProtocol generates two protocols for both sides, one
+// referencing an interface of the other, which must resolve to the
+// package generated for the same side.
+func TestConfigCrossProtocol(t *testing.T) {
+	base, err := filepath.Abs("testdata/config/base.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ext, err := filepath.Abs("testdata/config/ext.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	config := filepath.Join(dir, "protocols.json")
+	b := fmt.Sprintf(`{"protocols": [
+		{"input": %[1]q, "output": "base/base.go", "package": "base", "import": "example.com/config/base", "prefix": "cb_"},
+		{"input": %[2]q, "output": "ext/ext.go", "package": "ext", "import": "example.com/config/ext", "prefix": "ce_"},
+		{"input": %[1]q, "output": "server/base/base.go", "package": "base", "import": "example.com/config/server/base", "prefix": "cb_", "side": "server"},
+		{"input": %[2]q, "output": "server/ext/ext.go", "package": "ext", "import": "example.com/config/server/ext", "prefix": "ce_", "side": "server"}
+	]}`, base, ext)
+	if err := os.WriteFile(config, []byte(b), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, stderr, code := runScanner(t, dir, "-config", config); code != 0 {
+		t.Fatalf("scanner exited with status %d: %s", code, stderr)
+	}
+
+	for _, tt := range []struct {
+		output string
+		want   []string
+	}{
+		{"ext/ext.go", []string{
+			"\t\"example.com/config/base\"\n",
+			"func (i *Manager) GetExtension(thing *base.Thing) (*Extension, error) {",
+			".(*base.Thing)",
+		}},
+		{"server/ext/ext.go", []string{
+			"\t\"example.com/config/server/base\"\n",
+			"GetExtension(r *Manager, id *Extension, thing *base.Thing)",
+			"func (r *Extension) SendReplaced(thing *base.Thing) error {",
+		}},
+	} {
+		b, err := os.ReadFile(filepath.Join(dir, tt.output))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(string(b), want) {
+				t.Errorf("%s doesn't contain %q", tt.output, want)
+			}
+		}
+	}
+}
+
+// TestConfigDuplicateInterface declares an interface twice on the same
+// side.
+func TestConfigDuplicateInterface(t *testing.T) {
+	base, err := filepath.Abs("testdata/config/base.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	config := filepath.Join(dir, "protocols.json")
+	b := fmt.Sprintf(`{"protocols": [
+		{"input": %[1]q, "output": "a/base.go", "package": "base", "import": "example.com/config/a"},
+		{"input": %[1]q, "output": "b/base.go", "package": "base", "import": "example.com/config/b"}
+	]}`, base)
+	if err := os.WriteFile(config, []byte(b), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, stderr, code := runScanner(t, dir, "-config", config)
+	if code == 0 {
+		t.Fatal("interface declared twice accepted")
+	}
+	if !strings.Contains(stderr, "interface cb_thing declared by both") {
+		t.Errorf("unexpected stderr: %s", stderr)
+	}
+}
+
+// TestVendoredVersions checks the versions listed in the readme of the
+// vendored protocols match the xml files, and that every input of the
+// config is listed
+func TestVendoredVersions(t *testing.T) {
+	readme, err := os.ReadFile("protocols/README.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := regexp.MustCompile("(?m)^\\| `([^`]+\\.xml)` \\| `([^`]+)` \\| ([0-9]+) \\|$").FindAllStringSubmatch(string(readme), -1)
+
+	listed := map[string]bool{}
+	for _, row := range rows {
+		file, global, version := row[1], row[2], row[3]
+		listed[file] = true
+
+		p, err := readProtocol(filepath.Join("protocols", file))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		found := false
+		for _, iface := range p.Interfaces {
+			if iface.Name == global {
+				found = true
+				if strconv.Itoa(iface.Version) != version {
+					t.Errorf("%s: %s is version %d, listed as %s", file, global, iface.Version, version)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s: listed global %s not found", file, global)
+		}
+	}
+
+	config, err := readConfig("protocols/protocols.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pc := range config.Protocols {
+		if !listed[pc.Input] {
+			t.Errorf("%s isn't listed in protocols/README.md", pc.Input)
+		}
+	}
+}
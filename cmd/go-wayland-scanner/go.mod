@@ -3,13 +3,16 @@ module github.com/hempflower/go-wayland/cmd/go-wayland-scanner
 go 1.19
 
 require (
+	github.com/hempflower/go-wayland/protocol v0.0.0-00010101000000-000000000000
 	github.com/iancoleman/strcase v0.2.0
+	golang.org/x/mod v0.7.0
 	golang.org/x/tools v0.5.0
 	mvdan.cc/gofumpt v0.4.0
 )
 
 require (
 	github.com/google/go-cmp v0.5.8 // indirect
-	golang.org/x/mod v0.7.0 // indirect
 	golang.org/x/sys v0.4.0 // indirect
 )
+
+replace github.com/hempflower/go-wayland/protocol => ../../protocol
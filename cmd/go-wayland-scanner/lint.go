@@ -0,0 +1,544 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hempflower/go-wayland/protocol"
+)
+
+// node is an element of a protocol xml file along with the line it
+// starts at. The linter walks nodes rather than protocol.Protocol to be
+// able to report positions, unknown elements or attributes and values
+// protocol.Parse would reject. References are resolved on the shared
+// model, see (*node).protocol.
+type node struct {
+	name     string
+	attrs    map[string]string
+	line     int
+	text     string
+	children []*node
+}
+
+func (n *node) attr(name string) (string, bool) {
+	v, ok := n.attrs[name]
+	return v, ok
+}
+
+func (n *node) elements(name string) []*node {
+	var nodes []*node
+	for _, c := range n.children {
+		if c.name == name {
+			nodes = append(nodes, c)
+		}
+	}
+
+	return nodes
+}
+
+// protocol converts the interfaces of a protocol node and their enums to
+// the shared model, which references are resolved against. Invalid
+// values, reported by the linter, are left zero.
+func (n *node) protocol() *protocol.Protocol {
+	p := &protocol.Protocol{Name: n.attrs["name"]}
+	for _, v := range n.elements("interface") {
+		version, _ := strconv.Atoi(v.attrs["version"])
+		iface := protocol.Interface{Name: v.attrs["name"], Version: version}
+		for _, e := range v.elements("enum") {
+			iface.Enums = append(iface.Enums, protocol.Enum{Name: e.attrs["name"], Bitfield: e.attrs["bitfield"] == "true"})
+		}
+		p.Interfaces = append(p.Interfaces, iface)
+	}
+
+	return p
+}
+
+// parseNodes decodes a protocol xml file into its root node
+func parseNodes(r io.Reader) (*node, error) {
+	d := xml.NewDecoder(r)
+
+	var root *node
+	var stack []*node
+	for {
+		line, _ := d.InputPos()
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			n := &node{name: t.Name.Local, attrs: map[string]string{}, line: line}
+			for _, a := range t.Attr {
+				n.attrs[a.Name.Local] = a.Value
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, n)
+			} else {
+				root = n
+			}
+			stack = append(stack, n)
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("no protocol element")
+	}
+
+	return root, nil
+}
+
+// element describes the content allowed for an element by wayland.dtd
+type element struct {
+	// children maps the allowed child elements to whether more than
+	// one of them is allowed
+	children map[string]bool
+	attrs    []string
+	required []string
+	text     bool
+}
+
+var dtd = map[string]element{
+	"protocol": {
+		children: map[string]bool{"copyright": false, "description": false, "interface": true},
+		attrs:    []string{"name"},
+		required: []string{"name"},
+	},
+	"copyright": {text: true},
+	"interface": {
+		children: map[string]bool{"description": false, "request": true, "event": true, "enum": true},
+		attrs:    []string{"name", "version"},
+		required: []string{"name", "version"},
+	},
+	"request": {
+		children: map[string]bool{"description": false, "arg": true},
+		attrs:    []string{"name", "type", "since", "deprecated-since"},
+		required: []string{"name"},
+	},
+	"event": {
+		children: map[string]bool{"description": false, "arg": true},
+		attrs:    []string{"name", "type", "since", "deprecated-since"},
+		required: []string{"name"},
+	},
+	"enum": {
+		children: map[string]bool{"description": false, "entry": true},
+		attrs:    []string{"name", "since", "bitfield"},
+		required: []string{"name"},
+	},
+	"entry": {
+		children: map[string]bool{"description": false},
+		attrs:    []string{"name", "value", "summary", "since", "deprecated-since"},
+		required: []string{"name", "value"},
+	},
+	"arg": {
+		children: map[string]bool{"description": false},
+		attrs:    []string{"name", "type", "summary", "interface", "allow-null", "enum"},
+		required: []string{"name", "type"},
+	},
+	"description": {
+		attrs: []string{"summary"},
+		text:  true,
+	},
+}
+
+var (
+	identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	entryNameRegexp  = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+)
+
+var argTypes = map[string]bool{
+	"int": true, "uint": true, "fixed": true, "string": true,
+	"object": true, "new_id": true, "array": true, "fd": true,
+}
+
+// diagnostic is a problem found by the linter
+type diagnostic struct {
+	line int
+	msg  string
+}
+
+// linter collects the diagnostics of a protocol file
+type linter struct {
+	diags []diagnostic
+	// interfaces indexes the interfaces of the linted protocols and
+	// of the vendored ones, to check references across protocols
+	interfaces map[string]*protocol.Interface
+}
+
+func (l *linter) errorf(n *node, format string, args ...interface{}) {
+	l.diags = append(l.diags, diagnostic{line: n.line, msg: fmt.Sprintf(format, args...)})
+}
+
+// lintProtocols lints the given protocol files and returns the
+// diagnostics of all of them, in file:line: message form
+func lintProtocols(files []string) ([]string, error) {
+	interfaces := map[string]*protocol.Interface{}
+	index := func(p *protocol.Protocol) {
+		for i := range p.Interfaces {
+			interfaces[p.Interfaces[i].Name] = &p.Interfaces[i]
+		}
+	}
+
+	// Vendored protocols are only used to resolve references
+	names, err := catalogue()
+	if err != nil {
+		return nil, err
+	}
+	for name := range names {
+		p, err := readProtocol(name)
+		if err != nil {
+			return nil, err
+		}
+		index(p)
+	}
+
+	roots := make([]*node, len(files))
+	for i, file := range files {
+		root, err := readNodes(file)
+		if err != nil {
+			return nil, err
+		}
+		roots[i] = root
+		index(root.protocol())
+	}
+
+	var diags []string
+	for i, file := range files {
+		l := &linter{interfaces: interfaces}
+		l.lintElement(roots[i])
+		l.lintProtocol(roots[i])
+
+		sort.SliceStable(l.diags, func(i, j int) bool { return l.diags[i].line < l.diags[j].line })
+		for _, d := range l.diags {
+			diags = append(diags, fmt.Sprintf("%s:%d: %s", file, d.line, d.msg))
+		}
+	}
+
+	return diags, nil
+}
+
+func readNodes(file string) (*node, error) {
+	src, err := openInput(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get input file: %w", err)
+	}
+	defer src.Close()
+
+	root, err := parseNodes(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode protocol xml %s: %w", file, err)
+	}
+
+	return root, nil
+}
+
+// lintElement checks n and its children against wayland.dtd
+func (l *linter) lintElement(n *node) {
+	e, ok := dtd[n.name]
+	if !ok {
+		l.errorf(n, "unknown element <%s>", n.name)
+		return
+	}
+
+	attrs := make([]string, 0, len(n.attrs))
+	for name := range n.attrs {
+		attrs = append(attrs, name)
+	}
+	sort.Strings(attrs)
+	for _, name := range attrs {
+		if !contains(e.attrs, name) {
+			l.errorf(n, "unknown attribute %q on <%s>", name, n.name)
+		}
+	}
+	for _, name := range e.required {
+		if _, ok := n.attrs[name]; !ok {
+			l.errorf(n, "<%s> is missing required attribute %q", n.name, name)
+		}
+	}
+
+	if !e.text && strings.TrimSpace(n.text) != "" {
+		l.errorf(n, "unexpected text in <%s>", n.name)
+	}
+
+	counts := map[string]int{}
+	for _, c := range n.children {
+		multiple, ok := e.children[c.name]
+		if !ok {
+			l.errorf(c, "element <%s> is not allowed in <%s>", c.name, n.name)
+			continue
+		}
+		counts[c.name]++
+		if !multiple && counts[c.name] == 2 {
+			l.errorf(c, "<%s> allows a single <%s>", n.name, c.name)
+		}
+		l.lintElement(c)
+	}
+
+	if n.name == "protocol" && counts["interface"] == 0 {
+		l.errorf(n, "protocol has no interface")
+	}
+}
+
+// lintProtocol checks the semantic constraints the generator relies on
+func (l *linter) lintProtocol(root *node) {
+	if name, ok := root.attr("name"); ok && !identifierRegexp.MatchString(name) {
+		l.errorf(root, "invalid protocol name %q", name)
+	}
+
+	seen := map[string]bool{}
+	for _, v := range root.elements("interface") {
+		name := v.attrs["name"]
+		if seen[name] {
+			l.errorf(v, "duplicate interface %s", name)
+		}
+		seen[name] = true
+		l.lintInterface(v)
+	}
+}
+
+func (l *linter) lintInterface(v *node) {
+	ifaceName := v.attrs["name"]
+	if _, ok := v.attr("name"); ok && !identifierRegexp.MatchString(ifaceName) {
+		l.errorf(v, "invalid interface name %q", ifaceName)
+	}
+
+	version := 1
+	if s, ok := v.attr("version"); ok {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 {
+			l.errorf(v, "interface %s: version must be a positive integer, got %q", ifaceName, s)
+		} else {
+			version = n
+		}
+	}
+
+	for _, kind := range []string{"request", "event"} {
+		names := map[string]bool{}
+		lastSince := 1
+		for _, m := range v.elements(kind) {
+			name := m.attrs["name"]
+			if !identifierRegexp.MatchString(name) {
+				l.errorf(m, "%s.%s: invalid %s name", ifaceName, name, kind)
+			}
+			if names[name] {
+				l.errorf(m, "%s.%s: duplicate %s", ifaceName, name, kind)
+			}
+			names[name] = true
+
+			since := l.since(m, ifaceName+"."+name, version)
+			if since < lastSince {
+				l.errorf(m, "%s.%s: since %d is lower than the since %d of a previous %s, opcodes must be added in version order", ifaceName, name, since, lastSince, kind)
+			} else {
+				lastSince = since
+			}
+
+			if t, ok := m.attr("type"); ok && t != "destructor" {
+				l.errorf(m, "%s.%s: invalid type %q, only \"destructor\" is allowed", ifaceName, name, t)
+			}
+			if kind == "request" && name == "destroy" && m.attrs["type"] != "destructor" {
+				l.errorf(m, "%s.destroy: request named destroy must be a destructor, it conflicts with the generated Destroy method", ifaceName)
+			}
+
+			l.lintArgs(m, ifaceName, kind)
+		}
+	}
+
+	enumNames := map[string]bool{}
+	for _, e := range v.elements("enum") {
+		name := e.attrs["name"]
+		if !identifierRegexp.MatchString(name) {
+			l.errorf(e, "%s.%s: invalid enum name", ifaceName, name)
+		}
+		if enumNames[name] {
+			l.errorf(e, "%s.%s: duplicate enum", ifaceName, name)
+		}
+		enumNames[name] = true
+
+		l.lintEnum(e, ifaceName, version)
+	}
+}
+
+// since returns the since attribute of n, checking that it is within
+// the version of the interface
+func (l *linter) since(n *node, what string, version int) int {
+	s, ok := n.attr("since")
+	if !ok {
+		return 1
+	}
+
+	since, err := strconv.Atoi(s)
+	if err != nil || since < 1 {
+		l.errorf(n, "%s: since must be a positive integer, got %q", what, s)
+		return 1
+	}
+	if since > version {
+		l.errorf(n, "%s: since %d is greater than the interface version %d", what, since, version)
+	}
+
+	return since
+}
+
+func (l *linter) lintArgs(m *node, ifaceName string, kind string) {
+	what := ifaceName + "." + m.attrs["name"]
+	isDestructor := m.attrs["type"] == "destructor"
+
+	names := map[string]bool{}
+	newIDs := 0
+	for _, a := range m.elements("arg") {
+		name := a.attrs["name"]
+		argType := a.attrs["type"]
+		if !identifierRegexp.MatchString(name) {
+			l.errorf(a, "%s: invalid arg name %q", what, name)
+		}
+		if names[name] {
+			l.errorf(a, "%s: duplicate arg %s", what, name)
+		}
+		names[name] = true
+
+		if _, ok := a.attr("type"); ok && !argTypes[argType] {
+			l.errorf(a, "%s: arg %s has invalid type %q", what, name, argType)
+		}
+
+		if iface, ok := a.attr("interface"); ok {
+			if argType != "object" && argType != "new_id" {
+				l.errorf(a, "%s: arg %s of type %s cannot have an interface", what, name, argType)
+			} else if _, ok := l.interfaces[iface]; !ok {
+				l.errorf(a, "%s: arg %s references unknown interface %s", what, name, iface)
+			}
+		}
+
+		if v, ok := a.attr("allow-null"); ok {
+			if v != "true" && v != "false" {
+				l.errorf(a, "%s: arg %s: allow-null must be true or false, got %q", what, name, v)
+			} else if v == "true" && argType != "object" && argType != "string" {
+				l.errorf(a, "%s: arg %s: allow-null is only valid for object and string args", what, name)
+			}
+		}
+
+		if argType == "new_id" {
+			newIDs++
+			if _, ok := a.attr("interface"); !ok && kind == "event" {
+				l.errorf(a, "%s: new_id arg %s of an event must have an interface", what, name)
+			}
+			if isDestructor {
+				l.errorf(a, "%s: destructor cannot create objects", what)
+			}
+		}
+
+		if enum, ok := a.attr("enum"); ok {
+			l.lintEnumRef(a, what, ifaceName, enum)
+		}
+	}
+
+	if newIDs > 1 {
+		l.errorf(m, "%s: %s has more than one new_id arg", what, kind)
+	}
+}
+
+// lintEnumRef checks that the enum referenced by arg exists and that
+// the arg type can hold it
+func (l *linter) lintEnumRef(arg *node, what string, ifaceName string, enum string) {
+	argType := arg.attrs["type"]
+	if argType != "int" && argType != "uint" {
+		l.errorf(arg, "%s: arg %s of type %s cannot reference enum %s", what, arg.attrs["name"], argType, enum)
+		return
+	}
+
+	if owner, name := protocol.SplitEnum(enum); owner != "" {
+		ifaceName, enum = owner, name
+	}
+	v, ok := l.interfaces[ifaceName]
+	if !ok {
+		l.errorf(arg, "%s: arg %s references enum of unknown interface %s", what, arg.attrs["name"], ifaceName)
+		return
+	}
+
+	e := v.Enum(enum)
+	if e == nil {
+		l.errorf(arg, "%s: arg %s references unknown enum %s.%s", what, arg.attrs["name"], ifaceName, enum)
+		return
+	}
+	if e.Bitfield && argType != "uint" {
+		l.errorf(arg, "%s: arg %s references bitfield %s.%s and must be an uint", what, arg.attrs["name"], ifaceName, enum)
+	}
+}
+
+func (l *linter) lintEnum(e *node, ifaceName string, version int) {
+	what := ifaceName + "." + e.attrs["name"]
+	l.since(e, what, version)
+
+	bitfield := false
+	if v, ok := e.attr("bitfield"); ok {
+		if v != "true" && v != "false" {
+			l.errorf(e, "%s: bitfield must be true or false, got %q", what, v)
+		}
+		bitfield = v == "true"
+	}
+
+	names := map[string]bool{}
+	var values []uint32
+	for _, entry := range e.elements("entry") {
+		name := entry.attrs["name"]
+		if !entryNameRegexp.MatchString(name) {
+			l.errorf(entry, "%s: invalid entry name %q", what, name)
+		}
+		if names[name] {
+			l.errorf(entry, "%s: duplicate entry %s", what, name)
+		}
+		names[name] = true
+
+		l.since(entry, what+"."+name, version)
+
+		s, ok := entry.attr("value")
+		if !ok {
+			continue
+		}
+		value, err := (&protocol.Entry{Value: s}).Uint()
+		if err != nil {
+			l.errorf(entry, "%s.%s: value %q is not an unsigned 32-bit integer", what, name, s)
+			continue
+		}
+
+		// Bitfield entries are flags, or combinations of the
+		// flags declared before them
+		if bitfield && value&(value-1) != 0 {
+			var combined uint32
+			for _, v := range values {
+				if value&v == v {
+					combined |= v
+				}
+			}
+			if combined != value {
+				l.errorf(entry, "%s.%s: bitfield value %s is neither a single bit nor a combination of previous entries", what, name, s)
+			}
+		}
+		values = append(values, value)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLintProtocols(t *testing.T) {
+	diags, err := lintProtocols([]string{"testdata/lint/invalid.xml", "testdata/lint/valid.xml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"testdata/lint/invalid.xml:5: lint_invalid_thing.frob: since 3 is greater than the interface version 2",
+		"testdata/lint/invalid.xml:6: lint_invalid_thing.frob: arg target references unknown interface lint_missing",
+		"testdata/lint/invalid.xml:8: lint_invalid_thing.frob: duplicate request",
+		"testdata/lint/invalid.xml:8: lint_invalid_thing.frob: since 1 is lower than the since 3 of a previous request, opcodes must be added in version order",
+		`testdata/lint/invalid.xml:9: unknown attribute "colour" on <event>`,
+		"testdata/lint/invalid.xml:10: lint_invalid_thing.state: arg value of type string cannot reference enum mode",
+		"testdata/lint/invalid.xml:14: lint_invalid_thing.mode.b: bitfield value 6 is neither a single bit nor a combination of previous entries",
+		`testdata/lint/invalid.xml:17: <interface> is missing required attribute "version"`,
+		"testdata/lint/invalid.xml:18: element <frob> is not allowed in <interface>",
+		"testdata/lint/invalid.xml:22: lint_invalid_released.destroy: request named destroy must be a destructor, it conflicts with the generated Destroy method",
+	}
+	if !reflect.DeepEqual(diags, want) {
+		t.Errorf("got diagnostics\n%q\nwant\n%q", diags, want)
+	}
+}
+
+func TestLintMalformed(t *testing.T) {
+	if _, err := lintProtocols([]string{"testdata/lint/malformed.xml"}); err == nil {
+		t.Error("malformed xml linted without error")
+	}
+}
+
+func TestRunLint(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		code  int
+	}{
+		{"testdata/lint/valid.xml", 0},
+		{"testdata/lint/invalid.xml", 1},
+		{"testdata/lint/malformed.xml", 2},
+		{"testdata/lint/missing.xml", 2},
+	} {
+		t.Run(tt.input, func(t *testing.T) {
+			setFlag(t, &inputFile, tt.input)
+			if code := runLint(); code != tt.code {
+				t.Errorf("got exit code %d, want %d", code, tt.code)
+			}
+		})
+	}
+}
+
+// setFlag sets a flag variable for the duration of the test
+func setFlag[T any](t *testing.T, p *T, v T) {
+	old := *p
+	*p = v
+	t.Cleanup(func() { *p = old })
+}
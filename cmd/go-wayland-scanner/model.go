@@ -0,0 +1,388 @@
+package main
+
+import (
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+
+	"github.com/hempflower/go-wayland/protocol"
+)
+
+// The types below are the data model passed to templates. A template is
+// executed with a *File, every other value is reachable from it. XML
+// names are kept as is, names prefixed with Go are the identifiers of the
+// generated client code, resolved with the prefix, suffix and package of
+// each protocol.
+
+// File is the root of the template data, describing a single protocol.
+type File struct {
+	// Protocol is the name of the protocol, e.g. "xdg_shell"
+	Protocol string
+	// Copyright is the raw text of the copyright element
+	Copyright string
+	// Package is the Go package name
+	Package string
+	// Input is the protocol file as given to the scanner
+	Input string
+	// Checksum is the sha256 of the xml file
+	Checksum string
+	// ScannerVersion is the version of the scanner
+	ScannerVersion string
+	// Side is client or server
+	Side string
+	// Core reports whether the protocol is the core wayland protocol,
+	// which is generated in the runtime package of the side itself
+	Core bool
+	// Runtime is the qualifier of identifiers of the runtime package,
+	// "client." or "server.", empty for the core protocol
+	Runtime string
+	// Imports are the packages referenced by the resolved Go types,
+	// sorted by path
+	Imports    []*Import
+	Interfaces []*InterfaceData
+}
+
+// Import is a package imported by the generated code.
+type Import struct {
+	Path string
+	Name string
+}
+
+// Aliased reports whether the package name differs from the last
+// element of the import path
+func (i *Import) Aliased() bool {
+	return path.Base(i.Path) != i.Name
+}
+
+// InterfaceData is an interface of the protocol.
+type InterfaceData struct {
+	File *File
+
+	Name string
+	// GoName is the name of the proxy type
+	GoName string
+	// GoVar is the name of a local variable holding the proxy
+	GoVar       string
+	Version     int
+	Summary     string
+	Description string
+	Requests    []*Message
+	Events      []*Message
+	Enums       []*EnumData
+	// HasDestructor reports whether a request is a destructor, proxies
+	// without one get a Destroy method unregistering them
+	HasDestructor bool
+}
+
+// Message is a request or an event of an interface.
+type Message struct {
+	Interface *InterfaceData
+
+	Name string
+	// GoName is the name of the request method, or is used in the
+	// names of the event types
+	GoName string
+	// GoVar is the name of the event handler field
+	GoVar  string
+	Opcode int
+	// Since is the version that introduced the message, at least 1
+	Since       int
+	Destructor  bool
+	Summary     string
+	Description string
+	Args        []*ArgData
+}
+
+// ArgData is an argument of a message.
+type ArgData struct {
+	Name string
+	// GoName is the name of the event field
+	GoName string
+	// GoVar is the lower camel case name of the argument, Param the
+	// identifier of the parameter
+	GoVar string
+	// Type is the wire type: int, uint, fixed, string, object, new_id,
+	// array or fd
+	Type string
+	// GoType is the resolved Go type, e.g. *client.Surface for objects or
+	// the enum type for int and uint arguments referencing an enum
+	GoType string
+	// Interface is the interface of object and new_id arguments, empty
+	// if it is not fixed by the protocol
+	Interface string
+	// GoInterface is the qualified name of the proxy type of Interface
+	// and GoConstructor the qualified name of its constructor
+	GoInterface   string
+	GoConstructor string
+	Enum          string
+	AllowNull     bool
+	// Summary is the summary attribute of the argument, Description and
+	// DescriptionSummary come from its optional description element
+	Summary            string
+	Description        string
+	DescriptionSummary string
+}
+
+// Param returns GoVar as an identifier usable for parameters and local
+// variables, arguments named after Go keywords or predeclared
+// identifiers get a trailing underscore
+func (a *ArgData) Param() string {
+	if token.IsKeyword(a.GoVar) || types.Universe.Lookup(a.GoVar) != nil {
+		return a.GoVar + "_"
+	}
+
+	return a.GoVar
+}
+
+// EnumData is an enum of an interface.
+type EnumData struct {
+	Name string
+	// GoName is the name of the enum type
+	GoName      string
+	Since       int
+	Bitfield    bool
+	Summary     string
+	Description string
+	Entries     []*EntryData
+	// Zero is the name of the entry with no flag set of a bitfield, or
+	// "0" if there is none
+	Zero string
+}
+
+// EntryData is an entry of an enum.
+type EntryData struct {
+	Name string
+	// GoName is the name of the constant
+	GoName      string
+	Value       string
+	Since       int
+	Summary     string
+	Description string
+	// Flag reports whether the value is a single bit
+	Flag bool
+}
+
+// Flags returns the entries of a bitfield that represent a single flag
+func (e *EnumData) Flags() []*EntryData {
+	var flags []*EntryData
+	for _, entry := range e.Entries {
+		if entry.Flag {
+			flags = append(flags, entry)
+		}
+	}
+
+	return flags
+}
+
+// HasFd reports whether the message carries a file descriptor
+func (m *Message) HasFd() bool {
+	return m.FdArg() != nil
+}
+
+// FdArg returns the file descriptor argument of the message, if any
+func (m *Message) FdArg() *ArgData {
+	for _, arg := range m.Args {
+		if arg.Type == "fd" {
+			return arg
+		}
+	}
+
+	return nil
+}
+
+// HasData reports whether the message has arguments encoded in the
+// message body, file descriptors are passed out of band
+func (m *Message) HasData() bool {
+	for _, arg := range m.Args {
+		if arg.Type != "fd" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FixedSize reports whether the size of the message is known at
+// compile time
+func (m *Message) FixedSize() bool {
+	for _, arg := range m.Args {
+		if arg.Type == "string" || arg.Type == "array" || (arg.Type == "new_id" && arg.Interface == "") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewObjects returns the new_id arguments of fixed interface, which
+// requests create and return
+func (m *Message) NewObjects() []*ArgData {
+	var args []*ArgData
+	for _, arg := range m.Args {
+		if arg.Type == "new_id" && arg.Interface != "" {
+			args = append(args, arg)
+		}
+	}
+
+	return args
+}
+
+// newFile builds the template data of the current protocol. Resolving
+// the Go types records the imports they need in usedImports.
+func newFile(inputFile string) *File {
+	usedImports = map[string]string{}
+
+	f := &File{
+		Protocol:       currentProtocol.Name,
+		Copyright:      currentProtocol.Copyright,
+		Package:        packageName,
+		Input:          inputFile,
+		Checksum:       currentProtocol.Checksum,
+		ScannerVersion: version,
+		Side:           side,
+		Core:           currentProtocol.Name == "wayland",
+	}
+	if !f.Core {
+		f.Runtime = side + "."
+		usedImports[runtimeImports[side]] = side
+	}
+
+	for _, v := range currentProtocol.Interfaces {
+		f.Interfaces = append(f.Interfaces, newInterfaceData(f, v))
+	}
+
+	for _, v := range f.Interfaces {
+		for _, m := range v.Requests {
+			if m.HasFd() {
+				usedImports[unixImport] = "unix"
+			}
+		}
+		for _, m := range v.Events {
+			if m.HasFd() {
+				usedImports[unixImport] = "unix"
+			}
+		}
+		for _, e := range v.Enums {
+			if e.Bitfield {
+				usedImports["strconv"] = "strconv"
+				usedImports["strings"] = "strings"
+			}
+		}
+	}
+
+	for importPath, name := range usedImports {
+		f.Imports = append(f.Imports, &Import{Path: importPath, Name: name})
+	}
+	sort.Slice(f.Imports, func(i, j int) bool {
+		return f.Imports[i].Path < f.Imports[j].Path
+	})
+
+	return f
+}
+
+func newInterfaceData(f *File, v protocol.Interface) *InterfaceData {
+	iface := &InterfaceData{
+		File:          f,
+		Name:          v.Name,
+		GoName:        toCamel(v.Name),
+		GoVar:         toLowerCamel(v.Name),
+		Version:       protocol.Version(v.Version),
+		Summary:       v.Description.Summary,
+		Description:   v.Description.Text,
+		HasDestructor: v.HasDestructor(),
+	}
+
+	for i, r := range v.Requests {
+		iface.Requests = append(iface.Requests, newMessage(iface, i, r))
+	}
+	for i, e := range v.Events {
+		iface.Events = append(iface.Events, newMessage(iface, i, protocol.Request(e)))
+	}
+
+	for _, e := range v.Enums {
+		enum := &EnumData{
+			Name:        e.Name,
+			GoName:      iface.GoName + toCamel(e.Name),
+			Since:       protocol.Version(e.Since),
+			Bitfield:    e.Bitfield,
+			Summary:     e.Description.Summary,
+			Description: e.Description.Text,
+			Zero:        "0",
+		}
+		if zero := e.Zero(); zero != nil {
+			enum.Zero = zero.Name
+		}
+		for _, entry := range e.Entries {
+			v, err := entry.Uint()
+			enum.Entries = append(enum.Entries, &EntryData{
+				Name:        entry.Name,
+				GoName:      enum.GoName + toCamel(entry.Name),
+				Value:       entry.Value,
+				Since:       protocol.Version(entry.Since),
+				Summary:     entry.Summary,
+				Description: entry.Description.Text,
+				Flag:        err == nil && v != 0 && v&(v-1) == 0,
+			})
+		}
+		iface.Enums = append(iface.Enums, enum)
+	}
+
+	return iface
+}
+
+func newMessage(iface *InterfaceData, opcode int, r protocol.Request) *Message {
+	m := &Message{
+		Interface:   iface,
+		Name:        r.Name,
+		GoName:      toCamel(r.Name),
+		GoVar:       toLowerCamel(r.Name),
+		Opcode:      opcode,
+		Since:       protocol.Version(r.Since),
+		Destructor:  r.IsDestructor(),
+		Summary:     r.Description.Summary,
+		Description: r.Description.Text,
+	}
+
+	for _, arg := range r.Args {
+		a := &ArgData{
+			Name:               arg.Name,
+			GoName:             toCamel(arg.Name),
+			GoVar:              toLowerCamel(arg.Name),
+			Type:               arg.Type,
+			Interface:          arg.Interface,
+			Enum:               arg.Enum,
+			AllowNull:          arg.AllowNull,
+			Summary:            arg.Summary,
+			Description:        arg.Description.Text,
+			DescriptionSummary: arg.Description.Summary,
+		}
+
+		switch arg.Type {
+		case "object", "new_id":
+			if arg.Interface != "" {
+				a.GoInterface = goIfaceName(arg.Interface)
+				a.GoConstructor = goIfaceConstructor(arg.Interface)
+				a.GoType = "*" + a.GoInterface
+			} else if iface.File.Side == "server" {
+				a.GoType = iface.File.Runtime + "Resource"
+			} else {
+				a.GoType = iface.File.Runtime + "Proxy"
+			}
+
+		case "int", "uint":
+			if arg.Enum != "" {
+				a.GoType = goEnumName(iface.Name, arg.Enum)
+			} else {
+				a.GoType = typeToGoTypeMap[arg.Type]
+			}
+
+		default:
+			a.GoType = typeToGoTypeMap[arg.Type]
+		}
+
+		m.Args = append(m.Args, a)
+	}
+
+	return m
+}
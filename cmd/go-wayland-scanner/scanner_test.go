@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// TestMain runs the scanner instead of the tests when the test binary
+// is started by runScanner
+func TestMain(m *testing.M) {
+	if os.Getenv("GO_WAYLAND_SCANNER_TEST_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runScanner runs the scanner with args in dir and returns its output
+// and exit code
+func runScanner(t *testing.T, dir string, args ...string) (stdout string, stderr string, code int) {
+	t.Helper()
+
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GO_WAYLAND_SCANNER_TEST_MAIN=1")
+	var outBuf, errBuf bytes.Buffer
+	cmd.Stdout = &outBuf
+	cmd.Stderr = &errBuf
+
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		code = exitErr.ExitCode()
+	} else if err != nil {
+		t.Fatal(err)
+	}
+
+	return outBuf.String(), errBuf.String(), code
+}
+
+func TestDiffNames(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	outside := filepath.Join(filepath.Dir(wd), "other", "out.go")
+
+	for _, tt := range []struct {
+		file     string
+		old, new string
+	}{
+		{"out.go", "a/out.go", "b/out.go"},
+		{"./gen/out.go", "a/gen/out.go", "b/gen/out.go"},
+		{filepath.Join(wd, "gen", "out.go"), "a/gen/out.go", "b/gen/out.go"},
+		{outside, filepath.ToSlash(outside), filepath.ToSlash(outside)},
+	} {
+		if old, new := diffNames(tt.file); old != tt.old || new != tt.new {
+			t.Errorf("diffNames(%q) = %q, %q, want %q, %q", tt.file, old, new, tt.old, tt.new)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	input, err := filepath.Abs("testdata/check.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	output := filepath.Join(dir, "check.go")
+
+	if _, stderr, code := runScanner(t, dir, "-i", input, "-pkg", "check", "-o", "check.go"); code != 0 {
+		t.Fatalf("generate exited with status %d: %s", code, stderr)
+	}
+
+	// Up to date
+	if stdout, stderr, code := runScanner(t, dir, "-check", "-i", input, "-pkg", "check", "-o", "check.go"); code != 0 || stdout != "" {
+		t.Fatalf("check of an up to date file exited with status %d: %s%s", code, stdout, stderr)
+	}
+
+	b, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale := bytes.Replace(b, []byte("package check"), []byte("package stale"), 1)
+	if err := os.WriteFile(output, stale, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		dir    string
+		output string
+		header string
+	}{
+		{"relative", dir, "check.go", "--- a/check.go\n+++ b/check.go\n"},
+		{"absolute", dir, output, "--- a/check.go\n+++ b/check.go\n"},
+		{"outside", t.TempDir(), output, "--- " + filepath.ToSlash(output) + "\n+++ " + filepath.ToSlash(output) + "\n"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, stderr, code := runScanner(t, tt.dir, "-check", "-i", input, "-pkg", "check", "-o", tt.output)
+			if code != 1 {
+				t.Errorf("check of a stale file exited with status %d", code)
+			}
+			if !strings.HasPrefix(stdout, tt.header) {
+				t.Errorf("diff doesn't start with\n%s\ngot\n%s", tt.header, stdout)
+			}
+			if !strings.Contains(stdout, "\n-package stale\n+package check\n") {
+				t.Errorf("diff doesn't revert the package clause:\n%s", stdout)
+			}
+			if !strings.Contains(stderr, "1 generated file(s) out of date") {
+				t.Errorf("unexpected stderr: %s", stderr)
+			}
+		})
+	}
+
+	// -check never writes
+	if b, err := os.ReadFile(output); err != nil || !bytes.Equal(b, stale) {
+		t.Error("stale file modified by -check")
+	}
+}
+
+// TestCheckBindings checks the committed bindings are up to date with
+// the vendored protocols and the templates, run go generate in
+// wayland/client after changing either
+func TestCheckBindings(t *testing.T) {
+	// Use the flags of the go:generate directive
+	doc, err := os.ReadFile("../../wayland/client/doc.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := regexp.MustCompile(`(?m)^//go:generate .*go-wayland-scanner .*-go (\S+)`).FindSubmatch(doc)
+	if m == nil {
+		t.Fatal("no go:generate directive running the scanner in wayland/client/doc.go")
+	}
+
+	stdout, stderr, code := runScanner(t, ".", "-check", "-config", "protocols/protocols.json", "-go", string(m[1]))
+	if code != 0 {
+		t.Errorf("bindings out of date, run go generate in wayland/client:\n%s%s", stdout, stderr)
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"go/doc"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+)
+
+// templateFS holds the default templates: common.go.tmpl, shared by
+// both sides, and one template per side generating its bindings. They
+// can be reused by custom templates.
+//
+//go:embed templates
+var templateFS embed.FS
+
+// codeTemplate is executed from templateEntry with the *File of each
+// generated protocol
+var (
+	codeTemplate  *template.Template
+	templateEntry = "file"
+)
+
+// templateFuncs are the functions available to templates
+var templateFuncs = template.FuncMap{
+	// synopsis returns the first sentence of a summary
+	"synopsis": doc.Synopsis,
+	// comment turns a description into "// " prefixed lines
+	"comment": comment,
+	// camel and lowerCamel convert xml names to Go identifiers
+	"camel":      strcase.ToCamel,
+	"lowerCamel": toLowerCamel,
+	// argType returns the name of the ArgType of a wire type in the
+	// runtime packages
+	"argType": func(t string) string { return typeToArgTypeMap[t] },
+}
+
+// loadTemplate parses the default templates of side, then the custom
+// template file if any. The custom file is executed instead of "file":
+// it can invoke the default templates, e.g. {{template "interface" .}},
+// and redefine them with {{define}}.
+func loadTemplate(side string, file string) error {
+	t, err := template.New(side).Funcs(templateFuncs).ParseFS(templateFS, "templates/common.go.tmpl", "templates/"+side+".go.tmpl")
+	if err != nil {
+		return fmt.Errorf("unable to parse default template: %w", err)
+	}
+	codeTemplate = t
+	templateEntry = "file"
+
+	if file == "" {
+		return nil
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("unable to read template: %w", err)
+	}
+
+	templateEntry = filepath.Base(file)
+	if _, err := codeTemplate.New(templateEntry).Parse(string(b)); err != nil {
+		return fmt.Errorf("unable to parse template: %w", err)
+	}
+
+	return nil
+}
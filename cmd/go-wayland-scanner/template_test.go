@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestTemplate executes a custom template with the data model of a
+// fixture protocol
+func TestTemplate(t *testing.T) {
+	input, err := filepath.Abs("testdata/template/template.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err := filepath.Abs("testdata/template/summary.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("testdata/template/summary.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if _, stderr, code := runScanner(t, dir, "-i", input, "-pkg", "template_test", "-prefix", "tt_", "-template", tmpl, "-o", "summary.txt"); code != 0 {
+		t.Fatalf("scanner exited with status %d: %s", code, stderr)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "summary.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestTemplateDefault invokes the default bindings from a custom
+// template, its go output is formatted as the bindings are
+func TestTemplateDefault(t *testing.T) {
+	input, err := filepath.Abs("testdata/template/template.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err := filepath.Abs("testdata/template/default.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	args := []string{"-i", input, "-pkg", "template_test", "-prefix", "tt_"}
+	if _, stderr, code := runScanner(t, dir, append(args, "-o", "bindings.go")...); code != 0 {
+		t.Fatalf("scanner exited with status %d: %s", code, stderr)
+	}
+	if _, stderr, code := runScanner(t, dir, append(args, "-template", tmpl, "-o", "template.go")...); code != 0 {
+		t.Fatalf("scanner exited with status %d: %s", code, stderr)
+	}
+
+	bindings, err := os.ReadFile(filepath.Join(dir, "bindings.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "template.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, bindings) {
+		t.Error("output of the default template differs from the bindings")
+	}
+}
+
+// TestTemplateWithConfig checks that -template is rejected with -config,
+// which would overwrite every protocol with the template output
+func TestTemplateWithConfig(t *testing.T) {
+	input, err := filepath.Abs("testdata/template/template.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err := filepath.Abs("testdata/template/summary.tmpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	config := filepath.Join(dir, "protocols.json")
+	b := fmt.Sprintf(`{"protocols": [{"input": %q, "output": "template.go", "package": "template_test", "import": "example.com/template_test", "prefix": "tt_"}]}`, input)
+	if err := os.WriteFile(config, []byte(b), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, stderr, code := runScanner(t, dir, "-config", config, "-template", tmpl)
+	if code == 0 {
+		t.Fatal("-template accepted with -config")
+	}
+	if !strings.Contains(stderr, "-template can't be used with -config") {
+		t.Errorf("unexpected stderr: %s", stderr)
+	}
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) != 1 {
+		t.Errorf("files written to the output directory: %v", entries)
+	}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+)
+
+// objects maps the object ids of a connection to their interface. Both
+// directions create objects, requests with new_id arguments and events
+// such as wl_data_device.data_offer.
+type objects struct {
+	mu sync.Mutex
+	m  map[uint32]*client.Interface
+}
+
+func newObjects() *objects {
+	return &objects{m: map[uint32]*client.Interface{1: client.DisplayInterface}}
+}
+
+func (o *objects) get(id uint32) *client.Interface {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.m[id]
+}
+
+func (o *objects) set(id uint32, iface *client.Interface) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if iface == nil {
+		delete(o.m, id)
+		return
+	}
+	o.m[id] = iface
+}
+
+// decoder splits the byte stream of one direction of a connection into
+// messages and formats them
+type decoder struct {
+	objects *objects
+	// request is set for the client to compositor direction
+	request bool
+	buf     []byte
+}
+
+// feed appends data read from the connection and returns the complete
+// messages it contains, formatted
+func (d *decoder) feed(data []byte) []string {
+	d.buf = append(d.buf, data...)
+
+	var lines []string
+	for len(d.buf) >= 8 {
+		size := int(client.Uint32(d.buf[4:8]) >> 16)
+		if size < 8 {
+			lines = append(lines, fmt.Sprintf("invalid message size %d, stopped decoding", size))
+			d.buf = nil
+			break
+		}
+		if size > len(d.buf) {
+			break
+		}
+
+		lines = append(lines, d.message(client.Uint32(d.buf[0:4]), client.Uint32(d.buf[4:8])&0xffff, d.buf[8:size]))
+		d.buf = d.buf[size:]
+	}
+	if len(d.buf) == 0 {
+		d.buf = nil
+	}
+
+	return lines
+}
+
+// message formats a message in the notation of WAYLAND_DEBUG, e.g.
+// wl_surface@3.attach(wl_buffer@7, 0, 0), and tracks the objects it
+// creates
+func (d *decoder) message(id, opcode uint32, data []byte) string {
+	iface := d.objects.get(id)
+	if iface == nil {
+		return fmt.Sprintf("unknown@%d.opcode %d (%d bytes)", id, opcode, len(data))
+	}
+
+	msg := iface.Event(opcode)
+	if d.request {
+		msg = iface.Request(opcode)
+	}
+	if msg == nil {
+		return fmt.Sprintf("%s@%d.opcode %d (%d bytes)", iface.Name, id, opcode, len(data))
+	}
+
+	args, err := d.args(msg, data)
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "%s@%d.%s(%s)", iface.Name, id, msg.Name, strings.Join(args, ", "))
+	if err != nil {
+		fmt.Fprintf(&sb, " malformed: %v", err)
+	}
+
+	if !d.request && id == 1 && msg.Name == "delete_id" && len(data) >= 4 {
+		d.objects.set(client.Uint32(data), nil)
+	}
+
+	return sb.String()
+}
+
+func (d *decoder) args(msg *client.Message, data []byte) ([]string, error) {
+	var args []string
+	l := 0
+	next := func() (uint32, error) {
+		if l+4 > len(data) {
+			return 0, fmt.Errorf("message too short")
+		}
+		v := client.Uint32(data[l:])
+		l += 4
+		return v, nil
+	}
+	bytes := func() ([]byte, error) {
+		n, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if int(n) > len(data)-l {
+			return nil, fmt.Errorf("message too short")
+		}
+		b := data[l : l+int(n)]
+		l += client.PaddedLen(int(n))
+		return b, nil
+	}
+
+	for _, arg := range msg.Args {
+		if arg.Type == client.ArgTypeFd {
+			args = append(args, "fd")
+			continue
+		}
+		if arg.Type == client.ArgTypeString || arg.Type == client.ArgTypeArray {
+			b, err := bytes()
+			if err != nil {
+				return args, err
+			}
+			args = append(args, formatBytes(arg.Type, b))
+			continue
+		}
+		if arg.Type == client.ArgTypeNewID && arg.Interface == "" {
+			// Untyped new_id, sent as interface name, version and id
+			name, err := bytes()
+			if err != nil {
+				return args, err
+			}
+			version, err := next()
+			if err != nil {
+				return args, err
+			}
+			id, err := next()
+			if err != nil {
+				return args, err
+			}
+			ifaceName := strings.TrimRight(string(name), "\x00")
+			d.objects.set(id, client.LookupInterface(ifaceName))
+			args = append(args, strconv.Quote(ifaceName), strconv.FormatUint(uint64(version), 10), fmt.Sprintf("new id %s@%d", ifaceName, id))
+			continue
+		}
+
+		v, err := next()
+		if err != nil {
+			return args, err
+		}
+		switch arg.Type {
+		case client.ArgTypeInt:
+			args = append(args, strconv.FormatInt(int64(int32(v)), 10))
+		case client.ArgTypeUint:
+			args = append(args, strconv.FormatUint(uint64(v), 10))
+		case client.ArgTypeFixed:
+			args = append(args, strconv.FormatFloat(client.Fixed(data[l-4:]), 'f', -1, 64))
+		case client.ArgTypeObject:
+			args = append(args, d.object(arg.Interface, v))
+		case client.ArgTypeNewID:
+			d.objects.set(v, client.LookupInterface(arg.Interface))
+			args = append(args, fmt.Sprintf("new id %s@%d", arg.Interface, v))
+		}
+	}
+	if l != len(data) {
+		return args, fmt.Errorf("%d trailing bytes", len(data)-l)
+	}
+
+	return args, nil
+}
+
+// object formats an object argument, with the interface it was created
+// with if the protocol doesn't fix it
+func (d *decoder) object(ifaceName string, id uint32) string {
+	if id == 0 {
+		return "nil"
+	}
+	if ifaceName == "" {
+		ifaceName = "unknown"
+		if iface := d.objects.get(id); iface != nil {
+			ifaceName = iface.Name
+		}
+	}
+	return fmt.Sprintf("%s@%d", ifaceName, id)
+}
+
+func formatBytes(t client.ArgType, b []byte) string {
+	if t == client.ArgTypeArray {
+		return fmt.Sprintf("array[%d]", len(b))
+	}
+	if len(b) == 0 {
+		return "nil"
+	}
+	return strconv.Quote(strings.TrimRight(string(b), "\x00"))
+}
@@ -0,0 +1,145 @@
+// Command wayland-debug-proxy sits between wayland clients and the
+// compositor and logs every message exchanged, decoded with the
+// metadata of the protocols generated in this module, in the notation
+// of WAYLAND_DEBUG:
+//
+//	[    12.345] client 1 -> wl_surface@3.attach(wl_buffer@7, 0, 0)
+//	[    12.402] client 1 <- wl_buffer@7.release()
+//
+// It listens on a new display socket and forwards each client to the
+// compositor of $WAYLAND_DISPLAY, or -display, file descriptors
+// included. Clients can be debugged without rebuilding them.
+//
+//	wayland-debug-proxy [-socket name] [-display name] [-o file] [command [args...]]
+//
+// The display name is printed as WAYLAND_DISPLAY=<name> once the proxy
+// accepts clients. Given a command, the proxy runs it with
+// WAYLAND_DISPLAY set and exits with its exit status.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+var (
+	socketName  string
+	displayName string
+	outputPath  string
+)
+
+func init() {
+	flag.StringVar(&socketName, "socket", "", "Display name to listen on, the first free wayland-N by default")
+	flag.StringVar(&displayName, "display", "", "Display name or path of the compositor, $WAYLAND_DISPLAY by default")
+	flag.StringVar(&outputPath, "o", "", "Write the log to `file` instead of stderr")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] [command [args...]]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Parse()
+
+	upstream, err := upstreamPath()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	p := &proxy{upstream: upstream, start: time.Now(), out: os.Stderr}
+	if outputPath != "" {
+		f, err := os.Create(outputPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		p.out = f
+	}
+
+	var sock *server.Socket
+	if socketName == "" {
+		sock, err = server.ListenAuto()
+	} else {
+		sock, err = server.Listen(socketName)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if sock.Path() == upstream {
+		sock.Close()
+		log.Fatalf("the proxy can't listen on the display of the compositor %s", upstream)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			conn, err := sock.Accept()
+			if err != nil {
+				errs <- err
+				return
+			}
+			go p.handle(conn)
+		}
+	}()
+	fmt.Printf("WAYLAND_DISPLAY=%s\n", sock.Name())
+
+	status := 0
+	if flag.NArg() > 0 {
+		status = run(flag.Args(), sock.Name())
+	} else if err := <-errs; err != nil {
+		log.Print(err)
+		status = 1
+	}
+
+	sock.Close()
+	os.Exit(status)
+}
+
+// upstreamPath returns the socket path of the compositor
+func upstreamPath() (string, error) {
+	name := displayName
+	if name == "" {
+		name = os.Getenv("WAYLAND_DISPLAY")
+	}
+	if name == "" {
+		name = "wayland-0"
+	}
+	if filepath.IsAbs(name) {
+		return name, nil
+	}
+
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		return "", errors.New("env XDG_RUNTIME_DIR not set")
+	}
+	return filepath.Join(runtimeDir, name), nil
+}
+
+// run runs the command connected to the proxy and returns its exit
+// status
+func run(args []string, display string) int {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "WAYLAND_DISPLAY="+display)
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	return 0
+}
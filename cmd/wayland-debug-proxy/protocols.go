@@ -0,0 +1,27 @@
+package main
+
+// The protocol packages register the metadata of their interfaces,
+// which decodes their messages.
+import (
+	_ "github.com/hempflower/go-wayland/wayland/external/wlr/data-control-v1"
+	_ "github.com/hempflower/go-wayland/wayland/external/wlr/foreign-toplevel-v1"
+	_ "github.com/hempflower/go-wayland/wayland/external/wlr/gamma-control-v1"
+	_ "github.com/hempflower/go-wayland/wayland/external/wlr/layer-shell-v1"
+	_ "github.com/hempflower/go-wayland/wayland/external/wlr/output-management-v1"
+	_ "github.com/hempflower/go-wayland/wayland/external/wlr/screencopy-v1"
+	_ "github.com/hempflower/go-wayland/wayland/external/wlr/virtual-keyboard-v1"
+	_ "github.com/hempflower/go-wayland/wayland/external/wlr/virtual-pointer-v1"
+	_ "github.com/hempflower/go-wayland/wayland/stable/linux-dmabuf-v1"
+	_ "github.com/hempflower/go-wayland/wayland/stable/presentation-time"
+	_ "github.com/hempflower/go-wayland/wayland/stable/tablet-v2"
+	_ "github.com/hempflower/go-wayland/wayland/stable/viewporter"
+	_ "github.com/hempflower/go-wayland/wayland/stable/xdg-shell"
+	_ "github.com/hempflower/go-wayland/wayland/staging/cursor-shape-v1"
+	_ "github.com/hempflower/go-wayland/wayland/staging/fractional-scale-v1"
+	_ "github.com/hempflower/go-wayland/wayland/staging/xdg-activation-v1"
+	_ "github.com/hempflower/go-wayland/wayland/unstable/idle-inhibit-v1"
+	_ "github.com/hempflower/go-wayland/wayland/unstable/pointer-constraints-v1"
+	_ "github.com/hempflower/go-wayland/wayland/unstable/relative-pointer-v1"
+	_ "github.com/hempflower/go-wayland/wayland/unstable/text-input-v3"
+	_ "github.com/hempflower/go-wayland/wayland/unstable/xdg-decoration-v1"
+)
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// maxFds is the maximum number of fds libwayland sends along with a
+// single sendmsg
+const maxFds = 28
+
+// proxy forwards the connections of clients to the compositor, logging
+// their messages
+type proxy struct {
+	upstream string
+	start    time.Time
+
+	logMu sync.Mutex
+	out   io.Writer
+
+	mu      sync.Mutex
+	clients int
+}
+
+// logf writes a line to the log, prefixed with the time since the proxy
+// started in ms like WAYLAND_DEBUG
+func (p *proxy) logf(format string, args ...interface{}) {
+	p.logMu.Lock()
+	defer p.logMu.Unlock()
+
+	ms := float64(time.Since(p.start).Microseconds()) / 1000
+	fmt.Fprintf(p.out, "[%10.3f] %s\n", ms, fmt.Sprintf(format, args...))
+}
+
+// handle forwards a client connection until either side closes it
+func (p *proxy) handle(conn *net.UnixConn) {
+	p.mu.Lock()
+	p.clients++
+	n := p.clients
+	p.mu.Unlock()
+
+	defer conn.Close()
+
+	upstream, err := net.DialUnix("unix", nil, &net.UnixAddr{Name: p.upstream, Net: "unix"})
+	if err != nil {
+		p.logf("client %d: unable to connect to the compositor: %v", n, err)
+		return
+	}
+	defer upstream.Close()
+
+	p.logf("client %d: connected", n)
+
+	objects := newObjects()
+	errs := make(chan error, 2)
+	go func() {
+		errs <- p.forward(n, conn, upstream, &decoder{objects: objects, request: true}, "->")
+	}()
+	go func() {
+		errs <- p.forward(n, upstream, conn, &decoder{objects: objects}, "<-")
+	}()
+
+	err = <-errs
+	// Unblock the other direction
+	conn.Close()
+	upstream.Close()
+	<-errs
+
+	if err != nil {
+		p.logf("client %d: disconnected: %v", n, err)
+	} else {
+		p.logf("client %d: disconnected", n)
+	}
+}
+
+// forward copies data and fds from src to dst as they arrive, logging
+// the messages decoded from it. It returns nil once src is closed.
+func (p *proxy) forward(n int, src, dst *net.UnixConn, d *decoder, arrow string) error {
+	buf := make([]byte, 4096)
+	oob := make([]byte, unix.CmsgSpace(maxFds*4))
+
+	for {
+		bn, oobn, _, _, err := src.ReadMsgUnix(buf, oob)
+		if err != nil {
+			// Peers exiting with unread messages reset the connection
+			if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) || errors.Is(err, unix.ECONNRESET) {
+				return nil
+			}
+			return err
+		}
+		if bn == 0 {
+			return nil
+		}
+
+		fds, err := parseFds(oob[:oobn])
+		if err != nil {
+			return err
+		}
+
+		// The objects created by the messages are tracked before the peer
+		// can answer them, its reply is decoded by the other direction
+		for _, line := range d.feed(buf[:bn]) {
+			p.logf("client %d %s %s", n, arrow, line)
+		}
+
+		var rights []byte
+		if len(fds) > 0 {
+			rights = unix.UnixRights(fds...)
+		}
+		err = writeMsg(dst, buf[:bn], rights)
+		for _, fd := range fds {
+			unix.Close(fd)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// writeMsg writes all of b to conn, the fds in oob are sent along with
+// the first chunk
+func writeMsg(conn *net.UnixConn, b, oob []byte) error {
+	for len(b) > 0 {
+		n, oobn, err := conn.WriteMsgUnix(b, oob, nil)
+		if err != nil {
+			return err
+		}
+		if oobn != len(oob) {
+			return fmt.Errorf("incorrect number of oob bytes written (oobn=%d)", oobn)
+		}
+		b = b[n:]
+		oob = nil
+	}
+	return nil
+}
+
+func parseFds(oob []byte) ([]int, error) {
+	if len(oob) == 0 {
+		return nil, nil
+	}
+
+	msgs, err := unix.ParseSocketControlMessage(oob)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse control message: %w", err)
+	}
+
+	var fds []int
+	for _, msg := range msgs {
+		rights, err := unix.ParseUnixRights(&msg)
+		if err != nil {
+			log.Printf("ignoring control message: %v", err)
+			continue
+		}
+		fds = append(fds, rights...)
+	}
+
+	return fds, nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"golang.org/x/sys/unix"
+)
+
+// message encodes a message, args are uint32 values or strings
+func message(id, opcode uint32, args ...interface{}) []byte {
+	var body []byte
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case uint32:
+			body = binaryAppend(body, v)
+		case string:
+			l := client.PaddedLen(len(v) + 1)
+			body = binaryAppend(body, uint32(len(v)+1))
+			b := make([]byte, l)
+			copy(b, v)
+			body = append(body, b...)
+		}
+	}
+	return append(binaryAppend(binaryAppend(nil, id), uint32(8+len(body))<<16|opcode), body...)
+}
+
+func binaryAppend(b []byte, v uint32) []byte {
+	var buf [4]byte
+	client.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+// socketpair returns both ends of a connected unix socket
+func socketpair(t *testing.T) (*net.UnixConn, *net.UnixConn) {
+	t.Helper()
+
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var conns [2]*net.UnixConn
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "socketpair")
+		conn, err := net.FileConn(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = conn.(*net.UnixConn)
+	}
+	return conns[0], conns[1]
+}
+
+// readMsg reads n bytes from conn along with the fds passed with them
+func readMsg(t *testing.T, conn *net.UnixConn, n int) ([]byte, []int) {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var data []byte
+	var fds []int
+	buf := make([]byte, n)
+	oob := make([]byte, unix.CmsgSpace(maxFds*4))
+	for len(data) < n {
+		bn, oobn, _, _, err := conn.ReadMsgUnix(buf[:n-len(data)], oob)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := parseFds(oob[:oobn])
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = append(data, buf[:bn]...)
+		fds = append(fds, got...)
+	}
+	return data, fds
+}
+
+func TestProxy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wayland-upstream")
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var log bytes.Buffer
+	p := &proxy{upstream: path, start: time.Now(), out: &log}
+	conn, proxyConn := socketpair(t)
+	done := make(chan struct{})
+	go func() {
+		p.handle(proxyConn)
+		close(done)
+	}()
+
+	upstream, err := l.AcceptUnix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer upstream.Close()
+
+	pool, err := unix.MemfdCreate("pool", unix.MFD_CLOEXEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(pool)
+
+	var requests []byte
+	requests = append(requests, message(1, 1, uint32(2))...)
+	requests = append(requests, message(2, 0, uint32(1), "wl_shm", uint32(1), uint32(3))...)
+	requests = append(requests, message(3, 0, uint32(4), uint32(4096))...)
+	if _, _, err := conn.WriteMsgUnix(requests, unix.UnixRights(pool), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	got, fds := readMsg(t, upstream, len(requests))
+	if !bytes.Equal(got, requests) {
+		t.Errorf("forwarded requests differ")
+	}
+	if len(fds) != 1 {
+		t.Fatalf("got %d fds, want 1", len(fds))
+	}
+	var want, st unix.Stat_t
+	unix.Fstat(pool, &want)
+	unix.Fstat(fds[0], &st)
+	unix.Close(fds[0])
+	if st.Dev != want.Dev || st.Ino != want.Ino {
+		t.Error("forwarded fd isn't the pool")
+	}
+
+	// The reply is about the registry created by the requests
+	events := message(2, 0, uint32(1), "wl_shm", uint32(1))
+	if _, err := upstream.Write(events); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := readMsg(t, conn, len(events)); !bytes.Equal(got, events) {
+		t.Errorf("forwarded events differ")
+	}
+
+	conn.Close()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("proxy didn't stop after the client closed")
+	}
+
+	for _, line := range []string{
+		"client 1: connected",
+		"client 1 -> wl_display@1.get_registry(new id wl_registry@2)",
+		`client 1 -> wl_registry@2.bind(1, "wl_shm", 1, new id wl_shm@3)`,
+		"client 1 -> wl_shm@3.create_pool(new id wl_shm_pool@4, fd, 4096)",
+		`client 1 <- wl_registry@2.global(1, "wl_shm", 1)`,
+		"client 1: disconnected",
+	} {
+		if !strings.Contains(log.String(), "] "+line+"\n") {
+			t.Errorf("log misses %q:\n%s", line, log.String())
+		}
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/hempflower/go-wayland/cmd/wayland-forward
+
+go 1.19
+
+require (
+	github.com/hempflower/go-wayland/wayland v0.0.0-00010101000000-000000000000
+	golang.org/x/sys v0.0.0-20211117180635-dee7805ff2e1
+)
+
+replace github.com/hempflower/go-wayland/wayland => ../../wayland
@@ -0,0 +1,282 @@
+// Command wayland-forward runs wayland clients on another machine than
+// the compositor, like waypipe. Two instances are connected by a
+// stream: the remote one, next to the clients, listens on a display
+// socket, the local one connects each client to the compositor.
+//
+//	wayland-forward local [-display name] [-listen addr | -connect addr]
+//	wayland-forward remote [-socket name] [-listen addr | -connect addr] [command [args...]]
+//	wayland-forward ssh [-display name] [-socket name] host [command [args...]]
+//
+// The stream is a TCP connection, listened on or connected to, or the
+// stdin and stdout of the command. The ssh mode runs the remote end
+// over ssh, with wayland-forward installed on the host:
+//
+//	wayland-forward ssh host weston-terminal
+//
+// Or, by hand, over TCP:
+//
+//	host$ wayland-forward remote -listen :7000 weston-terminal
+//	local$ wayland-forward local -connect host:7000
+//
+// The remote end prints the display name as WAYLAND_DISPLAY=<name> once
+// it accepts clients. Given a command, it runs it with WAYLAND_DISPLAY
+// set and exits with its exit status.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/hempflower/go-wayland/wayland/forward"
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `usage: %[1]s local [-display name] [-listen addr | -connect addr]
+       %[1]s remote [-socket name] [-listen addr | -connect addr] [command [args...]]
+       %[1]s ssh [-display name] [-socket name] host [command [args...]]
+`, os.Args[0])
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("wayland-forward: ")
+
+	if len(os.Args) < 2 {
+		usage()
+	}
+	mode, args := os.Args[1], os.Args[2:]
+
+	var err error
+	status := 0
+	switch mode {
+	case "local":
+		err = runLocal(args)
+	case "remote":
+		status, err = runRemote(args)
+	case "ssh":
+		status, err = runSSH(args)
+	default:
+		usage()
+	}
+	if err != nil {
+		log.Print(err)
+		status = 1
+	}
+	os.Exit(status)
+}
+
+func runLocal(args []string) error {
+	fs := flag.NewFlagSet("local", flag.ExitOnError)
+	displayName := fs.String("display", "", "Display name or path of the compositor, $WAYLAND_DISPLAY by default")
+	listen := fs.String("listen", "", "Accept the remote ends on the TCP `address`")
+	connect := fs.String("connect", "", "Connect to the remote end at the TCP `address`")
+	fs.Parse(args)
+	if fs.NArg() > 0 || (*listen != "" && *connect != "") {
+		usage()
+	}
+
+	display, err := displayPath(*displayName)
+	if err != nil {
+		return err
+	}
+
+	if *listen == "" {
+		tunnel, err := dial(*connect)
+		if err != nil {
+			return err
+		}
+		return forward.Local(tunnel, display)
+	}
+
+	// Every remote end connecting gets its own session
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := forward.Local(conn, display); err != nil {
+				log.Printf("%s: %v", conn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+func runRemote(args []string) (int, error) {
+	fs := flag.NewFlagSet("remote", flag.ExitOnError)
+	socketName := fs.String("socket", "", "Display name to listen on, the first free wayland-N by default")
+	listen := fs.String("listen", "", "Accept the local end on the TCP `address`")
+	connect := fs.String("connect", "", "Connect to the local end at the TCP `address`")
+	fs.Parse(args)
+	if *listen != "" && *connect != "" {
+		usage()
+	}
+
+	var tunnel io.ReadWriteCloser
+	var err error
+	if *listen != "" {
+		tunnel, err = accept(*listen)
+	} else {
+		tunnel, err = dial(*connect)
+	}
+	if err != nil {
+		return 1, err
+	}
+
+	var sock *server.Socket
+	if *socketName == "" {
+		sock, err = server.ListenAuto()
+	} else {
+		sock, err = server.Listen(*socketName)
+	}
+	if err != nil {
+		tunnel.Close()
+		return 1, err
+	}
+	defer sock.Close()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- forward.Remote(tunnel, sock)
+	}()
+	// Over stdio, stdout is the tunnel
+	fmt.Fprintf(os.Stderr, "WAYLAND_DISPLAY=%s\n", sock.Name())
+
+	if fs.NArg() == 0 {
+		return 0, <-errs
+	}
+	status := run(fs.Args(), sock.Name(), *listen == "" && *connect == "")
+	tunnel.Close()
+	<-errs
+	return status, nil
+}
+
+func runSSH(args []string) (int, error) {
+	fs := flag.NewFlagSet("ssh", flag.ExitOnError)
+	displayName := fs.String("display", "", "Display name or path of the compositor, $WAYLAND_DISPLAY by default")
+	socketName := fs.String("socket", "", "Display name to listen on on the host")
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		usage()
+	}
+
+	display, err := displayPath(*displayName)
+	if err != nil {
+		return 1, err
+	}
+
+	remote := []string{fs.Arg(0), "wayland-forward", "remote"}
+	if *socketName != "" {
+		remote = append(remote, "-socket", *socketName)
+	}
+	if fs.NArg() > 1 {
+		remote = append(remote, "--")
+		remote = append(remote, fs.Args()[1:]...)
+	}
+
+	cmd := exec.Command("ssh", remote...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return 1, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return 1, err
+	}
+	if err := cmd.Start(); err != nil {
+		return 1, err
+	}
+
+	ferr := forward.Local(&stream{Reader: stdout, WriteCloser: stdin}, display)
+	status := exitStatus(cmd.Wait())
+	if ferr != nil && status == 0 {
+		return 1, ferr
+	}
+	return status, nil
+}
+
+// dial connects to the TCP address, or uses stdio without an address
+func dial(addr string) (io.ReadWriteCloser, error) {
+	if addr == "" {
+		return &stream{Reader: os.Stdin, WriteCloser: os.Stdout}, nil
+	}
+	return net.Dial("tcp", addr)
+}
+
+// accept returns the first connection to the TCP address
+func accept(addr string) (io.ReadWriteCloser, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer l.Close()
+	fmt.Fprintf(os.Stderr, "listening on %s\n", l.Addr())
+
+	return l.Accept()
+}
+
+// stream is a tunnel over a pair of pipes
+type stream struct {
+	io.Reader
+	io.WriteCloser
+}
+
+// displayPath returns the socket path of the compositor
+func displayPath(name string) (string, error) {
+	if name == "" {
+		name = os.Getenv("WAYLAND_DISPLAY")
+	}
+	if name == "" {
+		name = "wayland-0"
+	}
+	if filepath.IsAbs(name) {
+		return name, nil
+	}
+
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		return "", errors.New("env XDG_RUNTIME_DIR not set")
+	}
+	return filepath.Join(runtimeDir, name), nil
+}
+
+// run runs the command connected to the remote end and returns its exit
+// status, the command doesn't get stdio if it's the tunnel
+func run(args []string, display string, stdio bool) int {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if !stdio {
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+	}
+	cmd.Env = append(os.Environ(), "WAYLAND_DISPLAY="+display)
+
+	return exitStatus(cmd.Run())
+}
+
+func exitStatus(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+	xdg_shell "github.com/hempflower/go-wayland/wayland/server/stable/xdg-shell"
+)
+
+// compositor holds the state shared by the handlers, it is only
+// accessed from handlers or through server.Do.
+type compositor struct {
+	server  *server.Server
+	outputs []*output
+	seat    *seat
+	start   time.Time
+
+	// views are the mapped toplevels and popups, bottom to top
+	views        []*surface
+	nextWindowID int
+	// frames are the frame callbacks committed since the last repaint
+	frames []*server.Callback
+
+	selection *dataSource
+	// dataDevices are the wl_data_device resources of all the clients
+	dataDevices map[*server.DataDevice]struct{}
+
+	stop chan struct{}
+}
+
+func newCompositor(s *server.Server, modes []outputMode, refreshRate int) (*compositor, error) {
+	c := &compositor{
+		server:      s,
+		start:       time.Now(),
+		dataDevices: map[*server.DataDevice]struct{}{},
+		stop:        make(chan struct{}),
+	}
+	c.seat = &seat{c: c}
+
+	x := int32(0)
+	for i, m := range modes {
+		o := &output{
+			c:       c,
+			name:    fmt.Sprintf("HEADLESS-%d", i+1),
+			x:       x,
+			mode:    m,
+			refresh: int32(refreshRate * 1000),
+		}
+		x += m.width / m.scale
+		c.outputs = append(c.outputs, o)
+	}
+
+	globals := []struct {
+		iface *server.Interface
+		bind  func(r server.Resource)
+	}{
+		{server.CompositorInterface, func(r server.Resource) {
+			r.(*server.Compositor).SetHandler(compositorHandler{c})
+		}},
+		{server.SubcompositorInterface, func(r server.Resource) {
+			r.(*server.Subcompositor).SetHandler(subcompositorHandler{c})
+		}},
+		{server.SeatInterface, c.seat.bind},
+		{server.DataDeviceManagerInterface, func(r server.Resource) {
+			r.(*server.DataDeviceManager).SetHandler(dataDeviceManagerHandler{c})
+		}},
+		{xdg_shell.WmBaseInterface, func(r server.Resource) {
+			r.(*xdg_shell.WmBase).SetHandler(wmBaseHandler{c})
+		}},
+	}
+	for _, g := range globals {
+		if _, err := s.CreateGlobal(g.iface, g.iface.Version, g.bind); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := s.InitShm(); err != nil {
+		return nil, err
+	}
+	for _, o := range c.outputs {
+		if _, err := s.CreateGlobal(server.OutputInterface, server.OutputInterface.Version, o.bind); err != nil {
+			return nil, err
+		}
+	}
+
+	go c.repaintLoop(time.Second / time.Duration(refreshRate))
+
+	return c, nil
+}
+
+func (c *compositor) close() {
+	close(c.stop)
+	c.server.Close()
+}
+
+// now returns the timestamp of input and frame events, in milliseconds
+func (c *compositor) now() uint32 {
+	return uint32(time.Since(c.start).Milliseconds())
+}
+
+// repaintLoop signals frame callbacks at the refresh rate, nothing is
+// actually painted until an output is dumped
+func (c *compositor) repaintLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+		}
+
+		c.server.Do(func() {
+			now := c.now()
+			for _, cb := range c.frames {
+				cb.SendDone(now)
+				cb.Destroy()
+			}
+			c.frames = nil
+		})
+	}
+}
+
+// mapView adds a toplevel or popup on top of the others
+func (c *compositor) mapView(s *surface) {
+	c.views = append(c.views, s)
+	c.updateOutputs(s)
+	c.seat.updatePointerFocus()
+}
+
+func (c *compositor) unmapView(s *surface) {
+	for i, v := range c.views {
+		if v == s {
+			c.views = append(c.views[:i], c.views[i+1:]...)
+			break
+		}
+	}
+
+	// Popups don't outlive their parent
+	for _, v := range append([]*surface(nil), c.views...) {
+		if p := v.popup(); p != nil && p.parent != nil && p.parent.surface == s {
+			p.dismiss()
+		}
+	}
+
+	if c.seat.keyboardFocus == s {
+		c.seat.setKeyboardFocus(c.topToplevel())
+	}
+	c.seat.updatePointerFocus()
+}
+
+// raise moves a view on top of the others
+func (c *compositor) raise(s *surface) {
+	for i, v := range c.views {
+		if v == s {
+			c.views = append(append(c.views[:i], c.views[i+1:]...), s)
+			return
+		}
+	}
+}
+
+// topToplevel returns the topmost mapped toplevel, or nil
+func (c *compositor) topToplevel() *surface {
+	for i := len(c.views) - 1; i >= 0; i-- {
+		if c.views[i].toplevel() != nil {
+			return c.views[i]
+		}
+	}
+	return nil
+}
+
+// toplevelByID returns the mapped toplevel with the given window id
+func (c *compositor) toplevelByID(id int) *toplevel {
+	for _, v := range c.views {
+		if t := v.toplevel(); t != nil && t.id == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// surfaceAt returns the surface at the global position and the position
+// relative to it
+func (c *compositor) surfaceAt(x, y float64) (s *surface, sx, sy float64) {
+	for i := len(c.views) - 1; i >= 0; i-- {
+		if s, sx, sy := c.views[i].surfaceAt(x, y); s != nil {
+			return s, sx, sy
+		}
+	}
+	return nil, 0, 0
+}
+
+// updateOutputs sends wl_surface.enter and leave for the outputs the
+// view overlaps
+func (c *compositor) updateOutputs(s *surface) {
+	x, y := s.origin()
+	w, h := s.size()
+	r := rect{x, y, w, h}
+
+	for _, o := range c.outputs {
+		on := r.overlaps(o.rect())
+		if on == s.outputs[o] {
+			continue
+		}
+		if on {
+			s.outputs[o] = true
+		} else {
+			delete(s.outputs, o)
+		}
+
+		for _, res := range o.resources {
+			if res.Client() != s.res.Client() {
+				continue
+			}
+			if on {
+				s.res.SendEnter(res)
+			} else {
+				s.res.SendLeave(res)
+			}
+		}
+	}
+}
+
+type rect struct {
+	x, y, width, height int32
+}
+
+func (r rect) contains(x, y float64) bool {
+	return x >= float64(r.x) && y >= float64(r.y) && x < float64(r.x+r.width) && y < float64(r.y+r.height)
+}
+
+func (r rect) overlaps(o rect) bool {
+	return r.x < o.x+o.width && o.x < r.x+r.width && r.y < o.y+o.height && o.y < r.y+r.height
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+// serveControl accepts connections to the control socket until it is
+// closed
+func (c *compositor) serveControl(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go c.handleControl(conn)
+	}
+}
+
+// handleControl runs the commands of a control connection, see the
+// package documentation
+func (c *compositor) handleControl(conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	w := bufio.NewWriter(conn)
+	for scanner.Scan() {
+		args := strings.Fields(scanner.Text())
+		if len(args) == 0 {
+			continue
+		}
+
+		var lines []string
+		var img image.Image
+		var err error
+		c.server.Do(func() {
+			if args[0] == "dump" {
+				img, err = c.compositeCommand(args[1:])
+				return
+			}
+			lines, err = c.command(args)
+		})
+		if err == nil && img != nil {
+			err = writePNG(img, args[1])
+		}
+
+		for _, line := range lines {
+			fmt.Fprintln(w, line)
+		}
+		if err != nil {
+			fmt.Fprintf(w, "error: %v\n", err)
+		} else {
+			fmt.Fprintln(w, "ok")
+		}
+		if err := w.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// compositeCommand composites the output of the dump command, the
+// image is written once the server is no longer blocked
+func (c *compositor) compositeCommand(args []string) (image.Image, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, errors.New("usage: dump <file.png> [output]")
+	}
+
+	o := c.outputs[0]
+	if len(args) == 2 {
+		i, err := strconv.Atoi(args[1])
+		if err != nil || i < 0 || i >= len(c.outputs) {
+			return nil, fmt.Errorf("invalid output %q", args[1])
+		}
+		o = c.outputs[i]
+	}
+
+	return c.composite(o), nil
+}
+
+// command runs a control command other than dump and returns its
+// output lines
+func (c *compositor) command(args []string) ([]string, error) {
+	switch args[0] {
+	case "motion":
+		x, y, err := parsePoint(args[1:])
+		if err != nil {
+			return nil, err
+		}
+		c.seat.motion(x, y)
+
+	case "button", "key":
+		if len(args) != 3 || (args[2] != "press" && args[2] != "release") {
+			return nil, fmt.Errorf("usage: %s <code> press|release", args[0])
+		}
+		code, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code %q", args[1])
+		}
+		if args[0] == "button" {
+			c.seat.button(uint32(code), args[2] == "press")
+		} else {
+			c.seat.key(uint32(code), args[2] == "press")
+		}
+
+	case "axis":
+		if len(args) != 3 || (args[1] != "vertical" && args[1] != "horizontal") {
+			return nil, errors.New("usage: axis vertical|horizontal <value>")
+		}
+		v, err := strconv.ParseFloat(args[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", args[2])
+		}
+		axis := server.PointerAxisVerticalScroll
+		if args[1] == "horizontal" {
+			axis = server.PointerAxisHorizontalScroll
+		}
+		c.seat.axis(axis, v)
+
+	case "windows":
+		var lines []string
+		for _, v := range c.views {
+			t := v.toplevel()
+			if t == nil {
+				continue
+			}
+			w, h := t.xdg.windowSize()
+			lines = append(lines, fmt.Sprintf("%d %d %d %d %d %q %q", t.id, t.x, t.y, w, h, t.appID, t.title))
+		}
+		return lines, nil
+
+	case "focus", "close":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("usage: %s <id>", args[0])
+		}
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q", args[1])
+		}
+		t := c.toplevelByID(id)
+		if t == nil {
+			return nil, fmt.Errorf("no window %d", id)
+		}
+		if args[0] == "focus" {
+			c.raise(t.xdg.surface)
+			c.seat.setKeyboardFocus(t.xdg.surface)
+			c.seat.updatePointerFocus()
+		} else {
+			t.res.SendClose()
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown command %q", args[0])
+	}
+
+	return nil, nil
+}
+
+func parsePoint(args []string) (x, y float64, err error) {
+	if len(args) != 2 {
+		return 0, 0, errors.New("usage: motion <x> <y>")
+	}
+	x, err1 := strconv.ParseFloat(args[0], 64)
+	y, err2 := strconv.ParseFloat(args[1], 64)
+	if err1 != nil || err2 != nil {
+		return 0, 0, fmt.Errorf("invalid position %s %s", args[0], args[1])
+	}
+	return x, y, nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bufio"
+	"net"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"github.com/hempflower/go-wayland/wayland/server"
+	xdg_shell "github.com/hempflower/go-wayland/wayland/stable/xdg-shell"
+	"golang.org/x/sys/unix"
+)
+
+// startCompositor runs a compositor with a 640x480 output and returns
+// the path of its display socket and a connection to its control socket
+func startCompositor(t *testing.T) (string, net.Conn) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", dir)
+
+	sock, err := server.Listen("wayland-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := newCompositor(server.New(), []outputMode{{width: 640, height: 480, scale: 1}}, 60)
+	if err != nil {
+		sock.Close()
+		t.Fatal(err)
+	}
+	go c.server.Serve(sock)
+	t.Cleanup(c.close)
+
+	l, err := net.Listen("unix", filepath.Join(dir, "control"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go c.serveControl(l)
+
+	conn, err := net.Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return sock.Path(), conn
+}
+
+// mapToplevel maps a toplevel with a 100x50 buffer
+func mapToplevel(t *testing.T, display *client.Display, appID, title string) {
+	t.Helper()
+
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compositor, err := client.BindGlobal[*client.Compositor](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shm, err := client.BindGlobal[*client.Shm](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wmBase, err := client.BindGlobal[*xdg_shell.WmBase](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	surface, err := compositor.CreateSurface()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xdgSurface, err := wmBase.GetXdgSurface(surface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xdgSurface.SetConfigureHandler(func(e xdg_shell.SurfaceConfigureEvent) {
+		xdgSurface.AckConfigure(e.Serial)
+	})
+	toplevel, err := xdgSurface.GetToplevel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	toplevel.SetAppId(appID)
+	toplevel.SetTitle(title)
+	surface.Commit()
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	const width, height = 100, 50
+	fd, err := unix.MemfdCreate("buffer", unix.MFD_CLOEXEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+	if err := unix.Ftruncate(fd, width*height*4); err != nil {
+		t.Fatal(err)
+	}
+	pool, err := shm.CreatePool(fd, width*height*4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer, err := pool.CreateBuffer(0, width, height, width*4, client.ShmFormatArgb8888)
+	if err != nil {
+		t.Fatal(err)
+	}
+	surface.Attach(buffer, 0, 0)
+	surface.Commit()
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// control runs a command and returns its output lines, the "ok" or
+// "error: ..." line included
+func control(t *testing.T, conn net.Conn, r *bufio.Reader, command string) []string {
+	t.Helper()
+
+	if _, err := conn.Write([]byte(command + "\n")); err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		lines = append(lines, line)
+		if line == "ok" || strings.HasPrefix(line, "error: ") {
+			return lines
+		}
+	}
+}
+
+func TestControl(t *testing.T) {
+	path, conn := startCompositor(t)
+	r := bufio.NewReader(conn)
+
+	display, err := client.Connect(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer display.Context().Close()
+	mapToplevel(t, display, "test app", "hello world")
+
+	for _, tt := range []struct {
+		command string
+		want    []string
+	}{
+		{"windows", []string{`1 0 0 100 50 "test app" "hello world"`, "ok"}},
+		{"focus 1", []string{"ok"}},
+		{"focus 2", []string{"error: no window 2"}},
+		{"motion 10 10", []string{"ok"}},
+		{"motion 10", []string{"error: usage: motion <x> <y>"}},
+		{"key 30 down", []string{"error: usage: key <code> press|release"}},
+		{"frobnicate", []string{`error: unknown command "frobnicate"`}},
+	} {
+		if got := control(t, conn, r, tt.command); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.command, got, tt.want)
+		}
+	}
+}
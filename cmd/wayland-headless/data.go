@@ -0,0 +1,141 @@
+package main
+
+import (
+	"github.com/hempflower/go-wayland/wayland/server"
+	"golang.org/x/sys/unix"
+)
+
+// dataDeviceManagerHandler implements wl_data_device_manager. Only the
+// selection is supported, drags are cancelled right away.
+type dataDeviceManagerHandler struct {
+	c *compositor
+}
+
+func (h dataDeviceManagerHandler) CreateDataSource(r *server.DataDeviceManager, id *server.DataSource) {
+	src := &dataSource{c: h.c, res: id}
+	id.SetHandler(src)
+	id.AddDestroyHandler(src.destroy)
+}
+
+func (h dataDeviceManagerHandler) GetDataDevice(r *server.DataDeviceManager, id *server.DataDevice, seat *server.Seat) {
+	c := h.c
+	id.SetHandler(dataDeviceHandler{c})
+	c.dataDevices[id] = struct{}{}
+	id.AddDestroyHandler(func() {
+		delete(c.dataDevices, id)
+	})
+
+	if f := c.seat.keyboardFocus; f != nil && f.res.Client() == id.Client() {
+		c.sendDeviceSelection(id)
+	}
+}
+
+// dataDeviceHandler implements wl_data_device
+type dataDeviceHandler struct {
+	c *compositor
+}
+
+func (dataDeviceHandler) StartDrag(r *server.DataDevice, source *server.DataSource, origin, icon *server.Surface, serial uint32) {
+	if source != nil {
+		source.SendCancelled()
+	}
+}
+
+func (h dataDeviceHandler) SetSelection(r *server.DataDevice, source *server.DataSource, serial uint32) {
+	c := h.c
+
+	var src *dataSource
+	if source != nil {
+		src = source.Handler().(*dataSource)
+	}
+	if src == c.selection {
+		return
+	}
+
+	if old := c.selection; old != nil {
+		old.res.SendCancelled()
+	}
+	c.selection = src
+	if f := c.seat.keyboardFocus; f != nil {
+		c.sendSelection(f.res.Client())
+	}
+}
+
+func (dataDeviceHandler) Release(r *server.DataDevice) {}
+
+// sendSelection offers the selection to the data devices of client
+func (c *compositor) sendSelection(client *server.Client) {
+	for d := range c.dataDevices {
+		if d.Client() == client {
+			c.sendDeviceSelection(d)
+		}
+	}
+}
+
+func (c *compositor) sendDeviceSelection(d *server.DataDevice) {
+	src := c.selection
+	if src == nil {
+		d.SendSelection(nil)
+		return
+	}
+
+	offer, err := d.SendDataOffer()
+	if err != nil {
+		return
+	}
+	offer.SetHandler(&dataOffer{source: src})
+	for _, mimeType := range src.mimeTypes {
+		offer.SendOffer(mimeType)
+	}
+	d.SendSelection(offer)
+}
+
+// dataSource implements wl_data_source
+type dataSource struct {
+	c         *compositor
+	res       *server.DataSource
+	mimeTypes []string
+	destroyed bool
+}
+
+func (src *dataSource) Offer(r *server.DataSource, mimeType string) {
+	src.mimeTypes = append(src.mimeTypes, mimeType)
+}
+
+func (src *dataSource) Destroy(r *server.DataSource) {}
+
+func (src *dataSource) SetActions(r *server.DataSource, dndActions server.DataDeviceManagerDndAction) {
+}
+
+func (src *dataSource) destroy() {
+	src.destroyed = true
+	if src.c.selection != src {
+		return
+	}
+	src.c.selection = nil
+	if f := src.c.seat.keyboardFocus; f != nil {
+		src.c.sendSelection(f.res.Client())
+	}
+}
+
+// dataOffer implements wl_data_offer, transfers are forwarded to the
+// source
+type dataOffer struct {
+	source *dataSource
+}
+
+func (o *dataOffer) Accept(r *server.DataOffer, serial uint32, mimeType string) {}
+
+func (o *dataOffer) Receive(r *server.DataOffer, mimeType string, fd int) {
+	if !o.source.destroyed {
+		o.source.res.SendSend(mimeType, fd)
+	}
+	unix.Close(fd)
+}
+
+func (o *dataOffer) Destroy(r *server.DataOffer) {}
+
+func (o *dataOffer) Finish(r *server.DataOffer) {}
+
+func (o *dataOffer) SetActions(r *server.DataOffer, dndActions, preferredAction server.DataDeviceManagerDndAction) {
+}
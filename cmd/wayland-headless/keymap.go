@@ -0,0 +1,195 @@
+package main
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// keymap is the xkb_v1 keymap sent to keyboards: a US layout of the
+// keys of a pc105 keyboard, keycodes are linux key codes + 8. It is
+// self-contained so that clients compile it without the xkb data
+// files.
+const keymap = `xkb_keymap {
+	xkb_keycodes "headless" {
+		minimum = 8;
+		maximum = 255;
+
+		<ESC> = 9;
+		<AE01> = 10; <AE02> = 11; <AE03> = 12; <AE04> = 13; <AE05> = 14;
+		<AE06> = 15; <AE07> = 16; <AE08> = 17; <AE09> = 18; <AE10> = 19;
+		<AE11> = 20; <AE12> = 21; <BKSP> = 22;
+		<TAB> = 23;
+		<AD01> = 24; <AD02> = 25; <AD03> = 26; <AD04> = 27; <AD05> = 28;
+		<AD06> = 29; <AD07> = 30; <AD08> = 31; <AD09> = 32; <AD10> = 33;
+		<AD11> = 34; <AD12> = 35; <RTRN> = 36;
+		<LCTL> = 37;
+		<AC01> = 38; <AC02> = 39; <AC03> = 40; <AC04> = 41; <AC05> = 42;
+		<AC06> = 43; <AC07> = 44; <AC08> = 45; <AC09> = 46; <AC10> = 47;
+		<AC11> = 48; <TLDE> = 49;
+		<LFSH> = 50; <BKSL> = 51;
+		<AB01> = 52; <AB02> = 53; <AB03> = 54; <AB04> = 55; <AB05> = 56;
+		<AB06> = 57; <AB07> = 58; <AB08> = 59; <AB09> = 60; <AB10> = 61;
+		<RTSH> = 62;
+		<LALT> = 64; <SPCE> = 65; <CAPS> = 66;
+		<FK01> = 67; <FK02> = 68; <FK03> = 69; <FK04> = 70; <FK05> = 71;
+		<FK06> = 72; <FK07> = 73; <FK08> = 74; <FK09> = 75; <FK10> = 76;
+		<FK11> = 95; <FK12> = 96;
+		<RCTL> = 105; <RALT> = 108;
+		<HOME> = 110; <UP> = 111; <PGUP> = 112; <LEFT> = 113; <RGHT> = 114;
+		<END> = 115; <DOWN> = 116; <PGDN> = 117; <INS> = 118; <DELE> = 119;
+		<LWIN> = 133;
+	};
+
+	xkb_types "headless" {
+		type "ONE_LEVEL" {
+			modifiers = none;
+			level_name[Level1] = "Any";
+		};
+		type "TWO_LEVEL" {
+			modifiers = Shift;
+			map[Shift] = Level2;
+			level_name[Level1] = "Base";
+			level_name[Level2] = "Shift";
+		};
+		type "ALPHABETIC" {
+			modifiers = Shift + Lock;
+			map[Shift] = Level2;
+			map[Lock] = Level2;
+			level_name[Level1] = "Base";
+			level_name[Level2] = "Caps";
+		};
+	};
+
+	xkb_compatibility "headless" {
+		interpret Caps_Lock {
+			action = LockMods(modifiers = Lock);
+		};
+		interpret Any + AnyOf(all) {
+			action = SetMods(modifiers = modMapMods, clearLocks);
+		};
+	};
+
+	xkb_symbols "headless" {
+		name[Group1] = "English (US)";
+
+		key <ESC> { [ Escape ] };
+		key <AE01> { [ 1, exclam ] };
+		key <AE02> { [ 2, at ] };
+		key <AE03> { [ 3, numbersign ] };
+		key <AE04> { [ 4, dollar ] };
+		key <AE05> { [ 5, percent ] };
+		key <AE06> { [ 6, asciicircum ] };
+		key <AE07> { [ 7, ampersand ] };
+		key <AE08> { [ 8, asterisk ] };
+		key <AE09> { [ 9, parenleft ] };
+		key <AE10> { [ 0, parenright ] };
+		key <AE11> { [ minus, underscore ] };
+		key <AE12> { [ equal, plus ] };
+		key <BKSP> { [ BackSpace ] };
+		key <TAB> { [ Tab, ISO_Left_Tab ] };
+		key <AD01> { [ q, Q ] };
+		key <AD02> { [ w, W ] };
+		key <AD03> { [ e, E ] };
+		key <AD04> { [ r, R ] };
+		key <AD05> { [ t, T ] };
+		key <AD06> { [ y, Y ] };
+		key <AD07> { [ u, U ] };
+		key <AD08> { [ i, I ] };
+		key <AD09> { [ o, O ] };
+		key <AD10> { [ p, P ] };
+		key <AD11> { [ bracketleft, braceleft ] };
+		key <AD12> { [ bracketright, braceright ] };
+		key <RTRN> { [ Return ] };
+		key <LCTL> { [ Control_L ] };
+		key <AC01> { [ a, A ] };
+		key <AC02> { [ s, S ] };
+		key <AC03> { [ d, D ] };
+		key <AC04> { [ f, F ] };
+		key <AC05> { [ g, G ] };
+		key <AC06> { [ h, H ] };
+		key <AC07> { [ j, J ] };
+		key <AC08> { [ k, K ] };
+		key <AC09> { [ l, L ] };
+		key <AC10> { [ semicolon, colon ] };
+		key <AC11> { [ apostrophe, quotedbl ] };
+		key <TLDE> { [ grave, asciitilde ] };
+		key <LFSH> { [ Shift_L ] };
+		key <BKSL> { [ backslash, bar ] };
+		key <AB01> { [ z, Z ] };
+		key <AB02> { [ x, X ] };
+		key <AB03> { [ c, C ] };
+		key <AB04> { [ v, V ] };
+		key <AB05> { [ b, B ] };
+		key <AB06> { [ n, N ] };
+		key <AB07> { [ m, M ] };
+		key <AB08> { [ comma, less ] };
+		key <AB09> { [ period, greater ] };
+		key <AB10> { [ slash, question ] };
+		key <RTSH> { [ Shift_R ] };
+		key <LALT> { [ Alt_L ] };
+		key <SPCE> { [ space ] };
+		key <CAPS> { [ Caps_Lock ] };
+		key <FK01> { [ F1 ] };
+		key <FK02> { [ F2 ] };
+		key <FK03> { [ F3 ] };
+		key <FK04> { [ F4 ] };
+		key <FK05> { [ F5 ] };
+		key <FK06> { [ F6 ] };
+		key <FK07> { [ F7 ] };
+		key <FK08> { [ F8 ] };
+		key <FK09> { [ F9 ] };
+		key <FK10> { [ F10 ] };
+		key <FK11> { [ F11 ] };
+		key <FK12> { [ F12 ] };
+		key <RCTL> { [ Control_R ] };
+		key <RALT> { [ Alt_R ] };
+		key <HOME> { [ Home ] };
+		key <UP> { [ Up ] };
+		key <PGUP> { [ Prior ] };
+		key <LEFT> { [ Left ] };
+		key <RGHT> { [ Right ] };
+		key <END> { [ End ] };
+		key <DOWN> { [ Down ] };
+		key <PGDN> { [ Next ] };
+		key <INS> { [ Insert ] };
+		key <DELE> { [ Delete ] };
+		key <LWIN> { [ Super_L ] };
+
+		modifier_map Shift { <LFSH>, <RTSH> };
+		modifier_map Lock { <CAPS> };
+		modifier_map Control { <LCTL>, <RCTL> };
+		modifier_map Mod1 { <LALT>, <RALT> };
+		modifier_map Mod4 { <LWIN> };
+	};
+};
+`
+
+// keymapFile returns a sealed memfd holding keymap, NUL-terminated as
+// wl_keyboard.keymap requires, and its size
+func keymapFile() (int, uint32, error) {
+	fd, err := unix.MemfdCreate("headless-keymap", unix.MFD_CLOEXEC|unix.MFD_ALLOW_SEALING)
+	if err != nil {
+		return -1, 0, err
+	}
+
+	b := append([]byte(keymap), 0)
+	for off := 0; off < len(b); {
+		n, err := unix.Write(fd, b[off:])
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			unix.Close(fd)
+			return -1, 0, err
+		}
+		off += n
+	}
+
+	// Clients map the keymap, it must not shrink under them
+	seals := unix.F_SEAL_SHRINK | unix.F_SEAL_GROW | unix.F_SEAL_WRITE | unix.F_SEAL_SEAL
+	if _, err := unix.FcntlInt(uintptr(fd), unix.F_ADD_SEALS, seals); err != nil {
+		unix.Close(fd)
+		return -1, 0, err
+	}
+
+	return fd, uint32(len(b)), nil
+}
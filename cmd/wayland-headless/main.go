@@ -0,0 +1,177 @@
+// Command wayland-headless is a compositor running without GPU nor
+// display, for end to end tests of wayland clients in CI.
+//
+//	wayland-headless [-socket name] [-outputs 1920x1080,...] [-control path] [command [args...]]
+//
+// It implements wl_compositor, wl_subcompositor, wl_shm, wl_seat,
+// wl_output, wl_data_device_manager and xdg-shell. Surfaces are
+// composited in memory from their shm buffers, the outputs are laid
+// out left to right. Keyboards get a US keymap.
+//
+// Tests drive it through the control socket, one command per line:
+//
+//	dump <file.png> [output]       composite an output to a PNG file
+//	motion <x> <y>                 move the pointer, in global coordinates
+//	button <code> press|release    linux button code, e.g. 272 for BTN_LEFT
+//	axis vertical|horizontal <v>   scroll
+//	key <code> press|release       linux key code, e.g. 30 for KEY_A
+//	windows                        list toplevels: id x y width height "app_id" "title"
+//	focus <id>                     raise and focus a toplevel
+//	close <id>                     ask a toplevel to close
+//
+// Each command is answered by its output lines, if any, then "ok" or
+// "error: <reason>". The app_id and title of windows are quoted Go
+// strings, they may contain spaces.
+//
+// The display name is printed as WAYLAND_DISPLAY=<name> once the
+// compositor accepts clients. Given a command, the compositor runs it
+// with WAYLAND_DISPLAY and WAYLAND_HEADLESS_CONTROL set and exits with
+// its exit status.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+var (
+	socketName  string
+	outputSpecs string
+	controlPath string
+	refreshRate int
+)
+
+func init() {
+	flag.StringVar(&socketName, "socket", "", "Display name, the first free wayland-N by default")
+	flag.StringVar(&outputSpecs, "outputs", "1920x1080", "Comma separated sizes of the outputs, WIDTHxHEIGHT[@SCALE]")
+	flag.StringVar(&controlPath, "control", "", "Path of the control socket, <display socket>.control by default")
+	flag.IntVar(&refreshRate, "refresh", 60, "Refresh rate of the outputs in Hz, paces frame callbacks")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] [command [args...]]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Parse()
+
+	modes, err := parseOutputs(outputSpecs)
+	if err != nil {
+		log.Fatalf("invalid -outputs: %v", err)
+	}
+	if refreshRate <= 0 {
+		log.Fatalf("invalid -refresh %d", refreshRate)
+	}
+
+	var sock *server.Socket
+	if socketName == "" {
+		sock, err = server.ListenAuto()
+	} else {
+		sock, err = server.Listen(socketName)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if controlPath == "" {
+		controlPath = sock.Path() + ".control"
+	}
+	os.Remove(controlPath)
+	control, err := net.Listen("unix", controlPath)
+	if err != nil {
+		sock.Close()
+		log.Fatalf("unable to create control socket: %v", err)
+	}
+
+	c, err := newCompositor(server.New(), modes, refreshRate)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go c.serveControl(control)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- c.server.Serve(sock)
+	}()
+	fmt.Printf("WAYLAND_DISPLAY=%s\n", sock.Name())
+
+	status := 0
+	if flag.NArg() > 0 {
+		status = run(flag.Args(), sock.Name())
+	} else if err := <-errs; err != nil && !errors.Is(err, server.ErrServerClosed) {
+		log.Print(err)
+		status = 1
+	}
+
+	control.Close()
+	c.close()
+	os.Exit(status)
+}
+
+// run runs the command connected to the compositor and returns its exit
+// status
+func run(args []string, display string) int {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "WAYLAND_DISPLAY="+display, "WAYLAND_HEADLESS_CONTROL="+controlPath)
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	return 0
+}
+
+// outputMode is the size and scale of an output
+type outputMode struct {
+	width, height int32
+	scale         int32
+}
+
+// parseOutputs parses a comma separated list of WIDTHxHEIGHT[@SCALE]
+func parseOutputs(specs string) ([]outputMode, error) {
+	var modes []outputMode
+	for _, spec := range strings.Split(specs, ",") {
+		m := outputMode{scale: 1}
+
+		size, scale, hasScale := strings.Cut(spec, "@")
+		if hasScale {
+			v, err := strconv.Atoi(scale)
+			if err != nil || v <= 0 {
+				return nil, fmt.Errorf("invalid scale %q", scale)
+			}
+			m.scale = int32(v)
+		}
+		w, h, ok := strings.Cut(size, "x")
+		if !ok {
+			return nil, fmt.Errorf("invalid size %q", size)
+		}
+		width, err1 := strconv.Atoi(w)
+		height, err2 := strconv.Atoi(h)
+		if err1 != nil || err2 != nil || width <= 0 || height <= 0 {
+			return nil, fmt.Errorf("invalid size %q", size)
+		}
+		m.width, m.height = int32(width), int32(height)
+
+		modes = append(modes, m)
+	}
+
+	return modes, nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+type output struct {
+	c    *compositor
+	name string
+	// x is the position of the output in the global space, outputs are
+	// laid out left to right
+	x    int32
+	mode outputMode
+	// refresh is the refresh rate in mHz
+	refresh int32
+
+	resources []*server.Output
+}
+
+// rect returns the area of the output in the global space
+func (o *output) rect() rect {
+	return rect{o.x, 0, o.mode.width / o.mode.scale, o.mode.height / o.mode.scale}
+}
+
+func (o *output) bind(r server.Resource) {
+	res := r.(*server.Output)
+	res.SetHandler(outputHandler{})
+	o.resources = append(o.resources, res)
+	res.AddDestroyHandler(func() {
+		for i, v := range o.resources {
+			if v == res {
+				o.resources = append(o.resources[:i], o.resources[i+1:]...)
+				break
+			}
+		}
+	})
+
+	res.SendGeometry(o.x, 0, 0, 0, server.OutputSubpixelUnknown, "go-wayland", "headless", server.OutputTransformNormal)
+	res.SendMode(server.OutputModeCurrent|server.OutputModePreferred, o.mode.width, o.mode.height, o.refresh)
+	// Events of later versions fail with a VersionError, which is fine
+	res.SendScale(o.mode.scale)
+	res.SendName(o.name)
+	res.SendDescription("Headless output " + o.name)
+	res.SendDone()
+
+	// Surfaces of the client mapped before it bound the output
+	for _, v := range o.c.views {
+		if v.res.Client() == res.Client() && v.outputs[o] {
+			v.res.SendEnter(res)
+		}
+	}
+}
+
+// outputHandler implements wl_output, release is a destructor
+type outputHandler struct{}
+
+func (outputHandler) Release(r *server.Output) {}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+)
+
+// background is the color of the outputs where no surface is
+var background = color.RGBA{0x20, 0x20, 0x20, 0xff}
+
+// composite draws the views on the output
+func (c *compositor) composite(o *output) *image.RGBA {
+	scale := int(o.mode.scale)
+	img := image.NewRGBA(image.Rect(0, 0, int(o.mode.width), int(o.mode.height)))
+	draw.Draw(img, img.Bounds(), &image.Uniform{background}, image.Point{}, draw.Src)
+
+	for _, v := range c.views {
+		c.drawTree(img, v, int(o.x), scale)
+	}
+
+	return img
+}
+
+// drawTree draws s and its subsurfaces in stacking order, ox is the
+// position of the output in the global space
+func (c *compositor) drawTree(dst *image.RGBA, s *surface, ox int, scale int) {
+	for _, v := range s.order {
+		if v != s {
+			c.drawTree(dst, v, ox, scale)
+			continue
+		}
+		if s.image == nil {
+			continue
+		}
+
+		x, y := s.origin()
+		pos := image.Pt((int(x)-ox)*scale, int(y)*scale)
+		src := s.image
+		if int(s.scale) != scale {
+			src = resize(src, int(s.scale), scale)
+		}
+		draw.Draw(dst, src.Bounds().Add(pos), src, image.Point{}, draw.Over)
+	}
+}
+
+// resize scales a buffer of scale from to the output scale to, with
+// nearest neighbour sampling
+func resize(src *image.RGBA, from, to int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx()*to/from, b.Dy()*to/from
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			i := src.PixOffset(x*from/to, y*from/to)
+			copy(dst.Pix[dst.PixOffset(x, y):], src.Pix[i:i+4])
+		}
+	}
+	return dst
+}
+
+// writePNG writes a composited output to a PNG file
+func writePNG(img image.Image, file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return fmt.Errorf("unable to encode %s: %w", file, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"log"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+	"golang.org/x/sys/unix"
+)
+
+// seat implements the single wl_seat, with a pointer and a keyboard
+// driven by the control socket
+type seat struct {
+	c *compositor
+
+	pointers  []*server.Pointer
+	keyboards []*server.Keyboard
+
+	// x and y are the pointer position in the global space
+	x, y          float64
+	pointerFocus  *surface
+	buttons       int
+	keyboardFocus *surface
+	keys          []uint32
+}
+
+func (st *seat) bind(r server.Resource) {
+	res := r.(*server.Seat)
+	res.SetHandler(seatHandler{st})
+	res.SendCapabilities(server.SeatCapabilityPointer | server.SeatCapabilityKeyboard)
+	res.SendName("seat0")
+}
+
+// seatHandler implements wl_seat
+type seatHandler struct {
+	st *seat
+}
+
+func (h seatHandler) GetPointer(r *server.Seat, id *server.Pointer) {
+	st := h.st
+	id.SetHandler(pointerHandler{st})
+	st.pointers = append(st.pointers, id)
+	id.AddDestroyHandler(func() {
+		st.pointers = removeResource(st.pointers, id)
+	})
+
+	if f := st.pointerFocus; f != nil && f.res.Client() == id.Client() {
+		x, y := f.origin()
+		id.SendEnter(st.c.server.NextSerial(), f.res, st.x-float64(x), st.y-float64(y))
+		id.SendFrame()
+	}
+}
+
+func (h seatHandler) GetKeyboard(r *server.Seat, id *server.Keyboard) {
+	st := h.st
+	id.SetHandler(keyboardHandler{})
+	st.keyboards = append(st.keyboards, id)
+	id.AddDestroyHandler(func() {
+		st.keyboards = removeResource(st.keyboards, id)
+	})
+
+	if fd, size, err := keymapFile(); err == nil {
+		id.SendKeymap(server.KeyboardKeymapFormatXkbV1, fd, size)
+		unix.Close(fd)
+	} else {
+		log.Printf("unable to create keymap: %v", err)
+	}
+	id.SendRepeatInfo(25, 600)
+
+	if f := st.keyboardFocus; f != nil && f.res.Client() == id.Client() {
+		serial := st.c.server.NextSerial()
+		id.SendEnter(serial, f.res, keysArray(st.keys))
+		id.SendModifiers(serial, 0, 0, 0, 0)
+	}
+}
+
+func (seatHandler) GetTouch(r *server.Seat, id *server.Touch) {
+	r.PostError(uint32(server.SeatErrorMissingCapability), "seat has no touch capability")
+}
+
+func (seatHandler) Release(r *server.Seat) {}
+
+// pointerHandler implements wl_pointer
+type pointerHandler struct {
+	st *seat
+}
+
+func (h pointerHandler) SetCursor(r *server.Pointer, serial uint32, surface_ *server.Surface, hotspotX, hotspotY int32) {
+	if surface_ == nil {
+		return
+	}
+	s := surface_.Handler().(*surface)
+	if s.sub != nil || s.xdg != nil {
+		r.PostError(uint32(server.PointerErrorRole), "wl_surface@%d already has a role", surface_.ID())
+		return
+	}
+	s.cursor = true
+}
+
+func (pointerHandler) Release(r *server.Pointer) {}
+
+// keyboardHandler implements wl_keyboard
+type keyboardHandler struct{}
+
+func (keyboardHandler) Release(r *server.Keyboard) {}
+
+// pointersOf returns the wl_pointer resources of the client of s
+func (st *seat) pointersOf(s *surface) []*server.Pointer {
+	var pointers []*server.Pointer
+	for _, p := range st.pointers {
+		if p.Client() == s.res.Client() {
+			pointers = append(pointers, p)
+		}
+	}
+	return pointers
+}
+
+func (st *seat) keyboardsOf(s *surface) []*server.Keyboard {
+	var keyboards []*server.Keyboard
+	for _, k := range st.keyboards {
+		if k.Client() == s.res.Client() {
+			keyboards = append(keyboards, k)
+		}
+	}
+	return keyboards
+}
+
+// updatePointerFocus moves the pointer focus to the surface under the
+// pointer, unless a button is held
+func (st *seat) updatePointerFocus() {
+	if st.pointerFocus != nil && st.pointerFocus.destroyed {
+		st.pointerFocus = nil
+	}
+	if st.buttons > 0 && st.pointerFocus != nil {
+		return
+	}
+
+	s, sx, sy := st.c.surfaceAt(st.x, st.y)
+	if s == st.pointerFocus {
+		return
+	}
+
+	if old := st.pointerFocus; old != nil {
+		serial := st.c.server.NextSerial()
+		for _, p := range st.pointersOf(old) {
+			p.SendLeave(serial, old.res)
+			p.SendFrame()
+		}
+	}
+	st.pointerFocus = s
+	if s != nil {
+		serial := st.c.server.NextSerial()
+		for _, p := range st.pointersOf(s) {
+			p.SendEnter(serial, s.res, sx, sy)
+			p.SendFrame()
+		}
+	}
+}
+
+// motion moves the pointer to the global position
+func (st *seat) motion(x, y float64) {
+	st.x, st.y = x, y
+	focus := st.pointerFocus
+	st.updatePointerFocus()
+
+	s := st.pointerFocus
+	if s == nil || s != focus {
+		return
+	}
+	ox, oy := s.origin()
+	now := st.c.now()
+	for _, p := range st.pointersOf(s) {
+		p.SendMotion(now, x-float64(ox), y-float64(oy))
+		p.SendFrame()
+	}
+}
+
+func (st *seat) button(button uint32, pressed bool) {
+	state := server.PointerButtonStateReleased
+	if pressed {
+		state = server.PointerButtonStatePressed
+		st.buttons++
+	} else if st.buttons > 0 {
+		st.buttons--
+	}
+
+	s := st.pointerFocus
+	if s == nil {
+		return
+	}
+	if pressed {
+		// Click to focus
+		root := s.root()
+		if root.toplevel() != nil {
+			st.c.raise(root)
+			st.setKeyboardFocus(root)
+		}
+	}
+
+	serial := st.c.server.NextSerial()
+	now := st.c.now()
+	for _, p := range st.pointersOf(s) {
+		p.SendButton(serial, now, button, state)
+		p.SendFrame()
+	}
+
+	if !pressed && st.buttons == 0 {
+		st.updatePointerFocus()
+	}
+}
+
+func (st *seat) axis(axis server.PointerAxis, value float64) {
+	s := st.pointerFocus
+	if s == nil {
+		return
+	}
+	now := st.c.now()
+	for _, p := range st.pointersOf(s) {
+		p.SendAxisSource(server.PointerAxisSourceWheel)
+		p.SendAxis(now, axis, value)
+		p.SendFrame()
+	}
+}
+
+func (st *seat) key(key uint32, pressed bool) {
+	state := server.KeyboardKeyStateReleased
+	if pressed {
+		state = server.KeyboardKeyStatePressed
+		st.keys = append(st.keys, key)
+	} else {
+		for i, k := range st.keys {
+			if k == key {
+				st.keys = append(st.keys[:i], st.keys[i+1:]...)
+				break
+			}
+		}
+	}
+
+	s := st.keyboardFocus
+	if s == nil {
+		return
+	}
+	serial := st.c.server.NextSerial()
+	now := st.c.now()
+	for _, k := range st.keyboardsOf(s) {
+		k.SendKey(serial, now, key, state)
+	}
+}
+
+// setKeyboardFocus moves the keyboard focus to the toplevel surface s,
+// which may be nil
+func (st *seat) setKeyboardFocus(s *surface) {
+	if s == st.keyboardFocus {
+		return
+	}
+
+	if old := st.keyboardFocus; old != nil {
+		if !old.destroyed {
+			serial := st.c.server.NextSerial()
+			for _, k := range st.keyboardsOf(old) {
+				k.SendLeave(serial, old.res)
+			}
+		}
+		if t := old.toplevel(); t != nil {
+			t.setActivated(false)
+		}
+	}
+
+	st.keyboardFocus = s
+	if s == nil {
+		return
+	}
+
+	serial := st.c.server.NextSerial()
+	for _, k := range st.keyboardsOf(s) {
+		k.SendEnter(serial, s.res, keysArray(st.keys))
+		k.SendModifiers(serial, 0, 0, 0, 0)
+	}
+	if t := s.toplevel(); t != nil {
+		t.setActivated(true)
+	}
+	st.c.sendSelection(s.res.Client())
+}
+
+// keysArray encodes the pressed keys for wl_keyboard.enter
+func keysArray(keys []uint32) []byte {
+	b := make([]byte, 4*len(keys))
+	for i, k := range keys {
+		server.PutUint32(b[i*4:], k)
+	}
+	return b
+}
+
+func removeResource[T comparable](list []T, r T) []T {
+	for i, v := range list {
+		if v == r {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"image"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+// copyImage returns a copy of the contents of an argb8888 or xrgb8888
+// buffer, nil if it is destroyed or can't be read
+func copyImage(b *server.ShmBuffer) *image.RGBA {
+	width, height, stride := int(b.Width()), int(b.Height()), int(b.Stride())
+	// The alpha of xrgb8888 buffers is ignored
+	opaque := b.Format() == server.ShmFormatXrgb8888
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	err := b.Access(func(data []byte) {
+		for y := 0; y < height; y++ {
+			src := data[y*stride:]
+			dst := img.Pix[y*img.Stride:]
+			for x := 0; x < width; x++ {
+				// Little endian [a]rgb8888, both premultiplied like image.RGBA
+				px := src[x*4 : x*4+4]
+				a := px[3]
+				if opaque {
+					a = 0xff
+				}
+				dst[x*4+0] = px[2]
+				dst[x*4+1] = px[1]
+				dst[x*4+2] = px[0]
+				dst[x*4+3] = a
+			}
+		}
+	})
+	if err != nil {
+		return nil
+	}
+
+	return img
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"image"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+// compositorHandler implements wl_compositor
+type compositorHandler struct {
+	c *compositor
+}
+
+func (h compositorHandler) CreateSurface(r *server.Compositor, id *server.Surface) {
+	s := &surface{
+		c:       h.c,
+		res:     id,
+		scale:   1,
+		outputs: map[*output]bool{},
+	}
+	s.order = []*surface{s}
+	id.SetHandler(s)
+	id.AddDestroyHandler(s.destroy)
+}
+
+func (compositorHandler) CreateRegion(r *server.Compositor, id *server.Region) {
+	id.SetHandler(regionHandler{})
+}
+
+// regionHandler implements wl_region. Input and opaque regions are
+// not used: surfaces accept input on their whole area.
+type regionHandler struct{}
+
+func (regionHandler) Destroy(r *server.Region) {}
+
+func (regionHandler) Add(r *server.Region, x, y, width, height int32) {}
+
+func (regionHandler) Subtract(r *server.Region, x, y, width, height int32) {}
+
+// surfaceState is the state of a surface applied on commit
+type surfaceState struct {
+	attached bool
+	buffer   *server.ShmBuffer
+	// scale is 0 if unchanged
+	scale  int32
+	frames []*server.Callback
+}
+
+// surface implements wl_surface
+type surface struct {
+	c   *compositor
+	res *server.Surface
+
+	pending surfaceState
+	// image holds the contents of the committed buffer, nil if none
+	image *image.RGBA
+	scale int32
+
+	// Roles, at most one is set
+	sub    *subsurface
+	xdg    *xdgSurface
+	cursor bool
+
+	// order is the stacking order of the surface and its subsurfaces,
+	// bottom to top
+	order []*surface
+	// outputs are the outputs the surface was sent enter for
+	outputs   map[*output]bool
+	destroyed bool
+}
+
+func (s *surface) hasRole() bool {
+	return s.sub != nil || s.xdg != nil || s.cursor
+}
+
+func (s *surface) toplevel() *toplevel {
+	if s.xdg != nil {
+		return s.xdg.toplevel
+	}
+	return nil
+}
+
+func (s *surface) popup() *popup {
+	if s.xdg != nil {
+		return s.xdg.popup
+	}
+	return nil
+}
+
+// size returns the size of the surface in surface coordinates
+func (s *surface) size() (width, height int32) {
+	if s.image == nil {
+		return 0, 0
+	}
+	b := s.image.Bounds()
+	return int32(b.Dx()) / s.scale, int32(b.Dy()) / s.scale
+}
+
+// origin returns the position of the surface in the global space
+func (s *surface) origin() (x, y int32) {
+	switch {
+	case s.sub != nil:
+		x, y := s.sub.parent.origin()
+		return x + s.sub.x, y + s.sub.y
+	case s.xdg != nil:
+		return s.xdg.origin()
+	}
+	return 0, 0
+}
+
+// surfaceAt returns the surface of the tree of s at the global position
+// and the position relative to it
+func (s *surface) surfaceAt(x, y float64) (*surface, float64, float64) {
+	for i := len(s.order) - 1; i >= 0; i-- {
+		v := s.order[i]
+		if v != s {
+			if r, sx, sy := v.surfaceAt(x, y); r != nil {
+				return r, sx, sy
+			}
+			continue
+		}
+		if s.image == nil {
+			continue
+		}
+		ox, oy := s.origin()
+		w, h := s.size()
+		if (rect{ox, oy, w, h}).contains(x, y) {
+			return s, x - float64(ox), y - float64(oy)
+		}
+	}
+	return nil, 0, 0
+}
+
+// root returns the toplevel or popup surface of the tree of s
+func (s *surface) root() *surface {
+	for s.sub != nil {
+		s = s.sub.parent
+	}
+	return s
+}
+
+func (s *surface) Destroy(r *server.Surface) {}
+
+func (s *surface) Attach(r *server.Surface, b *server.Buffer, x, y int32) {
+	s.pending.attached = true
+	s.pending.buffer = nil
+	if b != nil {
+		s.pending.buffer = server.GetShmBuffer(b)
+	}
+}
+
+func (s *surface) Damage(r *server.Surface, x, y, width, height int32) {}
+
+func (s *surface) DamageBuffer(r *server.Surface, x, y, width, height int32) {}
+
+func (s *surface) Frame(r *server.Surface, callback *server.Callback) {
+	s.pending.frames = append(s.pending.frames, callback)
+}
+
+func (s *surface) SetOpaqueRegion(r *server.Surface, region *server.Region) {}
+
+func (s *surface) SetInputRegion(r *server.Surface, region *server.Region) {}
+
+func (s *surface) SetBufferTransform(r *server.Surface, transform server.OutputTransform) {
+	if transform > server.OutputTransformFlipped270 {
+		r.PostError(uint32(server.SurfaceErrorInvalidTransform), "invalid transform %d", transform)
+	}
+}
+
+func (s *surface) SetBufferScale(r *server.Surface, scale int32) {
+	if scale <= 0 {
+		r.PostError(uint32(server.SurfaceErrorInvalidScale), "invalid scale %d", scale)
+		return
+	}
+	s.pending.scale = scale
+}
+
+func (s *surface) Offset(r *server.Surface, x, y int32) {}
+
+func (s *surface) Commit(r *server.Surface) {
+	if s.xdg != nil && !s.xdg.checkCommit() {
+		return
+	}
+
+	if s.pending.scale != 0 {
+		s.scale = s.pending.scale
+	}
+	if s.pending.attached {
+		s.image = nil
+		if b := s.pending.buffer; b != nil {
+			if s.image = copyImage(b); s.image != nil {
+				b.Buffer().SendRelease()
+			}
+		}
+		if s.image != nil {
+			if b := s.image.Bounds(); b.Dx()%int(s.scale) != 0 || b.Dy()%int(s.scale) != 0 {
+				r.PostError(uint32(server.SurfaceErrorInvalidSize), "buffer size %dx%d is not a multiple of the scale %d", b.Dx(), b.Dy(), s.scale)
+				return
+			}
+		}
+	}
+	s.c.frames = append(s.c.frames, s.pending.frames...)
+	s.pending = surfaceState{}
+
+	// Subsurface positions are double buffered by the parent
+	for _, v := range s.order {
+		if v != s && v.sub != nil {
+			v.sub.x, v.sub.y = v.sub.pendingX, v.sub.pendingY
+		}
+	}
+
+	if s.xdg != nil {
+		s.xdg.commit()
+	}
+	s.c.seat.updatePointerFocus()
+}
+
+// destroy is called once the surface resource is destroyed, by request
+// or because the client disconnected
+func (s *surface) destroy() {
+	s.destroyed = true
+	if s.xdg != nil {
+		s.xdg.unmap()
+	}
+	if s.sub != nil {
+		s.sub.detach()
+	}
+	for _, v := range s.order {
+		if v != s && v.sub != nil {
+			v.sub.parent = nil
+			v.sub = nil
+		}
+	}
+	s.order = nil
+
+	if s.c.seat.pointerFocus == s {
+		s.c.seat.pointerFocus = nil
+	}
+	s.c.seat.updatePointerFocus()
+}
+
+// subcompositorHandler implements wl_subcompositor
+type subcompositorHandler struct {
+	c *compositor
+}
+
+func (subcompositorHandler) Destroy(r *server.Subcompositor) {}
+
+func (subcompositorHandler) GetSubsurface(r *server.Subcompositor, id *server.Subsurface, surface_, parent *server.Surface) {
+	s := surface_.Handler().(*surface)
+	p := parent.Handler().(*surface)
+
+	if s.hasRole() {
+		r.PostError(uint32(server.SubcompositorErrorBadSurface), "wl_surface@%d already has a role", surface_.ID())
+		return
+	}
+	for v := p; v != nil; {
+		if v == s {
+			r.PostError(uint32(server.SubcompositorErrorBadSurface), "wl_surface@%d is an ancestor of its parent", surface_.ID())
+			return
+		}
+		if v.sub == nil {
+			break
+		}
+		v = v.sub.parent
+	}
+
+	sub := &subsurface{surface: s, parent: p}
+	s.sub = sub
+	p.order = append(p.order, s)
+	id.SetHandler(sub)
+	id.AddDestroyHandler(sub.detach)
+}
+
+// subsurface implements wl_subsurface. Subsurfaces are always
+// desynchronized: their commits apply right away.
+type subsurface struct {
+	surface *surface
+	parent  *surface
+
+	x, y               int32
+	pendingX, pendingY int32
+}
+
+// detach removes the subsurface from its parent, it is unmapped
+func (sub *subsurface) detach() {
+	if sub.parent != nil {
+		sub.parent.removeChild(sub.surface)
+		sub.parent = nil
+	}
+	if sub.surface.sub == sub {
+		sub.surface.sub = nil
+	}
+}
+
+func (s *surface) removeChild(child *surface) {
+	for i, v := range s.order {
+		if v == child {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			return
+		}
+	}
+}
+
+func (sub *subsurface) Destroy(r *server.Subsurface) {}
+
+func (sub *subsurface) SetPosition(r *server.Subsurface, x, y int32) {
+	sub.pendingX, sub.pendingY = x, y
+}
+
+func (sub *subsurface) PlaceAbove(r *server.Subsurface, sibling *server.Surface) {
+	sub.place(r, sibling, 1)
+}
+
+func (sub *subsurface) PlaceBelow(r *server.Subsurface, sibling *server.Surface) {
+	sub.place(r, sibling, 0)
+}
+
+// place moves the subsurface right above (offset 1) or below (offset 0)
+// its sibling, which is either its parent or another of its children
+func (sub *subsurface) place(r *server.Subsurface, sibling *server.Surface, offset int) {
+	p := sub.parent
+	if p == nil {
+		return
+	}
+	sib := sibling.Handler().(*surface)
+	if sib == sub.surface || (sib != p && (sib.sub == nil || sib.sub.parent != p)) {
+		r.PostError(uint32(server.SubsurfaceErrorBadSurface), "wl_surface@%d is not a sibling", sibling.ID())
+		return
+	}
+
+	p.removeChild(sub.surface)
+	for i, v := range p.order {
+		if v == sib {
+			i += offset
+			p.order = append(p.order[:i], append([]*surface{sub.surface}, p.order[i:]...)...)
+			return
+		}
+	}
+}
+
+func (sub *subsurface) SetSync(r *server.Subsurface) {}
+
+func (sub *subsurface) SetDesync(r *server.Subsurface) {}
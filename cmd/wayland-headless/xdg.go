@@ -0,0 +1,510 @@
+package main
+
+import (
+	"github.com/hempflower/go-wayland/wayland/server"
+	xdg_shell "github.com/hempflower/go-wayland/wayland/server/stable/xdg-shell"
+)
+
+// wmBaseHandler implements xdg_wm_base
+type wmBaseHandler struct {
+	c *compositor
+}
+
+func (wmBaseHandler) Destroy(r *xdg_shell.WmBase) {}
+
+func (wmBaseHandler) CreatePositioner(r *xdg_shell.WmBase, id *xdg_shell.Positioner) {
+	id.SetHandler(&positioner{})
+}
+
+func (h wmBaseHandler) GetXdgSurface(r *xdg_shell.WmBase, id *xdg_shell.Surface, surface_ *server.Surface) {
+	s := surface_.Handler().(*surface)
+	if s.hasRole() {
+		r.PostError(uint32(xdg_shell.WmBaseErrorRole), "wl_surface@%d already has a role", surface_.ID())
+		return
+	}
+
+	x := &xdgSurface{c: h.c, res: id, surface: s}
+	s.xdg = x
+	id.SetHandler(x)
+	id.AddDestroyHandler(x.destroy)
+}
+
+func (wmBaseHandler) Pong(r *xdg_shell.WmBase, serial uint32) {}
+
+// xdgSurface implements xdg_surface
+type xdgSurface struct {
+	c       *compositor
+	res     *xdg_shell.Surface
+	surface *surface
+
+	// Role objects, at most one is set
+	toplevel *toplevel
+	popup    *popup
+
+	// configureSerial is the serial of the last configure sent, 0
+	// until the initial configure
+	configureSerial uint32
+	acked           bool
+	mapped          bool
+
+	geometry, pendingGeometry *rect
+}
+
+func (x *xdgSurface) Destroy(r *xdg_shell.Surface) {
+	if x.toplevel != nil || x.popup != nil {
+		r.PostError(uint32(xdg_shell.SurfaceErrorDefunctRoleObject), "xdg_surface destroyed before its role object")
+	}
+}
+
+func (x *xdgSurface) GetToplevel(r *xdg_shell.Surface, id *xdg_shell.Toplevel) {
+	if x.toplevel != nil || x.popup != nil {
+		r.PostError(uint32(xdg_shell.SurfaceErrorAlreadyConstructed), "xdg_surface already has a role object")
+		return
+	}
+
+	x.c.nextWindowID++
+	t := &toplevel{xdg: x, res: id, id: x.c.nextWindowID}
+	x.toplevel = t
+	id.SetHandler(t)
+	id.AddDestroyHandler(t.destroy)
+}
+
+func (x *xdgSurface) GetPopup(r *xdg_shell.Surface, id *xdg_shell.Popup, parent *xdg_shell.Surface, pos *xdg_shell.Positioner) {
+	if x.toplevel != nil || x.popup != nil {
+		r.PostError(uint32(xdg_shell.SurfaceErrorAlreadyConstructed), "xdg_surface already has a role object")
+		return
+	}
+	if parent == nil {
+		r.PostError(uint32(xdg_shell.WmBaseErrorInvalidPopupParent), "popups without parent are not supported")
+		return
+	}
+	ps := pos.Handler().(*positioner)
+	if !ps.complete() {
+		r.PostError(uint32(xdg_shell.WmBaseErrorInvalidPositioner), "positioner without size or anchor rectangle")
+		return
+	}
+
+	p := &popup{xdg: x, res: id, parent: parent.Handler().(*xdgSurface), positioner: *ps}
+	x.popup = p
+	id.SetHandler(p)
+	id.AddDestroyHandler(p.destroy)
+}
+
+func (x *xdgSurface) SetWindowGeometry(r *xdg_shell.Surface, gx, gy, width, height int32) {
+	if width <= 0 || height <= 0 {
+		r.PostError(uint32(xdg_shell.SurfaceErrorInvalidSize), "invalid window geometry size %dx%d", width, height)
+		return
+	}
+	x.pendingGeometry = &rect{gx, gy, width, height}
+}
+
+func (x *xdgSurface) AckConfigure(r *xdg_shell.Surface, serial uint32) {
+	if serial == 0 || serial > x.configureSerial {
+		r.PostError(uint32(xdg_shell.SurfaceErrorInvalidSerial), "invalid configure serial %d", serial)
+		return
+	}
+	x.acked = true
+}
+
+// sendConfigure ends a configure sequence of the role object
+func (x *xdgSurface) sendConfigure() {
+	x.configureSerial = x.c.server.NextSerial()
+	x.res.SendConfigure(x.configureSerial)
+}
+
+// checkCommit reports whether the pending state of the surface may be
+// committed, posting an error otherwise
+func (x *xdgSurface) checkCommit() bool {
+	if x.toplevel == nil && x.popup == nil {
+		x.res.PostError(uint32(xdg_shell.SurfaceErrorNotConstructed), "xdg_surface committed without role object")
+		return false
+	}
+	if s := x.surface; s.pending.attached && s.pending.buffer != nil && !x.acked {
+		x.res.PostError(uint32(xdg_shell.SurfaceErrorUnconfiguredBuffer), "buffer committed before the first configure was acked")
+		return false
+	}
+	return true
+}
+
+// commit maps the surface once it has a buffer, and unmaps it when the
+// buffer is removed
+func (x *xdgSurface) commit() {
+	if x.pendingGeometry != nil {
+		x.geometry = x.pendingGeometry
+		x.pendingGeometry = nil
+	}
+
+	switch {
+	case x.configureSerial == 0:
+		// Initial commit
+		if x.toplevel != nil {
+			x.toplevel.sendConfigure()
+		} else {
+			x.popup.sendConfigure()
+		}
+	case !x.mapped && x.surface.image != nil:
+		x.mapped = true
+		if t := x.toplevel; t != nil {
+			t.place()
+			x.c.mapView(x.surface)
+			x.c.seat.setKeyboardFocus(x.surface)
+		} else {
+			x.c.mapView(x.surface)
+		}
+	case x.mapped && x.surface.image == nil:
+		x.unmap()
+	case x.mapped:
+		x.c.updateOutputs(x.surface)
+	}
+}
+
+// unmap hides the surface, it gets a new initial configure on its next
+// commit
+func (x *xdgSurface) unmap() {
+	x.configureSerial = 0
+	x.acked = false
+	if !x.mapped {
+		return
+	}
+	x.mapped = false
+	x.c.unmapView(x.surface)
+}
+
+func (x *xdgSurface) destroy() {
+	x.unmap()
+	if x.surface.xdg == x {
+		x.surface.xdg = nil
+	}
+}
+
+// geometryOffset returns the position of the window geometry in the
+// surface
+func (x *xdgSurface) geometryOffset() (int32, int32) {
+	if x.geometry == nil {
+		return 0, 0
+	}
+	return x.geometry.x, x.geometry.y
+}
+
+// windowSize returns the size of the window geometry
+func (x *xdgSurface) windowSize() (int32, int32) {
+	if x.geometry == nil {
+		return x.surface.size()
+	}
+	return x.geometry.width, x.geometry.height
+}
+
+// origin returns the position of the surface in the global space
+func (x *xdgSurface) origin() (int32, int32) {
+	gx, gy := x.geometryOffset()
+
+	switch {
+	case x.toplevel != nil:
+		return x.toplevel.x - gx, x.toplevel.y - gy
+	case x.popup != nil && x.popup.parent != nil:
+		px, py := x.popup.parent.origin()
+		pgx, pgy := x.popup.parent.geometryOffset()
+		return px + pgx + x.popup.x - gx, py + pgy + x.popup.y - gy
+	}
+	return 0, 0
+}
+
+// toplevel implements xdg_toplevel
+type toplevel struct {
+	xdg *xdgSurface
+	res *xdg_shell.Toplevel
+	// id identifies the window in control commands
+	id int
+
+	title, appID string
+
+	// x and y are the position of the window geometry
+	x, y   int32
+	placed bool
+	// savedX and savedY are the position to restore when leaving the
+	// maximized or fullscreen state
+	savedX, savedY int32
+
+	maximized  bool
+	fullscreen *output
+	activated  bool
+}
+
+// place positions a new window, cascading from the top left corner of
+// the first output
+func (t *toplevel) place() {
+	if t.placed || t.maximized || t.fullscreen != nil {
+		return
+	}
+	t.placed = true
+	n := int32(t.id-1) % 10
+	o := t.xdg.c.outputs[0]
+	t.x, t.y = o.x+32*n, 32*n
+}
+
+func (t *toplevel) sendConfigure() {
+	var width, height int32
+	var states []xdg_shell.ToplevelState
+
+	switch o := t.fullscreen; {
+	case o != nil:
+		r := o.rect()
+		width, height = r.width, r.height
+		states = append(states, xdg_shell.ToplevelStateFullscreen)
+	case t.maximized:
+		r := t.xdg.c.outputs[0].rect()
+		width, height = r.width, r.height
+		states = append(states, xdg_shell.ToplevelStateMaximized)
+	}
+	if t.activated {
+		states = append(states, xdg_shell.ToplevelStateActivated)
+	}
+
+	b := make([]byte, 4*len(states))
+	for i, s := range states {
+		server.PutUint32(b[i*4:], uint32(s))
+	}
+	t.res.SendConfigure(width, height, b)
+	t.xdg.sendConfigure()
+}
+
+// reconfigure sends a configure if the initial one was sent, otherwise
+// the new state is part of the initial configure
+func (t *toplevel) reconfigure() {
+	if t.xdg.configureSerial != 0 {
+		t.sendConfigure()
+	}
+}
+
+func (t *toplevel) setActivated(activated bool) {
+	if t.activated == activated {
+		return
+	}
+	t.activated = activated
+	t.reconfigure()
+}
+
+// setState maximizes the window or makes it fullscreen on an output,
+// it goes back to its saved position once neither
+func (t *toplevel) setState(maximized bool, fullscreen *output) {
+	wasFree := !t.maximized && t.fullscreen == nil
+	t.maximized, t.fullscreen = maximized, fullscreen
+
+	switch {
+	case fullscreen != nil:
+		if wasFree {
+			t.savedX, t.savedY = t.x, t.y
+		}
+		t.x, t.y = fullscreen.x, 0
+	case maximized:
+		if wasFree {
+			t.savedX, t.savedY = t.x, t.y
+		}
+		t.x, t.y = t.xdg.c.outputs[0].x, 0
+	case !wasFree:
+		t.x, t.y = t.savedX, t.savedY
+	}
+	t.reconfigure()
+}
+
+func (t *toplevel) destroy() {
+	t.xdg.unmap()
+	t.xdg.toplevel = nil
+}
+
+func (t *toplevel) Destroy(r *xdg_shell.Toplevel) {}
+
+func (t *toplevel) SetParent(r *xdg_shell.Toplevel, parent *xdg_shell.Toplevel) {}
+
+func (t *toplevel) SetTitle(r *xdg_shell.Toplevel, title string) {
+	t.title = title
+}
+
+func (t *toplevel) SetAppId(r *xdg_shell.Toplevel, appID string) {
+	t.appID = appID
+}
+
+func (t *toplevel) ShowWindowMenu(r *xdg_shell.Toplevel, seat *server.Seat, serial uint32, x, y int32) {
+}
+
+func (t *toplevel) Move(r *xdg_shell.Toplevel, seat *server.Seat, serial uint32) {}
+
+func (t *toplevel) Resize(r *xdg_shell.Toplevel, seat *server.Seat, serial uint32, edges xdg_shell.ToplevelResizeEdge) {
+	if edges > xdg_shell.ToplevelResizeEdgeBottomRight {
+		r.PostError(uint32(xdg_shell.ToplevelErrorInvalidResizeEdge), "invalid resize edge %d", edges)
+	}
+}
+
+func (t *toplevel) SetMaxSize(r *xdg_shell.Toplevel, width, height int32) {
+	if width < 0 || height < 0 {
+		r.PostError(uint32(xdg_shell.ToplevelErrorInvalidSize), "invalid max size %dx%d", width, height)
+	}
+}
+
+func (t *toplevel) SetMinSize(r *xdg_shell.Toplevel, width, height int32) {
+	if width < 0 || height < 0 {
+		r.PostError(uint32(xdg_shell.ToplevelErrorInvalidSize), "invalid min size %dx%d", width, height)
+	}
+}
+
+func (t *toplevel) SetMaximized(r *xdg_shell.Toplevel) {
+	t.setState(true, t.fullscreen)
+}
+
+func (t *toplevel) UnsetMaximized(r *xdg_shell.Toplevel) {
+	t.setState(false, t.fullscreen)
+}
+
+func (t *toplevel) SetFullscreen(r *xdg_shell.Toplevel, o *server.Output) {
+	out := t.xdg.c.outputs[0]
+	for _, v := range t.xdg.c.outputs {
+		for _, res := range v.resources {
+			if res == o {
+				out = v
+			}
+		}
+	}
+	t.setState(t.maximized, out)
+}
+
+func (t *toplevel) UnsetFullscreen(r *xdg_shell.Toplevel) {
+	t.setState(t.maximized, nil)
+}
+
+func (t *toplevel) SetMinimized(r *xdg_shell.Toplevel) {}
+
+// popup implements xdg_popup
+type popup struct {
+	xdg        *xdgSurface
+	res        *xdg_shell.Popup
+	parent     *xdgSurface
+	positioner positioner
+
+	// x and y are the position of the popup relative to the window
+	// geometry of its parent
+	x, y int32
+}
+
+func (p *popup) sendConfigure() {
+	p.x, p.y = p.positioner.position()
+	p.res.SendConfigure(p.x, p.y, p.positioner.width, p.positioner.height)
+	p.xdg.sendConfigure()
+}
+
+// dismiss closes the popup, the client is expected to destroy it
+func (p *popup) dismiss() {
+	p.res.SendPopupDone()
+	p.xdg.unmap()
+}
+
+func (p *popup) destroy() {
+	p.xdg.unmap()
+	p.xdg.popup = nil
+}
+
+func (p *popup) Destroy(r *xdg_shell.Popup) {}
+
+func (p *popup) Grab(r *xdg_shell.Popup, seat *server.Seat, serial uint32) {}
+
+func (p *popup) Reposition(r *xdg_shell.Popup, pos *xdg_shell.Positioner, token uint32) {
+	ps := pos.Handler().(*positioner)
+	if !ps.complete() {
+		r.PostError(uint32(xdg_shell.WmBaseErrorInvalidPositioner), "positioner without size or anchor rectangle")
+		return
+	}
+	p.positioner = *ps
+	p.res.SendRepositioned(token)
+	p.sendConfigure()
+}
+
+// positioner implements xdg_positioner. Constraint adjustments are not
+// applied: popups may extend past the outputs.
+type positioner struct {
+	width, height    int32
+	anchorRect       rect
+	anchor           xdg_shell.PositionerAnchor
+	gravity          xdg_shell.PositionerGravity
+	offsetX, offsetY int32
+}
+
+func (p *positioner) complete() bool {
+	return p.width > 0 && p.height > 0 && p.anchorRect.width > 0 && p.anchorRect.height > 0
+}
+
+// position returns the position of the popup relative to the window
+// geometry of its parent
+func (p *positioner) position() (x, y int32) {
+	r := p.anchorRect
+
+	x, y = r.x+r.width/2, r.y+r.height/2
+	switch p.anchor {
+	case xdg_shell.PositionerAnchorTop, xdg_shell.PositionerAnchorTopLeft, xdg_shell.PositionerAnchorTopRight:
+		y = r.y
+	case xdg_shell.PositionerAnchorBottom, xdg_shell.PositionerAnchorBottomLeft, xdg_shell.PositionerAnchorBottomRight:
+		y = r.y + r.height
+	}
+	switch p.anchor {
+	case xdg_shell.PositionerAnchorLeft, xdg_shell.PositionerAnchorTopLeft, xdg_shell.PositionerAnchorBottomLeft:
+		x = r.x
+	case xdg_shell.PositionerAnchorRight, xdg_shell.PositionerAnchorTopRight, xdg_shell.PositionerAnchorBottomRight:
+		x = r.x + r.width
+	}
+
+	// The gravity is the direction the popup extends to from the
+	// anchor point
+	switch p.gravity {
+	case xdg_shell.PositionerGravityTop, xdg_shell.PositionerGravityTopLeft, xdg_shell.PositionerGravityTopRight:
+		y -= p.height
+	case xdg_shell.PositionerGravityBottom, xdg_shell.PositionerGravityBottomLeft, xdg_shell.PositionerGravityBottomRight:
+	default:
+		y -= p.height / 2
+	}
+	switch p.gravity {
+	case xdg_shell.PositionerGravityLeft, xdg_shell.PositionerGravityTopLeft, xdg_shell.PositionerGravityBottomLeft:
+		x -= p.width
+	case xdg_shell.PositionerGravityRight, xdg_shell.PositionerGravityTopRight, xdg_shell.PositionerGravityBottomRight:
+	default:
+		x -= p.width / 2
+	}
+
+	return x + p.offsetX, y + p.offsetY
+}
+
+func (p *positioner) Destroy(r *xdg_shell.Positioner) {}
+
+func (p *positioner) SetSize(r *xdg_shell.Positioner, width, height int32) {
+	if width <= 0 || height <= 0 {
+		r.PostError(uint32(xdg_shell.PositionerErrorInvalidInput), "invalid size %dx%d", width, height)
+		return
+	}
+	p.width, p.height = width, height
+}
+
+func (p *positioner) SetAnchorRect(r *xdg_shell.Positioner, x, y, width, height int32) {
+	if width < 0 || height < 0 {
+		r.PostError(uint32(xdg_shell.PositionerErrorInvalidInput), "invalid anchor rectangle size %dx%d", width, height)
+		return
+	}
+	p.anchorRect = rect{x, y, width, height}
+}
+
+func (p *positioner) SetAnchor(r *xdg_shell.Positioner, anchor xdg_shell.PositionerAnchor) {
+	p.anchor = anchor
+}
+
+func (p *positioner) SetGravity(r *xdg_shell.Positioner, gravity xdg_shell.PositionerGravity) {
+	p.gravity = gravity
+}
+
+func (p *positioner) SetConstraintAdjustment(r *xdg_shell.Positioner, constraintAdjustment xdg_shell.PositionerConstraintAdjustment) {
+}
+
+func (p *positioner) SetOffset(r *xdg_shell.Positioner, x, y int32) {
+	p.offsetX, p.offsetY = x, y
+}
+
+func (p *positioner) SetReactive(r *xdg_shell.Positioner) {}
+
+func (p *positioner) SetParentSize(r *xdg_shell.Positioner, parentWidth, parentHeight int32) {}
+
+func (p *positioner) SetParentConfigure(r *xdg_shell.Positioner, serial uint32) {}
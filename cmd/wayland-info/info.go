@@ -0,0 +1,370 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	linux_dmabuf "github.com/hempflower/go-wayland/wayland/stable/linux-dmabuf-v1"
+	"golang.org/x/sys/unix"
+)
+
+// Info is what wayland-info prints, globals are in order of
+// announcement.
+type Info struct {
+	Globals []*Global `json:"globals"`
+}
+
+// Global is a global of the compositor, with the details of its
+// interface if it is known.
+type Global struct {
+	Interface string `json:"interface"`
+	Version   uint32 `json:"version"`
+	Name      uint32 `json:"name"`
+
+	Shm    *Shm    `json:"shm,omitempty"`
+	Seat   *Seat   `json:"seat,omitempty"`
+	Output *Output `json:"output,omitempty"`
+	Dmabuf *Dmabuf `json:"dmabuf,omitempty"`
+}
+
+type Shm struct {
+	Formats []Format `json:"formats"`
+}
+
+// Format is a DRM fourcc format.
+type Format struct {
+	Format uint32 `json:"format"`
+	Fourcc string `json:"fourcc"`
+}
+
+// DmabufFormat is a format with a DRM modifier.
+type DmabufFormat struct {
+	Format
+	Modifier uint64 `json:"modifier"`
+}
+
+type Seat struct {
+	Name         string   `json:"name,omitempty"`
+	Capabilities []string `json:"capabilities"`
+}
+
+type Output struct {
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	X              int32  `json:"x"`
+	Y              int32  `json:"y"`
+	Scale          int32  `json:"scale"`
+	PhysicalWidth  int32  `json:"physical_width"`
+	PhysicalHeight int32  `json:"physical_height"`
+	Make           string `json:"make"`
+	Model          string `json:"model"`
+	Subpixel       string `json:"subpixel"`
+	Transform      string `json:"transform"`
+	Modes          []Mode `json:"modes"`
+}
+
+type Mode struct {
+	Width  int32 `json:"width"`
+	Height int32 `json:"height"`
+	// Refresh is in mHz
+	Refresh   int32 `json:"refresh"`
+	Current   bool  `json:"current"`
+	Preferred bool  `json:"preferred"`
+}
+
+type Dmabuf struct {
+	// Formats are those of the format and modifier events, sent before
+	// version 4
+	Formats  []DmabufFormat  `json:"formats,omitempty"`
+	Feedback *DmabufFeedback `json:"default_feedback,omitempty"`
+}
+
+type DmabufFeedback struct {
+	MainDevice Device    `json:"main_device"`
+	Tranches   []Tranche `json:"tranches"`
+}
+
+type Tranche struct {
+	TargetDevice Device         `json:"target_device"`
+	Scanout      bool           `json:"scanout"`
+	Formats      []DmabufFormat `json:"formats"`
+}
+
+// Device is a dev_t
+type Device struct {
+	Major uint32 `json:"major"`
+	Minor uint32 `json:"minor"`
+}
+
+func (d Device) String() string {
+	return fmt.Sprintf("%d:%d", d.Major, d.Minor)
+}
+
+// collect binds the globals of known interfaces and gathers their
+// details in a roundtrip
+func collect(display *client.Display) (*Info, error) {
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{}
+	var errs []error
+	for _, g := range globals.List() {
+		global := &Global{Interface: g.Interface, Version: g.Version, Name: g.Name}
+		info.Globals = append(info.Globals, global)
+
+		var err error
+		switch g.Interface {
+		case client.ShmName:
+			err = collectShm(globals, g, global)
+		case client.SeatName:
+			err = collectSeat(globals, g, global)
+		case client.OutputName:
+			err = collectOutput(globals, g, global)
+		case linux_dmabuf.DmabufName:
+			err = collectDmabuf(globals, g, global, &errs)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := display.Roundtrip(); err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return info, nil
+}
+
+func collectShm(globals *client.Globals, g client.Global, global *Global) error {
+	p, err := globals.Bind(g, 1, 0)
+	if err != nil {
+		return err
+	}
+
+	global.Shm = &Shm{Formats: []Format{}}
+	p.(*client.Shm).SetFormatHandler(func(e client.ShmFormatEvent) {
+		format := uint32(e.Format)
+		// The only formats whose code isn't their fourcc
+		switch e.Format {
+		case client.ShmFormatArgb8888:
+			format = fourccCode("AR24")
+		case client.ShmFormatXrgb8888:
+			format = fourccCode("XR24")
+		}
+		global.Shm.Formats = append(global.Shm.Formats, Format{format, fourcc(format)})
+	})
+
+	return nil
+}
+
+func collectSeat(globals *client.Globals, g client.Global, global *Global) error {
+	p, err := globals.Bind(g, 1, 0)
+	if err != nil {
+		return err
+	}
+
+	seat := p.(*client.Seat)
+	global.Seat = &Seat{Capabilities: []string{}}
+	seat.SetCapabilitiesHandler(func(e client.SeatCapabilitiesEvent) {
+		global.Seat.Capabilities = []string{}
+		for _, c := range []client.SeatCapability{client.SeatCapabilityPointer, client.SeatCapabilityKeyboard, client.SeatCapabilityTouch} {
+			if e.Capabilities.Has(c) {
+				global.Seat.Capabilities = append(global.Seat.Capabilities, c.String())
+			}
+		}
+	})
+	seat.SetNameHandler(func(e client.SeatNameEvent) {
+		global.Seat.Name = e.Name
+	})
+
+	return nil
+}
+
+func collectOutput(globals *client.Globals, g client.Global, global *Global) error {
+	p, err := globals.Bind(g, 1, 0)
+	if err != nil {
+		return err
+	}
+
+	output := p.(*client.Output)
+	o := &Output{Scale: 1, Modes: []Mode{}}
+	global.Output = o
+	output.SetGeometryHandler(func(e client.OutputGeometryEvent) {
+		o.X, o.Y = e.X, e.Y
+		o.PhysicalWidth, o.PhysicalHeight = e.PhysicalWidth, e.PhysicalHeight
+		o.Make, o.Model = e.Make, e.Model
+		o.Subpixel = e.Subpixel.Name()
+		o.Transform = e.Transform.Name()
+	})
+	output.SetModeHandler(func(e client.OutputModeEvent) {
+		o.Modes = append(o.Modes, Mode{
+			Width:     e.Width,
+			Height:    e.Height,
+			Refresh:   e.Refresh,
+			Current:   e.Flags.Has(client.OutputModeCurrent),
+			Preferred: e.Flags.Has(client.OutputModePreferred),
+		})
+	})
+	output.SetScaleHandler(func(e client.OutputScaleEvent) {
+		o.Scale = e.Factor
+	})
+	output.SetNameHandler(func(e client.OutputNameEvent) {
+		o.Name = e.Name
+	})
+	output.SetDescriptionHandler(func(e client.OutputDescriptionEvent) {
+		o.Description = e.Description
+	})
+
+	return nil
+}
+
+// collectDmabuf gets the formats from the default feedback from version
+// 4, from the format and modifier events before. Errors reading the
+// format table are appended to errs.
+func collectDmabuf(globals *client.Globals, g client.Global, global *Global, errs *[]error) error {
+	p, err := globals.Bind(g, 1, 0)
+	if err != nil {
+		return err
+	}
+
+	dmabuf := p.(*linux_dmabuf.Dmabuf)
+	global.Dmabuf = &Dmabuf{}
+	if dmabuf.Version() < linux_dmabuf.DmabufGetDefaultFeedbackSinceVersion {
+		dmabuf.SetFormatHandler(func(e linux_dmabuf.DmabufFormatEvent) {
+			// Modifier events follow from version 3
+			if dmabuf.Version() < linux_dmabuf.DmabufModifierSinceVersion {
+				global.Dmabuf.Formats = append(global.Dmabuf.Formats, dmabufFormat(e.Format, modifierInvalid))
+			}
+		})
+		dmabuf.SetModifierHandler(func(e linux_dmabuf.DmabufModifierEvent) {
+			modifier := uint64(e.ModifierHi)<<32 | uint64(e.ModifierLo)
+			global.Dmabuf.Formats = append(global.Dmabuf.Formats, dmabufFormat(e.Format, modifier))
+		})
+		return nil
+	}
+
+	feedback, err := dmabuf.GetDefaultFeedback()
+	if err != nil {
+		return err
+	}
+
+	fb := &DmabufFeedback{Tranches: []Tranche{}}
+	global.Dmabuf.Feedback = fb
+	var table []DmabufFormat
+	tranche := Tranche{Formats: []DmabufFormat{}}
+	feedback.SetFormatTableHandler(func(e linux_dmabuf.DmabufFeedbackFormatTableEvent) {
+		defer unix.Close(e.Fd)
+
+		var err error
+		if table, err = readFormatTable(e.Fd, e.Size); err != nil {
+			*errs = append(*errs, err)
+		}
+	})
+	feedback.SetMainDeviceHandler(func(e linux_dmabuf.DmabufFeedbackMainDeviceEvent) {
+		fb.MainDevice = device(e.Device)
+	})
+	feedback.SetTrancheTargetDeviceHandler(func(e linux_dmabuf.DmabufFeedbackTrancheTargetDeviceEvent) {
+		tranche.TargetDevice = device(e.Device)
+	})
+	feedback.SetTrancheFlagsHandler(func(e linux_dmabuf.DmabufFeedbackTrancheFlagsEvent) {
+		tranche.Scanout = e.Flags.Has(linux_dmabuf.DmabufFeedbackTrancheFlagsScanout)
+	})
+	feedback.SetTrancheFormatsHandler(func(e linux_dmabuf.DmabufFeedbackTrancheFormatsEvent) {
+		for i := 0; i+2 <= len(e.Indices); i += 2 {
+			idx := int(nativeEndian.Uint16(e.Indices[i:]))
+			if idx >= len(table) {
+				*errs = append(*errs, fmt.Errorf("dmabuf feedback: format index %d out of the table of %d formats", idx, len(table)))
+				return
+			}
+			tranche.Formats = append(tranche.Formats, table[idx])
+		}
+	})
+	feedback.SetTrancheDoneHandler(func(linux_dmabuf.DmabufFeedbackTrancheDoneEvent) {
+		fb.Tranches = append(fb.Tranches, tranche)
+		tranche = Tranche{Formats: []DmabufFormat{}}
+	})
+
+	return nil
+}
+
+// readFormatTable reads the format table of a dmabuf feedback, an array
+// of 16 bytes entries: a format, 4 bytes of padding and a modifier.
+func readFormatTable(fd int, size uint32) ([]DmabufFormat, error) {
+	if size == 0 {
+		return nil, nil
+	}
+
+	data, err := unix.Mmap(fd, 0, int(size), unix.PROT_READ, unix.MAP_PRIVATE)
+	if err != nil {
+		return nil, fmt.Errorf("dmabuf feedback: unable to map the format table: %w", err)
+	}
+	defer unix.Munmap(data)
+
+	formats := make([]DmabufFormat, 0, len(data)/16)
+	for i := 0; i+16 <= len(data); i += 16 {
+		formats = append(formats, dmabufFormat(nativeEndian.Uint32(data[i:]), nativeEndian.Uint64(data[i+8:])))
+	}
+
+	return formats, nil
+}
+
+// nativeEndian is the byte order of the dev_t and of the tables the
+// compositor shares
+var nativeEndian binary.ByteOrder = binary.LittleEndian
+
+func init() {
+	x := uint16(1)
+	if *(*byte)(unsafe.Pointer(&x)) == 0 {
+		nativeEndian = binary.BigEndian
+	}
+}
+
+func device(b []byte) Device {
+	if len(b) != 8 {
+		return Device{}
+	}
+	dev := nativeEndian.Uint64(b)
+	return Device{Major: unix.Major(dev), Minor: unix.Minor(dev)}
+}
+
+// fourcc returns the four characters of a DRM format code
+func fourcc(format uint32) string {
+	b := []byte{byte(format), byte(format >> 8), byte(format >> 16), byte(format >> 24)}
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return fmt.Sprintf("0x%08x", format)
+		}
+	}
+	return string(b)
+}
+
+func dmabufFormat(format uint32, modifier uint64) DmabufFormat {
+	return DmabufFormat{Format{format, fourcc(format)}, modifier}
+}
+
+func fourccCode(s string) uint32 {
+	return uint32(s[0]) | uint32(s[1])<<8 | uint32(s[2])<<16 | uint32(s[3])<<24
+}
+
+const (
+	modifierLinear  = 0
+	modifierInvalid = 0x00ffffffffffffff
+)
+
+// modifierName names the vendor independent modifiers
+func modifierName(m uint64) string {
+	switch m {
+	case modifierLinear:
+		return "LINEAR"
+	case modifierInvalid:
+		return "INVALID"
+	}
+	return fmt.Sprintf("0x%016x", m)
+}
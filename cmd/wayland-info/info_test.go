@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+// startServer starts a compositor with wl_shm, wl_seat and wl_output
+// globals on the display name of the test
+func startServer(t *testing.T) {
+	t.Helper()
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+
+	s := server.New()
+	t.Cleanup(func() { s.Close() })
+	if _, err := s.InitShm(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateGlobal(server.SeatInterface, 2, func(r server.Resource) {
+		seat := r.(*server.Seat)
+		seat.SendCapabilities(server.SeatCapabilityPointer | server.SeatCapabilityKeyboard)
+		seat.SendName("seat0")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateGlobal(server.OutputInterface, 4, func(r server.Resource) {
+		output := r.(*server.Output)
+		output.SendGeometry(0, 0, 300, 200, server.OutputSubpixelUnknown, "Make", "Model", server.OutputTransformNormal)
+		output.SendMode(server.OutputModeCurrent|server.OutputModePreferred, 1920, 1080, 60000)
+		output.SendScale(2)
+		output.SendName("TEST-1")
+		output.SendDescription("Test output")
+		output.SendDone()
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	sock, err := server.Listen("wayland-info-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(sock)
+}
+
+// TestCollect connects by display name as with -socket
+func TestCollect(t *testing.T) {
+	startServer(t)
+
+	display, err := client.Connect("wayland-info-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer display.Context().Close()
+
+	info, err := collect(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	printInfo(&b, info)
+	want := `interface: 'wl_shm', version: 1, name: 1
+	formats (fourcc):
+	0x34325241 = 'AR24'
+	0x34325258 = 'XR24'
+interface: 'wl_seat', version: 2, name: 2
+	name: seat0
+	capabilities: pointer keyboard
+interface: 'wl_output', version: 4, name: 3
+	name: TEST-1
+	description: Test output
+	x: 0, y: 0, scale: 2,
+	physical_width: 300 mm, physical_height: 200 mm,
+	make: 'Make', model: 'Model',
+	subpixel_orientation: unknown, output_transform: normal,
+	mode:
+		width: 1920 px, height: 1080 px, refresh: 60.000 Hz,
+		flags: current preferred
+`
+	if b.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
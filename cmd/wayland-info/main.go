@@ -0,0 +1,67 @@
+// Command wayland-info prints the globals of a wayland compositor, with
+// their interface and version, and details of the interfaces it knows:
+// wl_shm formats, wl_seat capabilities and name, wl_output geometry,
+// modes, scale, name and description, and zwp_linux_dmabuf_v1 formats
+// and modifiers, read from the default feedback format table from
+// version 4.
+//
+//	wayland-info [-json] [-socket name]
+//
+// -json prints the same information as a json object, e.g. to attach
+// it to bug reports.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+)
+
+var (
+	jsonOutput bool
+	socket     string
+)
+
+func init() {
+	flag.BoolVar(&jsonOutput, "json", false, "Print the information as a json object")
+	flag.StringVar(&socket, "socket", "", "Display `name` or path, defaults to $WAYLAND_DISPLAY")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-json] [-socket name]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Parse()
+
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	display, err := client.Connect(socket)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer display.Context().Close()
+
+	info, err := collect(display)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "\t")
+		if err := enc.Encode(info); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	printInfo(os.Stdout, info)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// printInfo prints info in the layout of weston's wayland-info
+func printInfo(w io.Writer, info *Info) {
+	for _, g := range info.Globals {
+		fmt.Fprintf(w, "interface: '%s', version: %d, name: %d\n", g.Interface, g.Version, g.Name)
+
+		switch {
+		case g.Shm != nil:
+			printShm(w, g.Shm)
+		case g.Seat != nil:
+			printSeat(w, g.Seat)
+		case g.Output != nil:
+			printOutput(w, g.Output)
+		case g.Dmabuf != nil:
+			printDmabuf(w, g.Dmabuf)
+		}
+	}
+}
+
+func printShm(w io.Writer, shm *Shm) {
+	fmt.Fprintf(w, "\tformats (fourcc):\n")
+	for _, f := range shm.Formats {
+		fmt.Fprintf(w, "\t0x%08x = '%s'\n", f.Format, f.Fourcc)
+	}
+}
+
+func printSeat(w io.Writer, seat *Seat) {
+	if seat.Name != "" {
+		fmt.Fprintf(w, "\tname: %s\n", seat.Name)
+	}
+	caps := strings.Join(seat.Capabilities, " ")
+	if caps == "" {
+		caps = "none"
+	}
+	fmt.Fprintf(w, "\tcapabilities: %s\n", caps)
+}
+
+func printOutput(w io.Writer, o *Output) {
+	if o.Name != "" {
+		fmt.Fprintf(w, "\tname: %s\n", o.Name)
+	}
+	if o.Description != "" {
+		fmt.Fprintf(w, "\tdescription: %s\n", o.Description)
+	}
+	fmt.Fprintf(w, "\tx: %d, y: %d, scale: %d,\n", o.X, o.Y, o.Scale)
+	fmt.Fprintf(w, "\tphysical_width: %d mm, physical_height: %d mm,\n", o.PhysicalWidth, o.PhysicalHeight)
+	fmt.Fprintf(w, "\tmake: '%s', model: '%s',\n", o.Make, o.Model)
+	fmt.Fprintf(w, "\tsubpixel_orientation: %s, output_transform: %s,\n", o.Subpixel, o.Transform)
+	for _, m := range o.Modes {
+		fmt.Fprintf(w, "\tmode:\n")
+		fmt.Fprintf(w, "\t\twidth: %d px, height: %d px, refresh: %.3f Hz,\n", m.Width, m.Height, float64(m.Refresh)/1000)
+		var flags []string
+		if m.Current {
+			flags = append(flags, "current")
+		}
+		if m.Preferred {
+			flags = append(flags, "preferred")
+		}
+		fmt.Fprintf(w, "\t\tflags: %s\n", strings.Join(flags, " "))
+	}
+}
+
+func printDmabuf(w io.Writer, d *Dmabuf) {
+	if d.Feedback == nil {
+		fmt.Fprintf(w, "\tformats (fourcc) and modifiers:\n")
+		printDmabufFormats(w, "\t", d.Formats)
+		return
+	}
+
+	fmt.Fprintf(w, "\tmain device: %s\n", d.Feedback.MainDevice)
+	for i, t := range d.Feedback.Tranches {
+		fmt.Fprintf(w, "\ttranche %d\n", i)
+		fmt.Fprintf(w, "\t\ttarget device: %s\n", t.TargetDevice)
+		if t.Scanout {
+			fmt.Fprintf(w, "\t\tflags: scanout\n")
+		} else {
+			fmt.Fprintf(w, "\t\tflags: none\n")
+		}
+		fmt.Fprintf(w, "\t\tformats (fourcc) and modifiers:\n")
+		printDmabufFormats(w, "\t\t", t.Formats)
+	}
+}
+
+func printDmabufFormats(w io.Writer, indent string, formats []DmabufFormat) {
+	for _, f := range formats {
+		fmt.Fprintf(w, "%s'%s', %s\n", indent, f.Fourcc, modifierName(f.Modifier))
+	}
+}
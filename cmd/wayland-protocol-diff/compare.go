@@ -0,0 +1,308 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hempflower/go-wayland/protocol"
+)
+
+// Change is a difference between two versions of a protocol.
+type Change struct {
+	// Kind is added, removed or changed
+	Kind string `json:"kind"`
+	// Element is interface, request, event, arg, enum or entry
+	Element string `json:"element"`
+	// Path locates the element, e.g. "xdg_toplevel.set_title.title"
+	Path string `json:"path"`
+	// Attr is the changed attribute, e.g. version, since or type
+	Attr string `json:"attr,omitempty"`
+	Old  string `json:"old,omitempty"`
+	New  string `json:"new,omitempty"`
+	// Breaking reports whether the change breaks code using the
+	// generated Go bindings of the old version
+	Breaking bool   `json:"breaking"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+func (c Change) String() string {
+	mark := " "
+	if c.Breaking {
+		mark = "!"
+	}
+
+	s := fmt.Sprintf("%s %s: %s %s", mark, c.Path, c.Element, c.Kind)
+	if c.Kind == "added" && c.Attr != "" {
+		s += fmt.Sprintf(" %s %s", c.Attr, c.New)
+	} else if c.Attr != "" {
+		s += fmt.Sprintf(" %s %s -> %s", c.Attr, orNone(c.Old), orNone(c.New))
+	}
+	if c.Reason != "" {
+		s += " (" + c.Reason + ")"
+	}
+
+	return s
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+
+	return s
+}
+
+type comparer struct {
+	changes []Change
+}
+
+func (c *comparer) add(change Change) {
+	c.changes = append(c.changes, change)
+}
+
+// compare returns the changes turning a into b
+func compare(a *protocol.Protocol, b *protocol.Protocol) []Change {
+	c := &comparer{}
+
+	if a.Name != b.Name {
+		c.add(Change{Kind: "changed", Element: "protocol", Path: a.Name, Attr: "name", Old: a.Name, New: b.Name,
+			Breaking: true, Reason: "package and interfaces are renamed"})
+	}
+
+	for _, v := range a.Interfaces {
+		if b.Interface(v.Name) == nil {
+			c.add(Change{Kind: "removed", Element: "interface", Path: v.Name, Breaking: true, Reason: "proxy type removed"})
+		}
+	}
+	for i := range b.Interfaces {
+		nv := &b.Interfaces[i]
+		ov := a.Interface(nv.Name)
+		if ov == nil {
+			c.add(Change{Kind: "added", Element: "interface", Path: nv.Name, Attr: "version", New: itoa(protocol.Version(nv.Version))})
+			continue
+		}
+		c.compareInterface(ov, nv)
+	}
+
+	return c.changes
+}
+
+func (c *comparer) compareInterface(a *protocol.Interface, b *protocol.Interface) {
+	if ov, nv := protocol.Version(a.Version), protocol.Version(b.Version); ov != nv {
+		change := Change{Kind: "changed", Element: "interface", Path: a.Name, Attr: "version", Old: itoa(ov), New: itoa(nv)}
+		if nv < ov {
+			change.Reason = "version downgraded"
+		}
+		c.add(change)
+	}
+
+	// Interfaces without destructor get a Destroy method, which goes
+	// away unless the destructor is named destroy
+	if !a.HasDestructor() && b.HasDestructor() && b.Request("destroy") == nil {
+		c.add(Change{Kind: "changed", Element: "interface", Path: a.Name, Attr: "destructor", New: destructorName(b),
+			Breaking: true, Reason: "Destroy method replaced by the destructor request"})
+	}
+
+	c.compareMessages("request", a.Name, a.Requests, b.Requests)
+	c.compareMessages("event", a.Name, eventsToRequests(a.Events), eventsToRequests(b.Events))
+
+	for _, e := range a.Enums {
+		if b.Enum(e.Name) == nil {
+			c.add(Change{Kind: "removed", Element: "enum", Path: a.Name + "." + e.Name, Breaking: true, Reason: "enum type removed"})
+		}
+	}
+	for i := range b.Enums {
+		ne := &b.Enums[i]
+		oe := a.Enum(ne.Name)
+		if oe == nil {
+			c.add(Change{Kind: "added", Element: "enum", Path: a.Name + "." + ne.Name})
+			continue
+		}
+		c.compareEnum(a.Name+"."+ne.Name, oe, ne)
+	}
+}
+
+func (c *comparer) compareMessages(element string, iface string, a []protocol.Request, b []protocol.Request) {
+	index := func(messages []protocol.Request, name string) int {
+		for i, m := range messages {
+			if m.Name == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	reason := "method removed"
+	if element == "event" {
+		reason = "event type and handler removed"
+	}
+	for _, m := range a {
+		if index(b, m.Name) == -1 {
+			c.add(Change{Kind: "removed", Element: element, Path: iface + "." + m.Name, Breaking: true, Reason: reason})
+		}
+	}
+
+	for j, nm := range b {
+		path := iface + "." + nm.Name
+		i := index(a, nm.Name)
+		if i == -1 {
+			c.add(Change{Kind: "added", Element: element, Path: path, Attr: "since", New: itoa(protocol.Version(nm.Since))})
+			continue
+		}
+		om := a[i]
+
+		if i != j {
+			c.add(Change{Kind: "changed", Element: element, Path: path, Attr: "opcode", Old: itoa(i), New: itoa(j),
+				Breaking: true, Reason: "incompatible with peers of the old version"})
+		}
+		if os, ns := protocol.Version(om.Since), protocol.Version(nm.Since); os != ns {
+			c.add(Change{Kind: "changed", Element: element, Path: path, Attr: "since", Old: itoa(os), New: itoa(ns)})
+		}
+		if om.Type != nm.Type {
+			c.add(Change{Kind: "changed", Element: element, Path: path, Attr: "type", Old: om.Type, New: nm.Type})
+		}
+
+		c.compareArgs(element, path, om.Args, nm.Args)
+	}
+}
+
+func (c *comparer) compareArgs(element string, path string, a []protocol.Arg, b []protocol.Arg) {
+	index := func(args []protocol.Arg, name string) int {
+		for i, arg := range args {
+			if arg.Name == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	// Requests take arguments as parameters, events expose them as
+	// fields of the event struct
+	isRequest := element == "request"
+
+	for _, arg := range a {
+		if index(b, arg.Name) == -1 {
+			reason := "field removed"
+			if isRequest {
+				reason = "parameter removed"
+			}
+			c.add(Change{Kind: "removed", Element: "arg", Path: path + "." + arg.Name, Breaking: true, Reason: reason})
+		}
+	}
+
+	for j, na := range b {
+		argPath := path + "." + na.Name
+		i := index(a, na.Name)
+		if i == -1 {
+			change := Change{Kind: "added", Element: "arg", Path: argPath, Attr: "type", New: na.Type}
+			if isRequest && (na.Type != "new_id" || na.Interface == "") {
+				change.Breaking, change.Reason = true, "parameter added"
+			} else if isRequest {
+				change.Breaking, change.Reason = true, "return value added"
+			}
+			c.add(change)
+			continue
+		}
+		oa := a[i]
+
+		if i != j {
+			change := Change{Kind: "changed", Element: "arg", Path: argPath, Attr: "position", Old: itoa(i), New: itoa(j)}
+			if isRequest {
+				change.Breaking, change.Reason = true, "parameters reordered"
+			}
+			c.add(change)
+		}
+
+		attrs := []struct {
+			name     string
+			old, new string
+		}{
+			{"type", oa.Type, na.Type},
+			{"interface", oa.Interface, na.Interface},
+			{"enum", oa.Enum, na.Enum},
+		}
+		for _, attr := range attrs {
+			if attr.old != attr.new {
+				c.add(Change{Kind: "changed", Element: "arg", Path: argPath, Attr: attr.name, Old: attr.old, New: attr.new,
+					Breaking: true, Reason: "Go type changed"})
+			}
+		}
+		if oa.AllowNull != na.AllowNull {
+			change := Change{Kind: "changed", Element: "arg", Path: argPath, Attr: "allow-null",
+				Old: strconv.FormatBool(oa.AllowNull), New: strconv.FormatBool(na.AllowNull)}
+			if oa.AllowNull {
+				change.Reason = "nil is no longer accepted"
+			}
+			c.add(change)
+		}
+	}
+}
+
+func (c *comparer) compareEnum(path string, a *protocol.Enum, b *protocol.Enum) {
+	if os, ns := protocol.Version(a.Since), protocol.Version(b.Since); os != ns {
+		c.add(Change{Kind: "changed", Element: "enum", Path: path, Attr: "since", Old: itoa(os), New: itoa(ns)})
+	}
+	if a.Bitfield != b.Bitfield {
+		change := Change{Kind: "changed", Element: "enum", Path: path, Attr: "bitfield",
+			Old: strconv.FormatBool(a.Bitfield), New: strconv.FormatBool(b.Bitfield)}
+		if a.Bitfield {
+			change.Breaking, change.Reason = true, "Has, Set and Clear methods removed"
+		}
+		c.add(change)
+	}
+
+	for _, entry := range a.Entries {
+		if b.Entry(entry.Name) == nil {
+			c.add(Change{Kind: "removed", Element: "entry", Path: path + "." + entry.Name, Breaking: true, Reason: "constant removed"})
+		}
+	}
+	for _, ne := range b.Entries {
+		entryPath := path + "." + ne.Name
+		oe := a.Entry(ne.Name)
+		if oe == nil {
+			c.add(Change{Kind: "added", Element: "entry", Path: entryPath, Attr: "value", New: ne.Value})
+			continue
+		}
+
+		if ov, nv := entryValue(oe), entryValue(&ne); ov != nv {
+			c.add(Change{Kind: "changed", Element: "entry", Path: entryPath, Attr: "value", Old: oe.Value, New: ne.Value,
+				Breaking: true, Reason: "constant value changed"})
+		}
+		if os, ns := protocol.Version(oe.Since), protocol.Version(ne.Since); os != ns {
+			c.add(Change{Kind: "changed", Element: "entry", Path: entryPath, Attr: "since", Old: itoa(os), New: itoa(ns)})
+		}
+	}
+}
+
+// entryValue returns the value of the entry, so that a value spelled
+// differently, e.g. in hexadecimal, is not reported as a change
+func entryValue(e *protocol.Entry) string {
+	if v, err := e.Uint(); err == nil {
+		return strconv.FormatUint(uint64(v), 10)
+	}
+
+	return e.Value
+}
+
+func destructorName(v *protocol.Interface) string {
+	for _, r := range v.Requests {
+		if r.IsDestructor() {
+			return r.Name
+		}
+	}
+
+	return ""
+}
+
+func eventsToRequests(events []protocol.Event) []protocol.Request {
+	r := make([]protocol.Request, len(events))
+	for i, e := range events {
+		r[i] = protocol.Request(e)
+	}
+
+	return r
+}
+
+func itoa(i int) string {
+	return strconv.Itoa(i)
+}
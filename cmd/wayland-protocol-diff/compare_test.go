@@ -0,0 +1,87 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hempflower/go-wayland/protocol"
+)
+
+func TestCompare(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		old, new string
+		changes  []string
+	}{
+		{
+			name: "unchanged",
+			old:  "opcodes-old.xml", new: "opcodes-old.xml",
+		},
+		{
+			name: "opcodes",
+			old:  "opcodes-old.xml", new: "opcodes-new.xml",
+			changes: []string{
+				"! test_thing.set_size: request changed opcode 2 -> 1 (incompatible with peers of the old version)",
+				"! test_thing.set_title: request changed opcode 1 -> 2 (incompatible with peers of the old version)",
+			},
+		},
+		{
+			name: "additions",
+			old:  "opcodes-old.xml", new: "additions-new.xml",
+			changes: []string{
+				"  test_thing: interface changed version 1 -> 2",
+				"  test_thing.set_state: request added since 2",
+				"  test_thing.configure.scale: arg added type int",
+				"  test_thing.state: enum added",
+				"  test_other: interface added version 1",
+			},
+		},
+		{
+			name: "args",
+			old:  "opcodes-old.xml", new: "args-new.xml",
+			changes: []string{
+				"! test_thing.set_title.lang: arg added type string (parameter added)",
+				"! test_thing.set_size.height: arg changed position 1 -> 0 (parameters reordered)",
+				"! test_thing.set_size.width: arg changed position 0 -> 1 (parameters reordered)",
+				"! test_thing.set_size.width: arg changed type int -> uint (Go type changed)",
+				"! test_thing.configure: event removed (event type and handler removed)",
+			},
+		},
+		{
+			name: "enum",
+			old:  "enum-old.xml", new: "enum-new.xml",
+			changes: []string{
+				"! test_thing.edge: enum changed bitfield true -> false (Has, Set and Clear methods removed)",
+				"! test_thing.edge.left: entry removed (constant removed)",
+				"! test_thing.edge.bottom: entry changed value 2 -> 0x8 (constant value changed)",
+			},
+		},
+		{
+			name: "destructor",
+			old:  "destructor-old.xml", new: "destructor-new.xml",
+			changes: []string{
+				"! test_thing: interface changed destructor none -> release (Destroy method replaced by the destructor request)",
+				"  test_thing.release: request added since 1",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := protocol.ParseFile("testdata/" + tt.old)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := protocol.ParseFile("testdata/" + tt.new)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var changes []string
+			for _, c := range compare(a, b) {
+				changes = append(changes, c.String())
+			}
+			if !reflect.DeepEqual(changes, tt.changes) {
+				t.Errorf("got changes\n%q\nwant\n%q", changes, tt.changes)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+module github.com/hempflower/go-wayland/cmd/wayland-protocol-diff
+
+go 1.19
+
+require github.com/hempflower/go-wayland/protocol v0.0.0-00010101000000-000000000000
+
+replace github.com/hempflower/go-wayland/protocol => ../../protocol
@@ -0,0 +1,74 @@
+// Command wayland-protocol-diff compares two versions of a wayland
+// protocol xml file and reports the changes to interfaces, messages,
+// arguments and enums, flagging those that break code using the Go
+// bindings generated by go-wayland-scanner.
+//
+//	wayland-protocol-diff [-json] old.xml new.xml
+//
+// It exits with status 1 if any change is breaking and 2 on error.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hempflower/go-wayland/protocol"
+)
+
+var jsonOutput bool
+
+func init() {
+	flag.BoolVar(&jsonOutput, "json", false, "Print the changes as a json array")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [-json] old.xml new.xml\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	a, err := protocol.ParseFile(flag.Arg(0))
+	if err != nil {
+		log.Print(err)
+		os.Exit(2)
+	}
+	b, err := protocol.ParseFile(flag.Arg(1))
+	if err != nil {
+		log.Print(err)
+		os.Exit(2)
+	}
+
+	changes := compare(a, b)
+
+	if jsonOutput {
+		if changes == nil {
+			changes = []Change{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "\t")
+		if err := enc.Encode(changes); err != nil {
+			log.Print(err)
+			os.Exit(2)
+		}
+	} else {
+		for _, c := range changes {
+			fmt.Println(c)
+		}
+	}
+
+	for _, c := range changes {
+		if c.Breaking {
+			os.Exit(1)
+		}
+	}
+}
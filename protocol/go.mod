@@ -0,0 +1,3 @@
+module github.com/hempflower/go-wayland/protocol
+
+go 1.19
@@ -0,0 +1,139 @@
+// Package protocol is the model of wayland protocol xml files, as
+// parsed by go-wayland-scanner, along with helpers to query protocols
+// and to resolve references between them.
+package protocol
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Protocol is the root element of a protocol xml file.
+type Protocol struct {
+	XMLName    xml.Name    `xml:"protocol"`
+	Name       string      `xml:"name,attr"`
+	Copyright  string      `xml:"copyright"`
+	Interfaces []Interface `xml:"interface"`
+	// Checksum is the sha256 of the xml file
+	Checksum string `xml:"-"`
+}
+
+// Interface is an interface of a protocol.
+type Interface struct {
+	XMLName     xml.Name    `xml:"interface"`
+	Name        string      `xml:"name,attr"`
+	Description Description `xml:"description"`
+	Requests    []Request   `xml:"request"`
+	Events      []Event     `xml:"event"`
+	Enums       []Enum      `xml:"enum"`
+	Version     int         `xml:"version,attr"`
+}
+
+// Request is a message sent by clients. The opcode of a request is its
+// index in Interface.Requests.
+type Request struct {
+	XMLName     xml.Name    `xml:"request"`
+	Name        string      `xml:"name,attr"`
+	Type        string      `xml:"type,attr"`
+	Description Description `xml:"description"`
+	Args        []Arg       `xml:"arg"`
+	Since       int         `xml:"since,attr"`
+}
+
+// Event is a message sent by the compositor. The opcode of an event is
+// its index in Interface.Events.
+type Event struct {
+	XMLName     xml.Name    `xml:"event"`
+	Name        string      `xml:"name,attr"`
+	Type        string      `xml:"type,attr"`
+	Description Description `xml:"description"`
+	Args        []Arg       `xml:"arg"`
+	Since       int         `xml:"since,attr"`
+}
+
+// Enum is an enum of an interface.
+type Enum struct {
+	XMLName     xml.Name    `xml:"enum"`
+	Name        string      `xml:"name,attr"`
+	Description Description `xml:"description"`
+	Entries     []Entry     `xml:"entry"`
+	Since       int         `xml:"since,attr"`
+	Bitfield    bool        `xml:"bitfield,attr"`
+}
+
+// Entry is a value of an enum.
+type Entry struct {
+	XMLName     xml.Name    `xml:"entry"`
+	Name        string      `xml:"name,attr"`
+	Value       string      `xml:"value,attr"`
+	Summary     string      `xml:"summary,attr"`
+	Description Description `xml:"description"`
+	Since       int         `xml:"since,attr"`
+}
+
+// Arg is an argument of a request or an event.
+type Arg struct {
+	XMLName xml.Name `xml:"arg"`
+	Name    string   `xml:"name,attr"`
+	// Type is the wire type: int, uint, fixed, string, object, new_id,
+	// array or fd
+	Type    string `xml:"type,attr"`
+	Summary string `xml:"summary,attr"`
+	// Interface is the interface of object and new_id arguments, it is
+	// empty when any interface is accepted
+	Interface string `xml:"interface,attr"`
+	// Enum references an enum of the interface, or of another
+	// interface as "interface.enum"
+	Enum        string      `xml:"enum,attr"`
+	Description Description `xml:"description"`
+	AllowNull   bool        `xml:"allow-null,attr"`
+}
+
+// Description documents an element.
+type Description struct {
+	XMLName xml.Name `xml:"description"`
+	Text    string   `xml:",chardata"`
+	Summary string   `xml:"summary,attr"`
+}
+
+// Parse decodes a protocol xml document
+func Parse(b []byte) (*Protocol, error) {
+	p := &Protocol{}
+	if err := xml.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(b)
+	p.Checksum = hex.EncodeToString(sum[:])
+
+	return p, nil
+}
+
+// Read decodes a protocol xml document from r
+func Read(r io.Reader) (*Protocol, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(b)
+}
+
+// ParseFile decodes the protocol xml file
+func ParseFile(file string) (*Protocol, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return p, nil
+}
@@ -0,0 +1,117 @@
+package protocol
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testProtocol = `<?xml version="1.0" encoding="UTF-8"?>
+<protocol name="test">
+  <copyright>Copyright text</copyright>
+  <interface name="test_manager" version="3">
+    <description summary="manages things">Long description</description>
+    <request name="destroy" type="destructor"/>
+    <request name="get_thing" since="2">
+      <arg name="id" type="new_id" interface="test_thing"/>
+      <arg name="surface" type="object" interface="wl_surface" allow-null="true"/>
+      <arg name="state" type="uint" enum="test_thing.state"/>
+    </request>
+    <event name="done">
+      <arg name="serial" type="uint" summary="serial of the event"/>
+    </event>
+  </interface>
+  <interface name="test_thing" version="1">
+    <enum name="state" bitfield="true">
+      <entry name="none" value="0"/>
+      <entry name="active" value="1"/>
+      <entry name="focused" value="0x2"/>
+      <entry name="both" value="3"/>
+    </enum>
+  </interface>
+</protocol>
+`
+
+func TestParse(t *testing.T) {
+	p, err := Parse([]byte(testProtocol))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256([]byte(testProtocol))
+	if p.Checksum != hex.EncodeToString(sum[:]) {
+		t.Errorf("got checksum %s", p.Checksum)
+	}
+	if p.Name != "test" || p.Copyright != "Copyright text" || len(p.Interfaces) != 2 {
+		t.Fatalf("got protocol %s with %d interfaces", p.Name, len(p.Interfaces))
+	}
+
+	v := p.Interfaces[0]
+	if v.Name != "test_manager" || v.Version != 3 || v.Description.Summary != "manages things" || v.Description.Text != "Long description" {
+		t.Errorf("got interface %+v", v)
+	}
+	if len(v.Requests) != 2 || len(v.Events) != 1 {
+		t.Fatalf("got %d requests and %d events", len(v.Requests), len(v.Events))
+	}
+	if r := v.Requests[0]; r.Name != "destroy" || r.Type != "destructor" || r.Since != 0 {
+		t.Errorf("got request %+v", r)
+	}
+	r := v.Requests[1]
+	if r.Since != 2 || len(r.Args) != 3 {
+		t.Fatalf("got request %+v", r)
+	}
+	if a := r.Args[0]; a.Type != "new_id" || a.Interface != "test_thing" || a.AllowNull {
+		t.Errorf("got arg %+v", a)
+	}
+	if a := r.Args[1]; a.Type != "object" || a.Interface != "wl_surface" || !a.AllowNull {
+		t.Errorf("got arg %+v", a)
+	}
+	if a := r.Args[2]; a.Enum != "test_thing.state" {
+		t.Errorf("got arg %+v", a)
+	}
+	if a := v.Events[0].Args[0]; a.Summary != "serial of the event" {
+		t.Errorf("got arg %+v", a)
+	}
+
+	e := p.Interfaces[1].Enums[0]
+	if !e.Bitfield || len(e.Entries) != 4 || e.Entries[2].Value != "0x2" {
+		t.Errorf("got enum %+v", e)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"<protocol name=\"test\"><interface name=\"a\"></protocol>",
+		"<interface name=\"a\" version=\"1\"/>",
+		"<protocol name=\"test\"><interface name=\"a\" version=\"one\"/></protocol>",
+	} {
+		if _, err := Parse([]byte(s)); err == nil {
+			t.Errorf("%q parsed without error", s)
+		}
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "test.xml")
+	if err := os.WriteFile(file, []byte("<protocol>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseFile(file); err == nil || !strings.HasPrefix(err.Error(), file+": ") {
+		t.Errorf("got error %v, want it prefixed with the file", err)
+	}
+
+	if err := os.WriteFile(file, []byte(testProtocol), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	p, err := ParseFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "test" {
+		t.Errorf("got protocol %s", p.Name)
+	}
+}
@@ -0,0 +1,198 @@
+package protocol
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version returns the effective value of a version or since attribute,
+// elements without one are available since version 1
+func Version(v int) int {
+	if v == 0 {
+		return 1
+	}
+
+	return v
+}
+
+// Interface returns the interface of the protocol with the given name,
+// or nil if there is none
+func (p *Protocol) Interface(name string) *Interface {
+	for i := range p.Interfaces {
+		if p.Interfaces[i].Name == name {
+			return &p.Interfaces[i]
+		}
+	}
+
+	return nil
+}
+
+// References returns the names of the interfaces referenced by
+// arguments of the protocol but declared by other protocols, in order of
+// appearance
+func (p *Protocol) References() []string {
+	var refs []string
+	seen := map[string]bool{}
+	add := func(iface string) {
+		if iface == "" || seen[iface] || p.Interface(iface) != nil {
+			return
+		}
+		seen[iface] = true
+		refs = append(refs, iface)
+	}
+
+	for _, v := range p.Interfaces {
+		for _, m := range v.Messages() {
+			for _, arg := range m.Args {
+				add(arg.Interface)
+				if iface, _ := SplitEnum(arg.Enum); iface != "" {
+					add(iface)
+				}
+			}
+		}
+	}
+
+	return refs
+}
+
+// Request returns the request of the interface with the given name, or
+// nil if there is none
+func (v *Interface) Request(name string) *Request {
+	for i := range v.Requests {
+		if v.Requests[i].Name == name {
+			return &v.Requests[i]
+		}
+	}
+
+	return nil
+}
+
+// Event returns the event of the interface with the given name, or nil
+// if there is none
+func (v *Interface) Event(name string) *Event {
+	for i := range v.Events {
+		if v.Events[i].Name == name {
+			return &v.Events[i]
+		}
+	}
+
+	return nil
+}
+
+// Enum returns the enum of the interface with the given name, or nil if
+// there is none
+func (v *Interface) Enum(name string) *Enum {
+	for i := range v.Enums {
+		if v.Enums[i].Name == name {
+			return &v.Enums[i]
+		}
+	}
+
+	return nil
+}
+
+// HasDestructor reports whether a request of the interface is a
+// destructor
+func (v *Interface) HasDestructor() bool {
+	for _, r := range v.Requests {
+		if r.IsDestructor() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Messages returns the requests of the interface followed by its
+// events, events being converted to requests
+func (v *Interface) Messages() []Request {
+	messages := make([]Request, 0, len(v.Requests)+len(v.Events))
+	messages = append(messages, v.Requests...)
+	for _, e := range v.Events {
+		messages = append(messages, Request(e))
+	}
+
+	return messages
+}
+
+// IsDestructor reports whether the request destroys the object
+func (r *Request) IsDestructor() bool {
+	return r.Type == "destructor"
+}
+
+// NewIDs returns the new_id arguments of the message
+func (r *Request) NewIDs() []Arg {
+	return newIDs(r.Args)
+}
+
+// NewIDs returns the new_id arguments of the message
+func (e *Event) NewIDs() []Arg {
+	return newIDs(e.Args)
+}
+
+func newIDs(args []Arg) []Arg {
+	var ids []Arg
+	for _, arg := range args {
+		if arg.Type == "new_id" {
+			ids = append(ids, arg)
+		}
+	}
+
+	return ids
+}
+
+// Uint returns the numeric value of the entry, which is decimal or
+// hexadecimal
+func (e *Entry) Uint() (uint32, error) {
+	v, err := strconv.ParseUint(e.Value, 0, 32)
+	return uint32(v), err
+}
+
+// Entry returns the entry of the enum with the given name, or nil if
+// there is none
+func (e *Enum) Entry(name string) *Entry {
+	for i := range e.Entries {
+		if e.Entries[i].Name == name {
+			return &e.Entries[i]
+		}
+	}
+
+	return nil
+}
+
+// Flags returns the entries of the enum whose value is a single bit
+func (e *Enum) Flags() []Entry {
+	var flags []Entry
+	for _, entry := range e.Entries {
+		v, err := entry.Uint()
+		if err != nil || v == 0 || v&(v-1) != 0 {
+			continue
+		}
+		flags = append(flags, entry)
+	}
+
+	return flags
+}
+
+// Zero returns the entry of the enum with value 0, or nil if there is
+// none
+func (e *Enum) Zero() *Entry {
+	for i := range e.Entries {
+		if v, err := e.Entries[i].Uint(); err == nil && v == 0 {
+			return &e.Entries[i]
+		}
+	}
+
+	return nil
+}
+
+// SplitEnum splits an enum reference of the form "interface.enum", the
+// interface is empty for references local to the interface of the
+// argument
+func SplitEnum(ref string) (iface string, enum string) {
+	if idx := strings.IndexByte(ref, '.'); idx != -1 {
+		return ref[:idx], ref[idx+1:]
+	}
+
+	return "", ref
+}
@@ -0,0 +1,115 @@
+package protocol
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	for _, tt := range []struct{ v, want int }{
+		{0, 1},
+		{1, 1},
+		{4, 4},
+	} {
+		if got := Version(tt.v); got != tt.want {
+			t.Errorf("Version(%d) = %d, want %d", tt.v, got, tt.want)
+		}
+	}
+
+	p, err := Parse([]byte(testProtocol))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := p.Interface("test_manager")
+	if got := Version(v.Request("destroy").Since); got != 1 {
+		t.Errorf("since of a request without attribute is %d, want 1", got)
+	}
+	if got := Version(v.Request("get_thing").Since); got != 2 {
+		t.Errorf("since of get_thing is %d, want 2", got)
+	}
+}
+
+func TestEntryUint(t *testing.T) {
+	for _, tt := range []struct {
+		value string
+		want  uint32
+		err   bool
+	}{
+		{"0", 0, false},
+		{"42", 42, false},
+		{"0x10", 16, false},
+		{"0xffffffff", 0xffffffff, false},
+		{"0x100000000", 0, true},
+		{"-1", 0, true},
+		{"one", 0, true},
+		{"", 0, true},
+	} {
+		e := Entry{Value: tt.value}
+		got, err := e.Uint()
+		if (err != nil) != tt.err || (!tt.err && got != tt.want) {
+			t.Errorf("Uint of %q = %d, %v, want %d, error %v", tt.value, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestSplitEnum(t *testing.T) {
+	for _, tt := range []struct {
+		ref         string
+		iface, enum string
+	}{
+		{"state", "", "state"},
+		{"wl_output.transform", "wl_output", "transform"},
+		{"", "", ""},
+	} {
+		if iface, enum := SplitEnum(tt.ref); iface != tt.iface || enum != tt.enum {
+			t.Errorf("SplitEnum(%q) = %q, %q, want %q, %q", tt.ref, iface, enum, tt.iface, tt.enum)
+		}
+	}
+}
+
+func TestQueries(t *testing.T) {
+	p, err := Parse([]byte(testProtocol))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Interface("test_missing") != nil {
+		t.Error("unknown interface found")
+	}
+	v := p.Interface("test_manager")
+	if v == nil || v.Event("done") == nil || v.Request("done") != nil || v.Enum("state") != nil {
+		t.Fatal("unexpected messages of test_manager")
+	}
+	if !v.HasDestructor() || p.Interface("test_thing").HasDestructor() {
+		t.Error("unexpected destructors")
+	}
+
+	var names []string
+	for _, m := range v.Messages() {
+		names = append(names, m.Name)
+	}
+	if want := []string{"destroy", "get_thing", "done"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got messages %v, want %v", names, want)
+	}
+	if ids := v.Request("get_thing").NewIDs(); len(ids) != 1 || ids[0].Name != "id" {
+		t.Errorf("got new_ids %v", ids)
+	}
+	if refs := p.References(); !reflect.DeepEqual(refs, []string{"wl_surface"}) {
+		t.Errorf("got references %v", refs)
+	}
+
+	e := p.Interface("test_thing").Enum("state")
+	if e.Entry("active") == nil || e.Entry("missing") != nil {
+		t.Error("unexpected entries")
+	}
+	if z := e.Zero(); z == nil || z.Name != "none" {
+		t.Errorf("got zero entry %v", z)
+	}
+	var flags []string
+	for _, f := range e.Flags() {
+		flags = append(flags, f.Name)
+	}
+	if want := []string{"active", "focused"}; !reflect.DeepEqual(flags, want) {
+		t.Errorf("got flags %v, want %v", flags, want)
+	}
+}
@@ -0,0 +1,143 @@
+package protocol
+
+import (
+	"fmt"
+)
+
+// Set links protocols together, resolving the interfaces and enums
+// referenced across them. Protocols usually build upon the core
+// wayland protocol and reference its interfaces.
+type Set struct {
+	Protocols []*Protocol
+
+	// index maps interface names to the protocol declaring them
+	index map[string]*Protocol
+}
+
+// NewSet returns a set of the given protocols
+func NewSet(protocols ...*Protocol) (*Set, error) {
+	s := &Set{}
+	for _, p := range protocols {
+		if err := s.Add(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Add adds a protocol to the set, interface names must be unique
+// across the protocols of a set
+func (s *Set) Add(p *Protocol) error {
+	if s.index == nil {
+		s.index = map[string]*Protocol{}
+	}
+
+	for _, v := range p.Interfaces {
+		if other, ok := s.index[v.Name]; ok {
+			return fmt.Errorf("interface %s declared by both %s and %s", v.Name, other.Name, p.Name)
+		}
+	}
+	for _, v := range p.Interfaces {
+		s.index[v.Name] = p
+	}
+	s.Protocols = append(s.Protocols, p)
+
+	return nil
+}
+
+// Protocol returns the protocol with the given name, or nil if there is
+// none
+func (s *Set) Protocol(name string) *Protocol {
+	for _, p := range s.Protocols {
+		if p.Name == name {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// Lookup returns the interface with the given name along with the
+// protocol declaring it, or nils if no protocol of the set does
+func (s *Set) Lookup(iface string) (*Protocol, *Interface) {
+	p, ok := s.index[iface]
+	if !ok {
+		return nil, nil
+	}
+
+	return p, p.Interface(iface)
+}
+
+// LookupEnum resolves the enum reference of an argument of iface, see
+// Arg.Enum. It returns the interface declaring the enum and the enum,
+// or nils if it is unknown.
+func (s *Set) LookupEnum(iface string, ref string) (*Interface, *Enum) {
+	owner, enum := SplitEnum(ref)
+	if owner == "" {
+		owner = iface
+	}
+
+	_, v := s.Lookup(owner)
+	if v == nil {
+		return nil, nil
+	}
+	e := v.Enum(enum)
+	if e == nil {
+		return nil, nil
+	}
+
+	return v, e
+}
+
+// Reference is an interface or enum reference of an argument.
+type Reference struct {
+	Protocol  string
+	Interface string
+	Message   string
+	Arg       string
+	// Target is the referenced interface, or the enum reference as
+	// written in the argument
+	Target string
+	// Enum reports whether Target is an enum reference
+	Enum bool
+}
+
+func (r Reference) String() string {
+	kind := "interface"
+	if r.Enum {
+		kind = "enum"
+	}
+
+	return fmt.Sprintf("%s: %s.%s.%s: unknown %s %s", r.Protocol, r.Interface, r.Message, r.Arg, kind, r.Target)
+}
+
+// Unresolved returns the references of the protocols of the set that
+// no protocol of the set declares
+func (s *Set) Unresolved() []Reference {
+	var refs []Reference
+	for _, p := range s.Protocols {
+		for _, v := range p.Interfaces {
+			for _, m := range v.Messages() {
+				for _, arg := range m.Args {
+					ref := Reference{Protocol: p.Name, Interface: v.Name, Message: m.Name, Arg: arg.Name}
+
+					if arg.Interface != "" {
+						if _, iface := s.Lookup(arg.Interface); iface == nil {
+							ref.Target = arg.Interface
+							refs = append(refs, ref)
+						}
+					}
+					if arg.Enum != "" {
+						if _, e := s.LookupEnum(v.Name, arg.Enum); e == nil {
+							ref.Target, ref.Enum = arg.Enum, true
+							refs = append(refs, ref)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return refs
+}
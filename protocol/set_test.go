@@ -0,0 +1,67 @@
+package protocol
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testCore = `<protocol name="wayland">
+  <interface name="wl_surface" version="6">
+    <enum name="error">
+      <entry name="invalid_scale" value="0"/>
+    </enum>
+  </interface>
+</protocol>`
+
+func TestSet(t *testing.T) {
+	p, err := Parse([]byte(testProtocol))
+	if err != nil {
+		t.Fatal(err)
+	}
+	core, err := Parse([]byte(testCore))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := NewSet(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Reference{{Protocol: "test", Interface: "test_manager", Message: "get_thing", Arg: "surface", Target: "wl_surface"}}
+	if refs := s.Unresolved(); !reflect.DeepEqual(refs, want) {
+		t.Errorf("got unresolved references %v, want %v", refs, want)
+	}
+
+	if err := s.Add(core); err != nil {
+		t.Fatal(err)
+	}
+	if refs := s.Unresolved(); len(refs) != 0 {
+		t.Errorf("got unresolved references %v", refs)
+	}
+	if s.Protocol("wayland") != core || s.Protocol("missing") != nil {
+		t.Error("unexpected protocols")
+	}
+	if owner, v := s.Lookup("wl_surface"); owner != core || v == nil || v.Version != 6 {
+		t.Errorf("wl_surface resolved to %v", v)
+	}
+	if v, e := s.LookupEnum("test_manager", "test_thing.state"); v == nil || v.Name != "test_thing" || e == nil {
+		t.Error("test_thing.state unresolved")
+	}
+	if v, e := s.LookupEnum("test_thing", "state"); v == nil || e == nil || e.Name != "state" {
+		t.Error("local enum unresolved")
+	}
+	if v, e := s.LookupEnum("test_manager", "state"); v != nil || e != nil {
+		t.Error("state resolved on test_manager")
+	}
+
+	if err := s.Add(core); err == nil {
+		t.Error("interfaces declared twice")
+	}
+}
+
+func TestReferenceString(t *testing.T) {
+	r := Reference{Protocol: "test", Interface: "test_manager", Message: "get_thing", Arg: "state", Target: "test_thing.mode", Enum: true}
+	if got, want := r.String(), "test: test_manager.get_thing.state: unknown enum test_thing.mode"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -1,6 +1,8 @@
 // Generated by go-wayland-scanner
 // https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
-// XML file : https://gitlab.freedesktop.org/wayland/wayland/-/raw/1.21.0/protocol/wayland.xml
+// Scanner version : 0.2.1
+// XML file : wayland.xml
+// XML sha256 : 539f5bf30aea8d734938e17341ee1d7c8caecf960c7c3ae80a80867e75232b4c
 //
 // wayland Protocol Copyright:
 //
@@ -31,11 +33,62 @@
 
 package client
 
-import "golang.org/x/sys/unix"
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
 
 // DisplayName : core global object
 const DisplayName = "wl_display"
 
+// DisplayInterface : metadata of the wl_display interface
+var DisplayInterface = &Interface{
+	Name:    DisplayName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewDisplay(ctx) },
+	Requests: []Message{
+		{
+			Name:  "sync",
+			Since: 1,
+			Args: []Arg{
+				{Name: "callback", Type: ArgTypeNewID, Interface: "wl_callback"},
+			},
+		},
+		{
+			Name:  "get_registry",
+			Since: 1,
+			Args: []Arg{
+				{Name: "registry", Type: ArgTypeNewID, Interface: "wl_registry"},
+			},
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "error",
+			Since: 1,
+			Args: []Arg{
+				{Name: "object_id", Type: ArgTypeObject},
+				{Name: "code", Type: ArgTypeUint},
+				{Name: "message", Type: ArgTypeString},
+			},
+		},
+		{
+			Name:  "delete_id",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns DisplayInterface
+func (i *Display) Interface() *Interface {
+	return DisplayInterface
+}
+
 // Display : core global object
 //
 // The core global object.  This is a special singleton object.  It
@@ -56,6 +109,9 @@ func NewDisplay(ctx *Context) *Display {
 	return wlDisplay
 }
 
+// DisplaySyncSinceVersion : version of Display that introduced Sync
+const DisplaySyncSinceVersion = 1
+
 // Sync : asynchronous roundtrip
 //
 // The sync request asks the server to emit the 'done' event
@@ -71,6 +127,7 @@ func NewDisplay(ctx *Context) *Display {
 // The callback_data passed in the callback is the event serial.
 func (i *Display) Sync() (*Callback, error) {
 	callback := NewCallback(i.Context())
+	callback.SetVersion(i.Version())
 	const opcode = 0
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -85,6 +142,9 @@ func (i *Display) Sync() (*Callback, error) {
 	return callback, err
 }
 
+// DisplayGetRegistrySinceVersion : version of Display that introduced GetRegistry
+const DisplayGetRegistrySinceVersion = 1
+
 // GetRegistry : get global registry object
 //
 // This request creates a registry object that allows the client
@@ -98,6 +158,7 @@ func (i *Display) Sync() (*Callback, error) {
 // possible to avoid wasting memory.
 func (i *Display) GetRegistry() (*Registry, error) {
 	registry := NewRegistry(i.Context())
+	registry.SetVersion(i.Version())
 	const opcode = 1
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -182,6 +243,10 @@ type DisplayErrorEvent struct {
 	Code     uint32
 	Message  string
 }
+
+// DisplayErrorSinceVersion : version of Display that introduced DisplayErrorEvent
+const DisplayErrorSinceVersion = 1
+
 type DisplayErrorHandlerFunc func(DisplayErrorEvent)
 
 // SetErrorHandler : sets handler for DisplayErrorEvent
@@ -199,6 +264,10 @@ func (i *Display) SetErrorHandler(f DisplayErrorHandlerFunc) {
 type DisplayDeleteIdEvent struct {
 	Id uint32
 }
+
+// DisplayDeleteIdSinceVersion : version of Display that introduced DisplayDeleteIdEvent
+const DisplayDeleteIdSinceVersion = 1
+
 type DisplayDeleteIdHandlerFunc func(DisplayDeleteIdEvent)
 
 // SetDeleteIdHandler : sets handler for DisplayDeleteIdEvent
@@ -240,6 +309,46 @@ func (i *Display) Dispatch(opcode uint32, fd int, data []byte) {
 // RegistryName : global registry object
 const RegistryName = "wl_registry"
 
+// RegistryInterface : metadata of the wl_registry interface
+var RegistryInterface = &Interface{
+	Name:    RegistryName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewRegistry(ctx) },
+	Requests: []Message{
+		{
+			Name:  "bind",
+			Since: 1,
+			Args: []Arg{
+				{Name: "name", Type: ArgTypeUint},
+				{Name: "id", Type: ArgTypeNewID},
+			},
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "global",
+			Since: 1,
+			Args: []Arg{
+				{Name: "name", Type: ArgTypeUint},
+				{Name: "interface", Type: ArgTypeString},
+				{Name: "version", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "global_remove",
+			Since: 1,
+			Args: []Arg{
+				{Name: "name", Type: ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns RegistryInterface
+func (i *Registry) Interface() *Interface {
+	return RegistryInterface
+}
+
 // Registry : global registry object
 //
 // The singleton global registry object.  The server has a number of
@@ -296,6 +405,9 @@ func NewRegistry(ctx *Context) *Registry {
 	return wlRegistry
 }
 
+// RegistryBindSinceVersion : version of Registry that introduced Bind
+const RegistryBindSinceVersion = 1
+
 // Bind : bind an object to the display
 //
 // Binds a new, client-created object to the server using the
@@ -303,6 +415,7 @@ func NewRegistry(ctx *Context) *Registry {
 //
 //	name: unique numeric name of the object
 func (i *Registry) Bind(name uint32, iface string, version uint32, id Proxy) error {
+	id.SetVersion(version)
 	const opcode = 0
 	ifaceLen := PaddedLen(len(iface) + 1)
 	_reqBufLen := 8 + 4 + (4 + ifaceLen) + 4 + 4
@@ -341,6 +454,10 @@ type RegistryGlobalEvent struct {
 	Interface string
 	Version   uint32
 }
+
+// RegistryGlobalSinceVersion : version of Registry that introduced RegistryGlobalEvent
+const RegistryGlobalSinceVersion = 1
+
 type RegistryGlobalHandlerFunc func(RegistryGlobalEvent)
 
 // SetGlobalHandler : sets handler for RegistryGlobalEvent
@@ -363,6 +480,10 @@ func (i *Registry) SetGlobalHandler(f RegistryGlobalHandlerFunc) {
 type RegistryGlobalRemoveEvent struct {
 	Name uint32
 }
+
+// RegistryGlobalRemoveSinceVersion : version of Registry that introduced RegistryGlobalRemoveEvent
+const RegistryGlobalRemoveSinceVersion = 1
+
 type RegistryGlobalRemoveHandlerFunc func(RegistryGlobalRemoveEvent)
 
 // SetGlobalRemoveHandler : sets handler for RegistryGlobalRemoveEvent
@@ -404,6 +525,27 @@ func (i *Registry) Dispatch(opcode uint32, fd int, data []byte) {
 // CallbackName : callback object
 const CallbackName = "wl_callback"
 
+// CallbackInterface : metadata of the wl_callback interface
+var CallbackInterface = &Interface{
+	Name:    CallbackName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewCallback(ctx) },
+	Events: []Message{
+		{
+			Name:  "done",
+			Since: 1,
+			Args: []Arg{
+				{Name: "callback_data", Type: ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns CallbackInterface
+func (i *Callback) Interface() *Interface {
+	return CallbackInterface
+}
+
 // Callback : callback object
 //
 // Clients can handle the 'done' event to get notified when
@@ -434,6 +576,10 @@ func (i *Callback) Destroy() error {
 type CallbackDoneEvent struct {
 	CallbackData uint32
 }
+
+// CallbackDoneSinceVersion : version of Callback that introduced CallbackDoneEvent
+const CallbackDoneSinceVersion = 1
+
 type CallbackDoneHandlerFunc func(CallbackDoneEvent)
 
 // SetDoneHandler : sets handler for CallbackDoneEvent
@@ -459,6 +605,34 @@ func (i *Callback) Dispatch(opcode uint32, fd int, data []byte) {
 // CompositorName : the compositor singleton
 const CompositorName = "wl_compositor"
 
+// CompositorInterface : metadata of the wl_compositor interface
+var CompositorInterface = &Interface{
+	Name:    CompositorName,
+	Version: 5,
+	New:     func(ctx *Context) Proxy { return NewCompositor(ctx) },
+	Requests: []Message{
+		{
+			Name:  "create_surface",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_surface"},
+			},
+		},
+		{
+			Name:  "create_region",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_region"},
+			},
+		},
+	},
+}
+
+// Interface : returns CompositorInterface
+func (i *Compositor) Interface() *Interface {
+	return CompositorInterface
+}
+
 // Compositor : the compositor singleton
 //
 // A compositor.  This object is a singleton global.  The
@@ -479,11 +653,15 @@ func NewCompositor(ctx *Context) *Compositor {
 	return wlCompositor
 }
 
+// CompositorCreateSurfaceSinceVersion : version of Compositor that introduced CreateSurface
+const CompositorCreateSurfaceSinceVersion = 1
+
 // CreateSurface : create new surface
 //
 // Ask the compositor to create a new surface.
 func (i *Compositor) CreateSurface() (*Surface, error) {
 	id := NewSurface(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 0
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -498,11 +676,15 @@ func (i *Compositor) CreateSurface() (*Surface, error) {
 	return id, err
 }
 
+// CompositorCreateRegionSinceVersion : version of Compositor that introduced CreateRegion
+const CompositorCreateRegionSinceVersion = 1
+
 // CreateRegion : create new region
 //
 // Ask the compositor to create a new region.
 func (i *Compositor) CreateRegion() (*Region, error) {
 	id := NewRegion(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 1
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -525,6 +707,43 @@ func (i *Compositor) Destroy() error {
 // ShmPoolName : a shared memory pool
 const ShmPoolName = "wl_shm_pool"
 
+// ShmPoolInterface : metadata of the wl_shm_pool interface
+var ShmPoolInterface = &Interface{
+	Name:    ShmPoolName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewShmPool(ctx) },
+	Requests: []Message{
+		{
+			Name:  "create_buffer",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_buffer"},
+				{Name: "offset", Type: ArgTypeInt},
+				{Name: "width", Type: ArgTypeInt},
+				{Name: "height", Type: ArgTypeInt},
+				{Name: "stride", Type: ArgTypeInt},
+				{Name: "format", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "resize",
+			Since: 1,
+			Args: []Arg{
+				{Name: "size", Type: ArgTypeInt},
+			},
+		},
+	},
+}
+
+// Interface : returns ShmPoolInterface
+func (i *ShmPool) Interface() *Interface {
+	return ShmPoolInterface
+}
+
 // ShmPool : a shared memory pool
 //
 // The wl_shm_pool object encapsulates a piece of memory shared
@@ -553,6 +772,9 @@ func NewShmPool(ctx *Context) *ShmPool {
 	return wlShmPool
 }
 
+// ShmPoolCreateBufferSinceVersion : version of ShmPool that introduced CreateBuffer
+const ShmPoolCreateBufferSinceVersion = 1
+
 // CreateBuffer : create a buffer from the pool
 //
 // Create a wl_buffer object from the pool.
@@ -572,8 +794,9 @@ func NewShmPool(ctx *Context) *ShmPool {
 //	height: buffer height, in pixels
 //	stride: number of bytes from the beginning of one row to the beginning of the next row
 //	format: buffer pixel format
-func (i *ShmPool) CreateBuffer(offset, width, height, stride int32, format uint32) (*Buffer, error) {
+func (i *ShmPool) CreateBuffer(offset, width, height, stride int32, format ShmFormat) (*Buffer, error) {
 	id := NewBuffer(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 0
 	const _reqBufLen = 8 + 4 + 4 + 4 + 4 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -598,6 +821,9 @@ func (i *ShmPool) CreateBuffer(offset, width, height, stride int32, format uint3
 	return id, err
 }
 
+// ShmPoolDestroySinceVersion : version of ShmPool that introduced Destroy
+const ShmPoolDestroySinceVersion = 1
+
 // Destroy : destroy the pool
 //
 // Destroy the shared memory pool.
@@ -619,6 +845,9 @@ func (i *ShmPool) Destroy() error {
 	return err
 }
 
+// ShmPoolResizeSinceVersion : version of ShmPool that introduced Resize
+const ShmPoolResizeSinceVersion = 1
+
 // Resize : change the size of the pool mapping
 //
 // This request will cause the server to remap the backing memory
@@ -651,6 +880,38 @@ func (i *ShmPool) Resize(size int32) error {
 // ShmName : shared memory support
 const ShmName = "wl_shm"
 
+// ShmInterface : metadata of the wl_shm interface
+var ShmInterface = &Interface{
+	Name:    ShmName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewShm(ctx) },
+	Requests: []Message{
+		{
+			Name:  "create_pool",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_shm_pool"},
+				{Name: "fd", Type: ArgTypeFd},
+				{Name: "size", Type: ArgTypeInt},
+			},
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "format",
+			Since: 1,
+			Args: []Arg{
+				{Name: "format", Type: ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns ShmInterface
+func (i *Shm) Interface() *Interface {
+	return ShmInterface
+}
+
 // Shm : shared memory support
 //
 // A singleton global object that provides support for shared
@@ -684,6 +945,9 @@ func NewShm(ctx *Context) *Shm {
 	return wlShm
 }
 
+// ShmCreatePoolSinceVersion : version of Shm that introduced CreatePool
+const ShmCreatePoolSinceVersion = 1
+
 // CreatePool : create a shm pool
 //
 // Create a new wl_shm_pool object.
@@ -696,6 +960,7 @@ func NewShm(ctx *Context) *Shm {
 //	size: pool size, in bytes
 func (i *Shm) CreatePool(fd int, size int32) (*ShmPool, error) {
 	id := NewShmPool(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 0
 	const _reqBufLen = 8 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -1441,8 +1706,12 @@ func (e ShmFormat) String() string {
 // can be used for buffers. Known formats include
 // argb8888 and xrgb8888.
 type ShmFormatEvent struct {
-	Format uint32
+	Format ShmFormat
 }
+
+// ShmFormatSinceVersion : version of Shm that introduced ShmFormatEvent
+const ShmFormatSinceVersion = 1
+
 type ShmFormatHandlerFunc func(ShmFormatEvent)
 
 // SetFormatHandler : sets handler for ShmFormatEvent
@@ -1458,7 +1727,7 @@ func (i *Shm) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e ShmFormatEvent
 		l := 0
-		e.Format = Uint32(data[l : l+4])
+		e.Format = ShmFormat(Uint32(data[l : l+4]))
 		l += 4
 
 		i.formatHandler(e)
@@ -1468,6 +1737,30 @@ func (i *Shm) Dispatch(opcode uint32, fd int, data []byte) {
 // BufferName : content for a wl_surface
 const BufferName = "wl_buffer"
 
+// BufferInterface : metadata of the wl_buffer interface
+var BufferInterface = &Interface{
+	Name:    BufferName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewBuffer(ctx) },
+	Requests: []Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "release",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns BufferInterface
+func (i *Buffer) Interface() *Interface {
+	return BufferInterface
+}
+
 // Buffer : content for a wl_surface
 //
 // A buffer provides the content for a wl_surface. Buffers are
@@ -1503,6 +1796,9 @@ func NewBuffer(ctx *Context) *Buffer {
 	return wlBuffer
 }
 
+// BufferDestroySinceVersion : version of Buffer that introduced Destroy
+const BufferDestroySinceVersion = 1
+
 // Destroy : destroy a buffer
 //
 // Destroy a buffer. If and how you need to release the backing
@@ -1538,6 +1834,10 @@ func (i *Buffer) Destroy() error {
 // wl_surface contents, e.g. as a GL texture. This is an important
 // optimization for GL(ES) compositors with wl_shm clients.
 type BufferReleaseEvent struct{}
+
+// BufferReleaseSinceVersion : version of Buffer that introduced BufferReleaseEvent
+const BufferReleaseSinceVersion = 1
+
 type BufferReleaseHandlerFunc func(BufferReleaseEvent)
 
 // SetReleaseHandler : sets handler for BufferReleaseEvent
@@ -1560,6 +1860,75 @@ func (i *Buffer) Dispatch(opcode uint32, fd int, data []byte) {
 // DataOfferName : offer to transfer data
 const DataOfferName = "wl_data_offer"
 
+// DataOfferInterface : metadata of the wl_data_offer interface
+var DataOfferInterface = &Interface{
+	Name:    DataOfferName,
+	Version: 3,
+	New:     func(ctx *Context) Proxy { return NewDataOffer(ctx) },
+	Requests: []Message{
+		{
+			Name:  "accept",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "mime_type", Type: ArgTypeString},
+			},
+		},
+		{
+			Name:  "receive",
+			Since: 1,
+			Args: []Arg{
+				{Name: "mime_type", Type: ArgTypeString},
+				{Name: "fd", Type: ArgTypeFd},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "finish",
+			Since: 3,
+		},
+		{
+			Name:  "set_actions",
+			Since: 3,
+			Args: []Arg{
+				{Name: "dnd_actions", Type: ArgTypeUint},
+				{Name: "preferred_action", Type: ArgTypeUint},
+			},
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "offer",
+			Since: 1,
+			Args: []Arg{
+				{Name: "mime_type", Type: ArgTypeString},
+			},
+		},
+		{
+			Name:  "source_actions",
+			Since: 3,
+			Args: []Arg{
+				{Name: "source_actions", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "action",
+			Since: 3,
+			Args: []Arg{
+				{Name: "dnd_action", Type: ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns DataOfferInterface
+func (i *DataOffer) Interface() *Interface {
+	return DataOfferInterface
+}
+
 // DataOffer : offer to transfer data
 //
 // A wl_data_offer represents a piece of data offered for transfer
@@ -1589,6 +1958,9 @@ func NewDataOffer(ctx *Context) *DataOffer {
 	return wlDataOffer
 }
 
+// DataOfferAcceptSinceVersion : version of DataOffer that introduced Accept
+const DataOfferAcceptSinceVersion = 1
+
 // Accept : accept one of the offered mime types
 //
 // Indicate that the client can accept the given mime type, or
@@ -1626,6 +1998,9 @@ func (i *DataOffer) Accept(serial uint32, mimeType string) error {
 	return err
 }
 
+// DataOfferReceiveSinceVersion : version of DataOffer that introduced Receive
+const DataOfferReceiveSinceVersion = 1
+
 // Receive : request that the data is transferred
 //
 // To transfer the offered data, the client issues this request
@@ -1663,6 +2038,9 @@ func (i *DataOffer) Receive(mimeType string, fd int) error {
 	return err
 }
 
+// DataOfferDestroySinceVersion : version of DataOffer that introduced Destroy
+const DataOfferDestroySinceVersion = 1
+
 // Destroy : destroy data offer
 //
 // Destroy the data offer.
@@ -1680,6 +2058,9 @@ func (i *DataOffer) Destroy() error {
 	return err
 }
 
+// DataOfferFinishSinceVersion : version of DataOffer that introduced Finish
+const DataOfferFinishSinceVersion = 3
+
 // Finish : the offer will no longer be used
 //
 // Notifies the compositor that the drag destination successfully
@@ -1697,6 +2078,9 @@ func (i *DataOffer) Destroy() error {
 // If wl_data_offer.finish request is received for a non drag and drop
 // operation, the invalid_finish protocol error is raised.
 func (i *DataOffer) Finish() error {
+	if v := i.Version(); v < DataOfferFinishSinceVersion {
+		return &VersionError{Interface: DataOfferName, Request: "finish", Since: DataOfferFinishSinceVersion, Version: v}
+	}
 	const opcode = 3
 	const _reqBufLen = 8
 	var _reqBuf [_reqBufLen]byte
@@ -1709,6 +2093,9 @@ func (i *DataOffer) Finish() error {
 	return err
 }
 
+// DataOfferSetActionsSinceVersion : version of DataOffer that introduced SetActions
+const DataOfferSetActionsSinceVersion = 3
+
 // SetActions : set the available/preferred drag-and-drop actions
 //
 // Sets the actions that the destination side client supports for
@@ -1745,7 +2132,10 @@ func (i *DataOffer) Finish() error {
 //
 //	dndActions: actions supported by the destination client
 //	preferredAction: action preferred by the destination client
-func (i *DataOffer) SetActions(dndActions, preferredAction uint32) error {
+func (i *DataOffer) SetActions(dndActions, preferredAction DataDeviceManagerDndAction) error {
+	if v := i.Version(); v < DataOfferSetActionsSinceVersion {
+		return &VersionError{Interface: DataOfferName, Request: "set_actions", Since: DataOfferSetActionsSinceVersion, Version: v}
+	}
 	const opcode = 4
 	const _reqBufLen = 8 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -1817,6 +2207,10 @@ func (e DataOfferError) String() string {
 type DataOfferOfferEvent struct {
 	MimeType string
 }
+
+// DataOfferOfferSinceVersion : version of DataOffer that introduced DataOfferOfferEvent
+const DataOfferOfferSinceVersion = 1
+
 type DataOfferOfferHandlerFunc func(DataOfferOfferEvent)
 
 // SetOfferHandler : sets handler for DataOfferOfferEvent
@@ -1830,8 +2224,12 @@ func (i *DataOffer) SetOfferHandler(f DataOfferOfferHandlerFunc) {
 // will be sent right after wl_data_device.enter, or anytime the source
 // side changes its offered actions through wl_data_source.set_actions.
 type DataOfferSourceActionsEvent struct {
-	SourceActions uint32
+	SourceActions DataDeviceManagerDndAction
 }
+
+// DataOfferSourceActionsSinceVersion : version of DataOffer that introduced DataOfferSourceActionsEvent
+const DataOfferSourceActionsSinceVersion = 3
+
 type DataOfferSourceActionsHandlerFunc func(DataOfferSourceActionsEvent)
 
 // SetSourceActionsHandler : sets handler for DataOfferSourceActionsEvent
@@ -1877,8 +2275,12 @@ func (i *DataOffer) SetSourceActionsHandler(f DataOfferSourceActionsHandlerFunc)
 // final wl_data_offer.set_actions and wl_data_offer.accept requests
 // must happen before the call to wl_data_offer.finish.
 type DataOfferActionEvent struct {
-	DndAction uint32
+	DndAction DataDeviceManagerDndAction
 }
+
+// DataOfferActionSinceVersion : version of DataOffer that introduced DataOfferActionEvent
+const DataOfferActionSinceVersion = 3
+
 type DataOfferActionHandlerFunc func(DataOfferActionEvent)
 
 // SetActionHandler : sets handler for DataOfferActionEvent
@@ -1906,7 +2308,7 @@ func (i *DataOffer) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e DataOfferSourceActionsEvent
 		l := 0
-		e.SourceActions = Uint32(data[l : l+4])
+		e.SourceActions = DataDeviceManagerDndAction(Uint32(data[l : l+4]))
 		l += 4
 
 		i.sourceActionsHandler(e)
@@ -1916,7 +2318,7 @@ func (i *DataOffer) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e DataOfferActionEvent
 		l := 0
-		e.DndAction = Uint32(data[l : l+4])
+		e.DndAction = DataDeviceManagerDndAction(Uint32(data[l : l+4]))
 		l += 4
 
 		i.actionHandler(e)
@@ -1926,6 +2328,74 @@ func (i *DataOffer) Dispatch(opcode uint32, fd int, data []byte) {
 // DataSourceName : offer to transfer data
 const DataSourceName = "wl_data_source"
 
+// DataSourceInterface : metadata of the wl_data_source interface
+var DataSourceInterface = &Interface{
+	Name:    DataSourceName,
+	Version: 3,
+	New:     func(ctx *Context) Proxy { return NewDataSource(ctx) },
+	Requests: []Message{
+		{
+			Name:  "offer",
+			Since: 1,
+			Args: []Arg{
+				{Name: "mime_type", Type: ArgTypeString},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "set_actions",
+			Since: 3,
+			Args: []Arg{
+				{Name: "dnd_actions", Type: ArgTypeUint},
+			},
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "target",
+			Since: 1,
+			Args: []Arg{
+				{Name: "mime_type", Type: ArgTypeString, AllowNull: true},
+			},
+		},
+		{
+			Name:  "send",
+			Since: 1,
+			Args: []Arg{
+				{Name: "mime_type", Type: ArgTypeString},
+				{Name: "fd", Type: ArgTypeFd},
+			},
+		},
+		{
+			Name:  "cancelled",
+			Since: 1,
+		},
+		{
+			Name:  "dnd_drop_performed",
+			Since: 3,
+		},
+		{
+			Name:  "dnd_finished",
+			Since: 3,
+		},
+		{
+			Name:  "action",
+			Since: 3,
+			Args: []Arg{
+				{Name: "dnd_action", Type: ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns DataSourceInterface
+func (i *DataSource) Interface() *Interface {
+	return DataSourceInterface
+}
+
 // DataSource : offer to transfer data
 //
 // The wl_data_source object is the source side of a wl_data_offer.
@@ -1954,6 +2424,9 @@ func NewDataSource(ctx *Context) *DataSource {
 	return wlDataSource
 }
 
+// DataSourceOfferSinceVersion : version of DataSource that introduced Offer
+const DataSourceOfferSinceVersion = 1
+
 // Offer : add an offered mime type
 //
 // This request adds a mime type to the set of mime types
@@ -1977,6 +2450,9 @@ func (i *DataSource) Offer(mimeType string) error {
 	return err
 }
 
+// DataSourceDestroySinceVersion : version of DataSource that introduced Destroy
+const DataSourceDestroySinceVersion = 1
+
 // Destroy : destroy the data source
 //
 // Destroy the data source.
@@ -1994,6 +2470,9 @@ func (i *DataSource) Destroy() error {
 	return err
 }
 
+// DataSourceSetActionsSinceVersion : version of DataSource that introduced SetActions
+const DataSourceSetActionsSinceVersion = 3
+
 // SetActions : set the available drag-and-drop actions
 //
 // Sets the actions that the source side client supports for this
@@ -2011,7 +2490,10 @@ func (i *DataSource) Destroy() error {
 // for drag-and-drop will raise a protocol error.
 //
 //	dndActions: actions supported by the data source
-func (i *DataSource) SetActions(dndActions uint32) error {
+func (i *DataSource) SetActions(dndActions DataDeviceManagerDndAction) error {
+	if v := i.Version(); v < DataSourceSetActionsSinceVersion {
+		return &VersionError{Interface: DataSourceName, Request: "set_actions", Since: DataSourceSetActionsSinceVersion, Version: v}
+	}
 	const opcode = 2
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -2071,6 +2553,10 @@ func (e DataSourceError) String() string {
 type DataSourceTargetEvent struct {
 	MimeType string
 }
+
+// DataSourceTargetSinceVersion : version of DataSource that introduced DataSourceTargetEvent
+const DataSourceTargetSinceVersion = 1
+
 type DataSourceTargetHandlerFunc func(DataSourceTargetEvent)
 
 // SetTargetHandler : sets handler for DataSourceTargetEvent
@@ -2087,6 +2573,10 @@ type DataSourceSendEvent struct {
 	MimeType string
 	Fd       int
 }
+
+// DataSourceSendSinceVersion : version of DataSource that introduced DataSourceSendEvent
+const DataSourceSendSinceVersion = 1
+
 type DataSourceSendHandlerFunc func(DataSourceSendEvent)
 
 // SetSendHandler : sets handler for DataSourceSendEvent
@@ -2117,6 +2607,10 @@ func (i *DataSource) SetSendHandler(f DataSourceSendHandlerFunc) {
 // only be emitted if the data source was replaced by another data
 // source.
 type DataSourceCancelledEvent struct{}
+
+// DataSourceCancelledSinceVersion : version of DataSource that introduced DataSourceCancelledEvent
+const DataSourceCancelledSinceVersion = 1
+
 type DataSourceCancelledHandlerFunc func(DataSourceCancelledEvent)
 
 // SetCancelledHandler : sets handler for DataSourceCancelledEvent
@@ -2136,6 +2630,10 @@ func (i *DataSource) SetCancelledHandler(f DataSourceCancelledHandlerFunc) {
 // Note that the data_source may still be used in the future and should
 // not be destroyed here.
 type DataSourceDndDropPerformedEvent struct{}
+
+// DataSourceDndDropPerformedSinceVersion : version of DataSource that introduced DataSourceDndDropPerformedEvent
+const DataSourceDndDropPerformedSinceVersion = 3
+
 type DataSourceDndDropPerformedHandlerFunc func(DataSourceDndDropPerformedEvent)
 
 // SetDndDropPerformedHandler : sets handler for DataSourceDndDropPerformedEvent
@@ -2152,6 +2650,10 @@ func (i *DataSource) SetDndDropPerformedHandler(f DataSourceDndDropPerformedHand
 // If the action used to perform the operation was "move", the
 // source can now delete the transferred data.
 type DataSourceDndFinishedEvent struct{}
+
+// DataSourceDndFinishedSinceVersion : version of DataSource that introduced DataSourceDndFinishedEvent
+const DataSourceDndFinishedSinceVersion = 3
+
 type DataSourceDndFinishedHandlerFunc func(DataSourceDndFinishedEvent)
 
 // SetDndFinishedHandler : sets handler for DataSourceDndFinishedEvent
@@ -2187,8 +2689,12 @@ func (i *DataSource) SetDndFinishedHandler(f DataSourceDndFinishedHandlerFunc) {
 // Clients can trigger cursor surface changes from this point, so
 // they reflect the current action.
 type DataSourceActionEvent struct {
-	DndAction uint32
+	DndAction DataDeviceManagerDndAction
 }
+
+// DataSourceActionSinceVersion : version of DataSource that introduced DataSourceActionEvent
+const DataSourceActionSinceVersion = 3
+
 type DataSourceActionHandlerFunc func(DataSourceActionEvent)
 
 // SetActionHandler : sets handler for DataSourceActionEvent
@@ -2253,7 +2759,7 @@ func (i *DataSource) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e DataSourceActionEvent
 		l := 0
-		e.DndAction = Uint32(data[l : l+4])
+		e.DndAction = DataDeviceManagerDndAction(Uint32(data[l : l+4]))
 		l += 4
 
 		i.actionHandler(e)
@@ -2263,6 +2769,86 @@ func (i *DataSource) Dispatch(opcode uint32, fd int, data []byte) {
 // DataDeviceName : data transfer device
 const DataDeviceName = "wl_data_device"
 
+// DataDeviceInterface : metadata of the wl_data_device interface
+var DataDeviceInterface = &Interface{
+	Name:    DataDeviceName,
+	Version: 3,
+	New:     func(ctx *Context) Proxy { return NewDataDevice(ctx) },
+	Requests: []Message{
+		{
+			Name:  "start_drag",
+			Since: 1,
+			Args: []Arg{
+				{Name: "source", Type: ArgTypeObject, Interface: "wl_data_source", AllowNull: true},
+				{Name: "origin", Type: ArgTypeObject, Interface: "wl_surface"},
+				{Name: "icon", Type: ArgTypeObject, Interface: "wl_surface", AllowNull: true},
+				{Name: "serial", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "set_selection",
+			Since: 1,
+			Args: []Arg{
+				{Name: "source", Type: ArgTypeObject, Interface: "wl_data_source", AllowNull: true},
+				{Name: "serial", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 2,
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "data_offer",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_data_offer"},
+			},
+		},
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface"},
+				{Name: "x", Type: ArgTypeFixed},
+				{Name: "y", Type: ArgTypeFixed},
+				{Name: "id", Type: ArgTypeObject, Interface: "wl_data_offer", AllowNull: true},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []Arg{
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "x", Type: ArgTypeFixed},
+				{Name: "y", Type: ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "drop",
+			Since: 1,
+		},
+		{
+			Name:  "selection",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeObject, Interface: "wl_data_offer", AllowNull: true},
+			},
+		},
+	},
+}
+
+// Interface : returns DataDeviceInterface
+func (i *DataDevice) Interface() *Interface {
+	return DataDeviceInterface
+}
+
 // DataDevice : data transfer device
 //
 // There is one wl_data_device per seat which can be obtained
@@ -2293,6 +2879,9 @@ func NewDataDevice(ctx *Context) *DataDevice {
 	return wlDataDevice
 }
 
+// DataDeviceStartDragSinceVersion : version of DataDevice that introduced StartDrag
+const DataDeviceStartDragSinceVersion = 1
+
 // StartDrag : start drag-and-drop operation
 //
 // This request asks the compositor to start a drag-and-drop
@@ -2359,6 +2948,9 @@ func (i *DataDevice) StartDrag(source *DataSource, origin, icon *Surface, serial
 	return err
 }
 
+// DataDeviceSetSelectionSinceVersion : version of DataDevice that introduced SetSelection
+const DataDeviceSetSelectionSinceVersion = 1
+
 // SetSelection : copy data to the selection
 //
 // This request asks the compositor to set the selection
@@ -2390,10 +2982,16 @@ func (i *DataDevice) SetSelection(source *DataSource, serial uint32) error {
 	return err
 }
 
+// DataDeviceReleaseSinceVersion : version of DataDevice that introduced Release
+const DataDeviceReleaseSinceVersion = 2
+
 // Release : destroy data device
 //
 // This request destroys the data device.
 func (i *DataDevice) Release() error {
+	if v := i.Version(); v < DataDeviceReleaseSinceVersion {
+		return &VersionError{Interface: DataDeviceName, Request: "release", Since: DataDeviceReleaseSinceVersion, Version: v}
+	}
 	defer i.Context().Unregister(i)
 	const opcode = 2
 	const _reqBufLen = 8
@@ -2449,6 +3047,10 @@ func (e DataDeviceError) String() string {
 type DataDeviceDataOfferEvent struct {
 	Id *DataOffer
 }
+
+// DataDeviceDataOfferSinceVersion : version of DataDevice that introduced DataDeviceDataOfferEvent
+const DataDeviceDataOfferSinceVersion = 1
+
 type DataDeviceDataOfferHandlerFunc func(DataDeviceDataOfferEvent)
 
 // SetDataOfferHandler : sets handler for DataDeviceDataOfferEvent
@@ -2463,12 +3065,18 @@ func (i *DataDevice) SetDataOfferHandler(f DataDeviceDataOfferHandlerFunc) {
 // enter time is provided by the x and y arguments, in surface-local
 // coordinates.
 type DataDeviceEnterEvent struct {
-	Serial  uint32
+	Serial uint32
+	// Surface is nil if the client destroyed the object
 	Surface *Surface
 	X       float64
 	Y       float64
-	Id      *DataOffer
+	// Id is nil if it is null or the client destroyed the object
+	Id *DataOffer
 }
+
+// DataDeviceEnterSinceVersion : version of DataDevice that introduced DataDeviceEnterEvent
+const DataDeviceEnterSinceVersion = 1
+
 type DataDeviceEnterHandlerFunc func(DataDeviceEnterEvent)
 
 // SetEnterHandler : sets handler for DataDeviceEnterEvent
@@ -2482,6 +3090,10 @@ func (i *DataDevice) SetEnterHandler(f DataDeviceEnterHandlerFunc) {
 // surface and the session ends.  The client must destroy the
 // wl_data_offer introduced at enter time at this point.
 type DataDeviceLeaveEvent struct{}
+
+// DataDeviceLeaveSinceVersion : version of DataDevice that introduced DataDeviceLeaveEvent
+const DataDeviceLeaveSinceVersion = 1
+
 type DataDeviceLeaveHandlerFunc func(DataDeviceLeaveEvent)
 
 // SetLeaveHandler : sets handler for DataDeviceLeaveEvent
@@ -2500,6 +3112,10 @@ type DataDeviceMotionEvent struct {
 	X    float64
 	Y    float64
 }
+
+// DataDeviceMotionSinceVersion : version of DataDevice that introduced DataDeviceMotionEvent
+const DataDeviceMotionSinceVersion = 1
+
 type DataDeviceMotionHandlerFunc func(DataDeviceMotionEvent)
 
 // SetMotionHandler : sets handler for DataDeviceMotionEvent
@@ -2523,6 +3139,10 @@ func (i *DataDevice) SetMotionHandler(f DataDeviceMotionHandlerFunc) {
 // wl_data_offer.set_actions request, or wl_data_offer.destroy in order
 // to cancel the operation.
 type DataDeviceDropEvent struct{}
+
+// DataDeviceDropSinceVersion : version of DataDevice that introduced DataDeviceDropEvent
+const DataDeviceDropSinceVersion = 1
+
 type DataDeviceDropHandlerFunc func(DataDeviceDropEvent)
 
 // SetDropHandler : sets handler for DataDeviceDropEvent
@@ -2545,8 +3165,13 @@ func (i *DataDevice) SetDropHandler(f DataDeviceDropHandlerFunc) {
 // will be sent.  The client must destroy the previous selection
 // data_offer, if any, upon receiving this event.
 type DataDeviceSelectionEvent struct {
+	// Id is nil if it is null or the client destroyed the object
 	Id *DataOffer
 }
+
+// DataDeviceSelectionSinceVersion : version of DataDevice that introduced DataDeviceSelectionEvent
+const DataDeviceSelectionSinceVersion = 1
+
 type DataDeviceSelectionHandlerFunc func(DataDeviceSelectionEvent)
 
 // SetSelectionHandler : sets handler for DataDeviceSelectionEvent
@@ -2557,13 +3182,16 @@ func (i *DataDevice) SetSelectionHandler(f DataDeviceSelectionHandlerFunc) {
 func (i *DataDevice) Dispatch(opcode uint32, fd int, data []byte) {
 	switch opcode {
 	case 0:
-		if i.dataOfferHandler == nil {
-			return
-		}
 		var e DataDeviceDataOfferEvent
 		l := 0
-		e.Id = i.Context().GetProxy(Uint32(data[l : l+4])).(*DataOffer)
+		id := &DataOffer{}
+		i.Context().SetProxy(Uint32(data[l:l+4]), id)
+		id.SetVersion(i.Version())
+		e.Id = id
 		l += 4
+		if i.dataOfferHandler == nil {
+			return
+		}
 
 		i.dataOfferHandler(e)
 	case 1:
@@ -2574,13 +3202,13 @@ func (i *DataDevice) Dispatch(opcode uint32, fd int, data []byte) {
 		l := 0
 		e.Serial = Uint32(data[l : l+4])
 		l += 4
-		e.Surface = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
+		e.Surface, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
 		l += 4
 		e.X = Fixed(data[l : l+4])
 		l += 4
 		e.Y = Fixed(data[l : l+4])
 		l += 4
-		e.Id = i.Context().GetProxy(Uint32(data[l : l+4])).(*DataOffer)
+		e.Id, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*DataOffer)
 		l += 4
 
 		i.enterHandler(e)
@@ -2618,7 +3246,7 @@ func (i *DataDevice) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e DataDeviceSelectionEvent
 		l := 0
-		e.Id = i.Context().GetProxy(Uint32(data[l : l+4])).(*DataOffer)
+		e.Id, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*DataOffer)
 		l += 4
 
 		i.selectionHandler(e)
@@ -2628,6 +3256,35 @@ func (i *DataDevice) Dispatch(opcode uint32, fd int, data []byte) {
 // DataDeviceManagerName : data transfer interface
 const DataDeviceManagerName = "wl_data_device_manager"
 
+// DataDeviceManagerInterface : metadata of the wl_data_device_manager interface
+var DataDeviceManagerInterface = &Interface{
+	Name:    DataDeviceManagerName,
+	Version: 3,
+	New:     func(ctx *Context) Proxy { return NewDataDeviceManager(ctx) },
+	Requests: []Message{
+		{
+			Name:  "create_data_source",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_data_source"},
+			},
+		},
+		{
+			Name:  "get_data_device",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_data_device"},
+				{Name: "seat", Type: ArgTypeObject, Interface: "wl_seat"},
+			},
+		},
+	},
+}
+
+// Interface : returns DataDeviceManagerInterface
+func (i *DataDeviceManager) Interface() *Interface {
+	return DataDeviceManagerInterface
+}
+
 // DataDeviceManager : data transfer interface
 //
 // The wl_data_device_manager is a singleton global object that
@@ -2662,11 +3319,15 @@ func NewDataDeviceManager(ctx *Context) *DataDeviceManager {
 	return wlDataDeviceManager
 }
 
+// DataDeviceManagerCreateDataSourceSinceVersion : version of DataDeviceManager that introduced CreateDataSource
+const DataDeviceManagerCreateDataSourceSinceVersion = 1
+
 // CreateDataSource : create a new data source
 //
 // Create a new data source.
 func (i *DataDeviceManager) CreateDataSource() (*DataSource, error) {
 	id := NewDataSource(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 0
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -2681,6 +3342,9 @@ func (i *DataDeviceManager) CreateDataSource() (*DataSource, error) {
 	return id, err
 }
 
+// DataDeviceManagerGetDataDeviceSinceVersion : version of DataDeviceManager that introduced GetDataDevice
+const DataDeviceManagerGetDataDeviceSinceVersion = 1
+
 // GetDataDevice : create a new data device
 //
 // Create a new data device for a given seat.
@@ -2688,6 +3352,7 @@ func (i *DataDeviceManager) CreateDataSource() (*DataSource, error) {
 //	seat: seat associated with the data device
 func (i *DataDeviceManager) GetDataDevice(seat *Seat) (*DataDevice, error) {
 	id := NewDataDevice(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 1
 	const _reqBufLen = 8 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -2777,13 +3442,70 @@ func (e DataDeviceManagerDndAction) Value() string {
 	}
 }
 
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e DataDeviceManagerDndAction) Has(f DataDeviceManagerDndAction) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e DataDeviceManagerDndAction) Set(f DataDeviceManagerDndAction) DataDeviceManagerDndAction {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e DataDeviceManagerDndAction) Clear(f DataDeviceManagerDndAction) DataDeviceManagerDndAction {
+	return e &^ f
+}
+
 func (e DataDeviceManagerDndAction) String() string {
-	return e.Name() + "=" + e.Value()
+	if e == 0 {
+		return "none"
+	}
+	var flags []string
+	if e.Has(DataDeviceManagerDndActionCopy) {
+		flags = append(flags, "copy")
+		e = e.Clear(DataDeviceManagerDndActionCopy)
+	}
+	if e.Has(DataDeviceManagerDndActionMove) {
+		flags = append(flags, "move")
+		e = e.Clear(DataDeviceManagerDndActionMove)
+	}
+	if e.Has(DataDeviceManagerDndActionAsk) {
+		flags = append(flags, "ask")
+		e = e.Clear(DataDeviceManagerDndActionAsk)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
 }
 
 // ShellName : create desktop-style surfaces
 const ShellName = "wl_shell"
 
+// ShellInterface : metadata of the wl_shell interface
+var ShellInterface = &Interface{
+	Name:    ShellName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewShell(ctx) },
+	Requests: []Message{
+		{
+			Name:  "get_shell_surface",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_shell_surface"},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface"},
+			},
+		},
+	},
+}
+
+// Interface : returns ShellInterface
+func (i *Shell) Interface() *Interface {
+	return ShellInterface
+}
+
 // Shell : create desktop-style surfaces
 //
 // This interface is implemented by servers that provide
@@ -2816,6 +3538,9 @@ func NewShell(ctx *Context) *Shell {
 	return wlShell
 }
 
+// ShellGetShellSurfaceSinceVersion : version of Shell that introduced GetShellSurface
+const ShellGetShellSurfaceSinceVersion = 1
+
 // GetShellSurface : create a shell surface from a surface
 //
 // Create a shell surface for an existing surface. This gives
@@ -2827,6 +3552,7 @@ func NewShell(ctx *Context) *Shell {
 //	surface: surface to be given the shell surface role
 func (i *Shell) GetShellSurface(surface *Surface) (*ShellSurface, error) {
 	id := NewShellSurface(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 0
 	const _reqBufLen = 8 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -2881,6 +3607,122 @@ func (e ShellError) String() string {
 // ShellSurfaceName : desktop-style metadata interface
 const ShellSurfaceName = "wl_shell_surface"
 
+// ShellSurfaceInterface : metadata of the wl_shell_surface interface
+var ShellSurfaceInterface = &Interface{
+	Name:    ShellSurfaceName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewShellSurface(ctx) },
+	Requests: []Message{
+		{
+			Name:  "pong",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "move",
+			Since: 1,
+			Args: []Arg{
+				{Name: "seat", Type: ArgTypeObject, Interface: "wl_seat"},
+				{Name: "serial", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "resize",
+			Since: 1,
+			Args: []Arg{
+				{Name: "seat", Type: ArgTypeObject, Interface: "wl_seat"},
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "edges", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "set_toplevel",
+			Since: 1,
+		},
+		{
+			Name:  "set_transient",
+			Since: 1,
+			Args: []Arg{
+				{Name: "parent", Type: ArgTypeObject, Interface: "wl_surface"},
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+				{Name: "flags", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "set_fullscreen",
+			Since: 1,
+			Args: []Arg{
+				{Name: "method", Type: ArgTypeUint},
+				{Name: "framerate", Type: ArgTypeUint},
+				{Name: "output", Type: ArgTypeObject, Interface: "wl_output", AllowNull: true},
+			},
+		},
+		{
+			Name:  "set_popup",
+			Since: 1,
+			Args: []Arg{
+				{Name: "seat", Type: ArgTypeObject, Interface: "wl_seat"},
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "parent", Type: ArgTypeObject, Interface: "wl_surface"},
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+				{Name: "flags", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "set_maximized",
+			Since: 1,
+			Args: []Arg{
+				{Name: "output", Type: ArgTypeObject, Interface: "wl_output", AllowNull: true},
+			},
+		},
+		{
+			Name:  "set_title",
+			Since: 1,
+			Args: []Arg{
+				{Name: "title", Type: ArgTypeString},
+			},
+		},
+		{
+			Name:  "set_class",
+			Since: 1,
+			Args: []Arg{
+				{Name: "class", Type: ArgTypeString},
+			},
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "ping",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []Arg{
+				{Name: "edges", Type: ArgTypeUint},
+				{Name: "width", Type: ArgTypeInt},
+				{Name: "height", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "popup_done",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns ShellSurfaceInterface
+func (i *ShellSurface) Interface() *Interface {
+	return ShellSurfaceInterface
+}
+
 // ShellSurface : desktop-style metadata interface
 //
 // An interface that may be implemented by a wl_surface, for
@@ -2920,6 +3762,9 @@ func NewShellSurface(ctx *Context) *ShellSurface {
 	return wlShellSurface
 }
 
+// ShellSurfacePongSinceVersion : version of ShellSurface that introduced Pong
+const ShellSurfacePongSinceVersion = 1
+
 // Pong : respond to a ping event
 //
 // A client must respond to a ping event with a pong request or
@@ -2941,6 +3786,9 @@ func (i *ShellSurface) Pong(serial uint32) error {
 	return err
 }
 
+// ShellSurfaceMoveSinceVersion : version of ShellSurface that introduced Move
+const ShellSurfaceMoveSinceVersion = 1
+
 // Move : start an interactive move
 //
 // Start a pointer-driven move of the surface.
@@ -2968,6 +3816,9 @@ func (i *ShellSurface) Move(seat *Seat, serial uint32) error {
 	return err
 }
 
+// ShellSurfaceResizeSinceVersion : version of ShellSurface that introduced Resize
+const ShellSurfaceResizeSinceVersion = 1
+
 // Resize : start an interactive resize
 //
 // Start a pointer-driven resizing of the surface.
@@ -2979,7 +3830,7 @@ func (i *ShellSurface) Move(seat *Seat, serial uint32) error {
 //	seat: seat whose pointer is used
 //	serial: serial number of the implicit grab on the pointer
 //	edges: which edge or corner is being dragged
-func (i *ShellSurface) Resize(seat *Seat, serial, edges uint32) error {
+func (i *ShellSurface) Resize(seat *Seat, serial uint32, edges ShellSurfaceResize) error {
 	const opcode = 2
 	const _reqBufLen = 8 + 4 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -2998,6 +3849,9 @@ func (i *ShellSurface) Resize(seat *Seat, serial, edges uint32) error {
 	return err
 }
 
+// ShellSurfaceSetToplevelSinceVersion : version of ShellSurface that introduced SetToplevel
+const ShellSurfaceSetToplevelSinceVersion = 1
+
 // SetToplevel : make the surface a toplevel surface
 //
 // Map the surface as a toplevel surface.
@@ -3016,6 +3870,9 @@ func (i *ShellSurface) SetToplevel() error {
 	return err
 }
 
+// ShellSurfaceSetTransientSinceVersion : version of ShellSurface that introduced SetTransient
+const ShellSurfaceSetTransientSinceVersion = 1
+
 // SetTransient : make the surface a transient surface
 //
 // Map the surface relative to an existing surface.
@@ -3030,7 +3887,7 @@ func (i *ShellSurface) SetToplevel() error {
 //	x: surface-local x coordinate
 //	y: surface-local y coordinate
 //	flags: transient surface behavior
-func (i *ShellSurface) SetTransient(parent *Surface, x, y int32, flags uint32) error {
+func (i *ShellSurface) SetTransient(parent *Surface, x, y int32, flags ShellSurfaceTransient) error {
 	const opcode = 4
 	const _reqBufLen = 8 + 4 + 4 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -3051,6 +3908,9 @@ func (i *ShellSurface) SetTransient(parent *Surface, x, y int32, flags uint32) e
 	return err
 }
 
+// ShellSurfaceSetFullscreenSinceVersion : version of ShellSurface that introduced SetFullscreen
+const ShellSurfaceSetFullscreenSinceVersion = 1
+
 // SetFullscreen : make the surface a fullscreen surface
 //
 // Map the surface as a fullscreen surface.
@@ -3090,7 +3950,7 @@ func (i *ShellSurface) SetTransient(parent *Surface, x, y int32, flags uint32) e
 //	method: method for resolving size conflict
 //	framerate: framerate in mHz
 //	output: output on which the surface is to be fullscreen
-func (i *ShellSurface) SetFullscreen(method, framerate uint32, output *Output) error {
+func (i *ShellSurface) SetFullscreen(method ShellSurfaceFullscreenMethod, framerate uint32, output *Output) error {
 	const opcode = 5
 	const _reqBufLen = 8 + 4 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -3114,6 +3974,9 @@ func (i *ShellSurface) SetFullscreen(method, framerate uint32, output *Output) e
 	return err
 }
 
+// ShellSurfaceSetPopupSinceVersion : version of ShellSurface that introduced SetPopup
+const ShellSurfaceSetPopupSinceVersion = 1
+
 // SetPopup : make the surface a popup surface
 //
 // Map the surface as a popup.
@@ -3142,7 +4005,7 @@ func (i *ShellSurface) SetFullscreen(method, framerate uint32, output *Output) e
 //	x: surface-local x coordinate
 //	y: surface-local y coordinate
 //	flags: transient surface behavior
-func (i *ShellSurface) SetPopup(seat *Seat, serial uint32, parent *Surface, x, y int32, flags uint32) error {
+func (i *ShellSurface) SetPopup(seat *Seat, serial uint32, parent *Surface, x, y int32, flags ShellSurfaceTransient) error {
 	const opcode = 6
 	const _reqBufLen = 8 + 4 + 4 + 4 + 4 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -3167,6 +4030,9 @@ func (i *ShellSurface) SetPopup(seat *Seat, serial uint32, parent *Surface, x, y
 	return err
 }
 
+// ShellSurfaceSetMaximizedSinceVersion : version of ShellSurface that introduced SetMaximized
+const ShellSurfaceSetMaximizedSinceVersion = 1
+
 // SetMaximized : make the surface a maximized surface
 //
 // Map the surface as a maximized surface.
@@ -3209,6 +4075,9 @@ func (i *ShellSurface) SetMaximized(output *Output) error {
 	return err
 }
 
+// ShellSurfaceSetTitleSinceVersion : version of ShellSurface that introduced SetTitle
+const ShellSurfaceSetTitleSinceVersion = 1
+
 // SetTitle : set surface title
 //
 // Set a short title for the surface.
@@ -3236,6 +4105,9 @@ func (i *ShellSurface) SetTitle(title string) error {
 	return err
 }
 
+// ShellSurfaceSetClassSinceVersion : version of ShellSurface that introduced SetClass
+const ShellSurfaceSetClassSinceVersion = 1
+
 // SetClass : set surface class
 //
 // Set a class for the surface.
@@ -3346,8 +4218,47 @@ func (e ShellSurfaceResize) Value() string {
 	}
 }
 
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e ShellSurfaceResize) Has(f ShellSurfaceResize) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e ShellSurfaceResize) Set(f ShellSurfaceResize) ShellSurfaceResize {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e ShellSurfaceResize) Clear(f ShellSurfaceResize) ShellSurfaceResize {
+	return e &^ f
+}
+
 func (e ShellSurfaceResize) String() string {
-	return e.Name() + "=" + e.Value()
+	if e == 0 {
+		return "none"
+	}
+	var flags []string
+	if e.Has(ShellSurfaceResizeTop) {
+		flags = append(flags, "top")
+		e = e.Clear(ShellSurfaceResizeTop)
+	}
+	if e.Has(ShellSurfaceResizeBottom) {
+		flags = append(flags, "bottom")
+		e = e.Clear(ShellSurfaceResizeBottom)
+	}
+	if e.Has(ShellSurfaceResizeLeft) {
+		flags = append(flags, "left")
+		e = e.Clear(ShellSurfaceResizeLeft)
+	}
+	if e.Has(ShellSurfaceResizeRight) {
+		flags = append(flags, "right")
+		e = e.Clear(ShellSurfaceResizeRight)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
 }
 
 type ShellSurfaceTransient uint32
@@ -3379,8 +4290,35 @@ func (e ShellSurfaceTransient) Value() string {
 	}
 }
 
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e ShellSurfaceTransient) Has(f ShellSurfaceTransient) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e ShellSurfaceTransient) Set(f ShellSurfaceTransient) ShellSurfaceTransient {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e ShellSurfaceTransient) Clear(f ShellSurfaceTransient) ShellSurfaceTransient {
+	return e &^ f
+}
+
 func (e ShellSurfaceTransient) String() string {
-	return e.Name() + "=" + e.Value()
+	if e == 0 {
+		return "0"
+	}
+	var flags []string
+	if e.Has(ShellSurfaceTransientInactive) {
+		flags = append(flags, "inactive")
+		e = e.Clear(ShellSurfaceTransientInactive)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
 }
 
 type ShellSurfaceFullscreenMethod uint32
@@ -3442,6 +4380,10 @@ func (e ShellSurfaceFullscreenMethod) String() string {
 type ShellSurfacePingEvent struct {
 	Serial uint32
 }
+
+// ShellSurfacePingSinceVersion : version of ShellSurface that introduced ShellSurfacePingEvent
+const ShellSurfacePingSinceVersion = 1
+
 type ShellSurfacePingHandlerFunc func(ShellSurfacePingEvent)
 
 // SetPingHandler : sets handler for ShellSurfacePingEvent
@@ -3469,10 +4411,14 @@ func (i *ShellSurface) SetPingHandler(f ShellSurfacePingHandlerFunc) {
 // The width and height arguments specify the size of the window
 // in surface-local coordinates.
 type ShellSurfaceConfigureEvent struct {
-	Edges  uint32
+	Edges  ShellSurfaceResize
 	Width  int32
 	Height int32
 }
+
+// ShellSurfaceConfigureSinceVersion : version of ShellSurface that introduced ShellSurfaceConfigureEvent
+const ShellSurfaceConfigureSinceVersion = 1
+
 type ShellSurfaceConfigureHandlerFunc func(ShellSurfaceConfigureEvent)
 
 // SetConfigureHandler : sets handler for ShellSurfaceConfigureEvent
@@ -3486,6 +4432,10 @@ func (i *ShellSurface) SetConfigureHandler(f ShellSurfaceConfigureHandlerFunc) {
 // that is, when the user clicks a surface that doesn't belong
 // to the client owning the popup surface.
 type ShellSurfacePopupDoneEvent struct{}
+
+// ShellSurfacePopupDoneSinceVersion : version of ShellSurface that introduced ShellSurfacePopupDoneEvent
+const ShellSurfacePopupDoneSinceVersion = 1
+
 type ShellSurfacePopupDoneHandlerFunc func(ShellSurfacePopupDoneEvent)
 
 // SetPopupDoneHandler : sets handler for ShellSurfacePopupDoneEvent
@@ -3511,7 +4461,7 @@ func (i *ShellSurface) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e ShellSurfaceConfigureEvent
 		l := 0
-		e.Edges = Uint32(data[l : l+4])
+		e.Edges = ShellSurfaceResize(Uint32(data[l : l+4]))
 		l += 4
 		e.Width = int32(Uint32(data[l : l+4]))
 		l += 4
@@ -3532,6 +4482,116 @@ func (i *ShellSurface) Dispatch(opcode uint32, fd int, data []byte) {
 // SurfaceName : an onscreen surface
 const SurfaceName = "wl_surface"
 
+// SurfaceInterface : metadata of the wl_surface interface
+var SurfaceInterface = &Interface{
+	Name:    SurfaceName,
+	Version: 5,
+	New:     func(ctx *Context) Proxy { return NewSurface(ctx) },
+	Requests: []Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "attach",
+			Since: 1,
+			Args: []Arg{
+				{Name: "buffer", Type: ArgTypeObject, Interface: "wl_buffer", AllowNull: true},
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "damage",
+			Since: 1,
+			Args: []Arg{
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+				{Name: "width", Type: ArgTypeInt},
+				{Name: "height", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+			Args: []Arg{
+				{Name: "callback", Type: ArgTypeNewID, Interface: "wl_callback"},
+			},
+		},
+		{
+			Name:  "set_opaque_region",
+			Since: 1,
+			Args: []Arg{
+				{Name: "region", Type: ArgTypeObject, Interface: "wl_region", AllowNull: true},
+			},
+		},
+		{
+			Name:  "set_input_region",
+			Since: 1,
+			Args: []Arg{
+				{Name: "region", Type: ArgTypeObject, Interface: "wl_region", AllowNull: true},
+			},
+		},
+		{
+			Name:  "commit",
+			Since: 1,
+		},
+		{
+			Name:  "set_buffer_transform",
+			Since: 2,
+			Args: []Arg{
+				{Name: "transform", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_buffer_scale",
+			Since: 3,
+			Args: []Arg{
+				{Name: "scale", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "damage_buffer",
+			Since: 4,
+			Args: []Arg{
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+				{Name: "width", Type: ArgTypeInt},
+				{Name: "height", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "offset",
+			Since: 5,
+			Args: []Arg{
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+			},
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []Arg{
+				{Name: "output", Type: ArgTypeObject, Interface: "wl_output"},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []Arg{
+				{Name: "output", Type: ArgTypeObject, Interface: "wl_output"},
+			},
+		},
+	},
+}
+
+// Interface : returns SurfaceInterface
+func (i *Surface) Interface() *Interface {
+	return SurfaceInterface
+}
+
 // Surface : an onscreen surface
 //
 // A surface is a rectangular area that may be displayed on zero
@@ -3630,6 +4690,9 @@ func NewSurface(ctx *Context) *Surface {
 	return wlSurface
 }
 
+// SurfaceDestroySinceVersion : version of Surface that introduced Destroy
+const SurfaceDestroySinceVersion = 1
+
 // Destroy : delete surface
 //
 // Deletes the surface and invalidates its object ID.
@@ -3647,6 +4710,9 @@ func (i *Surface) Destroy() error {
 	return err
 }
 
+// SurfaceAttachSinceVersion : version of Surface that introduced Attach
+const SurfaceAttachSinceVersion = 1
+
 // Attach : set the surface contents
 //
 // Set a buffer as the content of this surface.
@@ -3733,6 +4799,9 @@ func (i *Surface) Attach(buffer *Buffer, x, y int32) error {
 	return err
 }
 
+// SurfaceDamageSinceVersion : version of Surface that introduced Damage
+const SurfaceDamageSinceVersion = 1
+
 // Damage : mark part of the surface damaged
 //
 // This request is used to describe the regions where the pending
@@ -3782,6 +4851,9 @@ func (i *Surface) Damage(x, y, width, height int32) error {
 	return err
 }
 
+// SurfaceFrameSinceVersion : version of Surface that introduced Frame
+const SurfaceFrameSinceVersion = 1
+
 // Frame : request a frame throttling hint
 //
 // Request a notification when it is a good time to start drawing a new
@@ -3818,6 +4890,7 @@ func (i *Surface) Damage(x, y, width, height int32) error {
 // milliseconds, with an undefined base.
 func (i *Surface) Frame() (*Callback, error) {
 	callback := NewCallback(i.Context())
+	callback.SetVersion(i.Version())
 	const opcode = 3
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -3832,6 +4905,9 @@ func (i *Surface) Frame() (*Callback, error) {
 	return callback, err
 }
 
+// SurfaceSetOpaqueRegionSinceVersion : version of Surface that introduced SetOpaqueRegion
+const SurfaceSetOpaqueRegionSinceVersion = 1
+
 // SetOpaqueRegion : set opaque region
 //
 // This request sets the region of the surface that contains
@@ -3880,6 +4956,9 @@ func (i *Surface) SetOpaqueRegion(region *Region) error {
 	return err
 }
 
+// SurfaceSetInputRegionSinceVersion : version of Surface that introduced SetInputRegion
+const SurfaceSetInputRegionSinceVersion = 1
+
 // SetInputRegion : set input region
 //
 // This request sets the region of the surface that can receive
@@ -3926,6 +5005,9 @@ func (i *Surface) SetInputRegion(region *Region) error {
 	return err
 }
 
+// SurfaceCommitSinceVersion : version of Surface that introduced Commit
+const SurfaceCommitSinceVersion = 1
+
 // Commit : commit pending surface state
 //
 // Surface state (input, opaque, and damage regions, attached buffers,
@@ -3958,6 +5040,9 @@ func (i *Surface) Commit() error {
 	return err
 }
 
+// SurfaceSetBufferTransformSinceVersion : version of Surface that introduced SetBufferTransform
+const SurfaceSetBufferTransformSinceVersion = 2
+
 // SetBufferTransform : sets the buffer transformation
 //
 // This request sets an optional transformation on how the compositor
@@ -3991,7 +5076,10 @@ func (i *Surface) Commit() error {
 // is raised.
 //
 //	transform: transform for interpreting buffer contents
-func (i *Surface) SetBufferTransform(transform int32) error {
+func (i *Surface) SetBufferTransform(transform OutputTransform) error {
+	if v := i.Version(); v < SurfaceSetBufferTransformSinceVersion {
+		return &VersionError{Interface: SurfaceName, Request: "set_buffer_transform", Since: SurfaceSetBufferTransformSinceVersion, Version: v}
+	}
 	const opcode = 7
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -4006,6 +5094,9 @@ func (i *Surface) SetBufferTransform(transform int32) error {
 	return err
 }
 
+// SurfaceSetBufferScaleSinceVersion : version of Surface that introduced SetBufferScale
+const SurfaceSetBufferScaleSinceVersion = 3
+
 // SetBufferScale : sets the buffer scaling factor
 //
 // This request sets an optional scaling factor on how the compositor
@@ -4034,6 +5125,9 @@ func (i *Surface) SetBufferTransform(transform int32) error {
 //
 //	scale: positive scale for interpreting buffer contents
 func (i *Surface) SetBufferScale(scale int32) error {
+	if v := i.Version(); v < SurfaceSetBufferScaleSinceVersion {
+		return &VersionError{Interface: SurfaceName, Request: "set_buffer_scale", Since: SurfaceSetBufferScaleSinceVersion, Version: v}
+	}
 	const opcode = 8
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -4048,6 +5142,9 @@ func (i *Surface) SetBufferScale(scale int32) error {
 	return err
 }
 
+// SurfaceDamageBufferSinceVersion : version of Surface that introduced DamageBuffer
+const SurfaceDamageBufferSinceVersion = 4
+
 // DamageBuffer : mark part of the surface damaged using buffer coordinates
 //
 // This request is used to describe the regions where the pending
@@ -4088,6 +5185,9 @@ func (i *Surface) SetBufferScale(scale int32) error {
 //	width: width of damage rectangle
 //	height: height of damage rectangle
 func (i *Surface) DamageBuffer(x, y, width, height int32) error {
+	if v := i.Version(); v < SurfaceDamageBufferSinceVersion {
+		return &VersionError{Interface: SurfaceName, Request: "damage_buffer", Since: SurfaceDamageBufferSinceVersion, Version: v}
+	}
 	const opcode = 9
 	const _reqBufLen = 8 + 4 + 4 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -4108,6 +5208,9 @@ func (i *Surface) DamageBuffer(x, y, width, height int32) error {
 	return err
 }
 
+// SurfaceOffsetSinceVersion : version of Surface that introduced Offset
+const SurfaceOffsetSinceVersion = 5
+
 // Offset : set the surface contents offset
 //
 // The x and y arguments specify the location of the new pending
@@ -4126,6 +5229,9 @@ func (i *Surface) DamageBuffer(x, y, width, height int32) error {
 //	x: surface-local x coordinate
 //	y: surface-local y coordinate
 func (i *Surface) Offset(x, y int32) error {
+	if v := i.Version(); v < SurfaceOffsetSinceVersion {
+		return &VersionError{Interface: SurfaceName, Request: "offset", Since: SurfaceOffsetSinceVersion, Version: v}
+	}
 	const opcode = 10
 	const _reqBufLen = 8 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -4200,8 +5306,13 @@ func (e SurfaceError) String() string {
 //
 // Note that a surface may be overlapping with zero or more outputs.
 type SurfaceEnterEvent struct {
+	// Output is nil if the client destroyed the object
 	Output *Output
 }
+
+// SurfaceEnterSinceVersion : version of Surface that introduced SurfaceEnterEvent
+const SurfaceEnterSinceVersion = 1
+
 type SurfaceEnterHandlerFunc func(SurfaceEnterEvent)
 
 // SetEnterHandler : sets handler for SurfaceEnterEvent
@@ -4221,8 +5332,13 @@ func (i *Surface) SetEnterHandler(f SurfaceEnterHandlerFunc) {
 // updates even if no enter event has been sent. The frame event should be
 // used instead.
 type SurfaceLeaveEvent struct {
+	// Output is nil if the client destroyed the object
 	Output *Output
 }
+
+// SurfaceLeaveSinceVersion : version of Surface that introduced SurfaceLeaveEvent
+const SurfaceLeaveSinceVersion = 1
+
 type SurfaceLeaveHandlerFunc func(SurfaceLeaveEvent)
 
 // SetLeaveHandler : sets handler for SurfaceLeaveEvent
@@ -4238,7 +5354,7 @@ func (i *Surface) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e SurfaceEnterEvent
 		l := 0
-		e.Output = i.Context().GetProxy(Uint32(data[l : l+4])).(*Output)
+		e.Output, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*Output)
 		l += 4
 
 		i.enterHandler(e)
@@ -4248,7 +5364,7 @@ func (i *Surface) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e SurfaceLeaveEvent
 		l := 0
-		e.Output = i.Context().GetProxy(Uint32(data[l : l+4])).(*Output)
+		e.Output, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*Output)
 		l += 4
 
 		i.leaveHandler(e)
@@ -4258,6 +5374,61 @@ func (i *Surface) Dispatch(opcode uint32, fd int, data []byte) {
 // SeatName : group of input devices
 const SeatName = "wl_seat"
 
+// SeatInterface : metadata of the wl_seat interface
+var SeatInterface = &Interface{
+	Name:    SeatName,
+	Version: 8,
+	New:     func(ctx *Context) Proxy { return NewSeat(ctx) },
+	Requests: []Message{
+		{
+			Name:  "get_pointer",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_pointer"},
+			},
+		},
+		{
+			Name:  "get_keyboard",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_keyboard"},
+			},
+		},
+		{
+			Name:  "get_touch",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_touch"},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 5,
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "capabilities",
+			Since: 1,
+			Args: []Arg{
+				{Name: "capabilities", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "name",
+			Since: 2,
+			Args: []Arg{
+				{Name: "name", Type: ArgTypeString},
+			},
+		},
+	},
+}
+
+// Interface : returns SeatInterface
+func (i *Seat) Interface() *Interface {
+	return SeatInterface
+}
+
 // Seat : group of input devices
 //
 // A seat is a group of keyboards, pointer and touch devices. This
@@ -4282,6 +5453,9 @@ func NewSeat(ctx *Context) *Seat {
 	return wlSeat
 }
 
+// SeatGetPointerSinceVersion : version of Seat that introduced GetPointer
+const SeatGetPointerSinceVersion = 1
+
 // GetPointer : return pointer object
 //
 // The ID provided will be initialized to the wl_pointer interface
@@ -4294,6 +5468,7 @@ func NewSeat(ctx *Context) *Seat {
 // be sent in this case.
 func (i *Seat) GetPointer() (*Pointer, error) {
 	id := NewPointer(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 0
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -4308,6 +5483,9 @@ func (i *Seat) GetPointer() (*Pointer, error) {
 	return id, err
 }
 
+// SeatGetKeyboardSinceVersion : version of Seat that introduced GetKeyboard
+const SeatGetKeyboardSinceVersion = 1
+
 // GetKeyboard : return keyboard object
 //
 // The ID provided will be initialized to the wl_keyboard interface
@@ -4320,6 +5498,7 @@ func (i *Seat) GetPointer() (*Pointer, error) {
 // be sent in this case.
 func (i *Seat) GetKeyboard() (*Keyboard, error) {
 	id := NewKeyboard(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 1
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -4334,6 +5513,9 @@ func (i *Seat) GetKeyboard() (*Keyboard, error) {
 	return id, err
 }
 
+// SeatGetTouchSinceVersion : version of Seat that introduced GetTouch
+const SeatGetTouchSinceVersion = 1
+
 // GetTouch : return touch object
 //
 // The ID provided will be initialized to the wl_touch interface
@@ -4346,6 +5528,7 @@ func (i *Seat) GetKeyboard() (*Keyboard, error) {
 // be sent in this case.
 func (i *Seat) GetTouch() (*Touch, error) {
 	id := NewTouch(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 2
 	const _reqBufLen = 8 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -4360,11 +5543,17 @@ func (i *Seat) GetTouch() (*Touch, error) {
 	return id, err
 }
 
+// SeatReleaseSinceVersion : version of Seat that introduced Release
+const SeatReleaseSinceVersion = 5
+
 // Release : release the seat object
 //
 // Using this request a client can tell the server that it is not going to
 // use the seat object anymore.
 func (i *Seat) Release() error {
+	if v := i.Version(); v < SeatReleaseSinceVersion {
+		return &VersionError{Interface: SeatName, Request: "release", Since: SeatReleaseSinceVersion, Version: v}
+	}
 	defer i.Context().Unregister(i)
 	const opcode = 3
 	const _reqBufLen = 8
@@ -4419,8 +5608,43 @@ func (e SeatCapability) Value() string {
 	}
 }
 
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e SeatCapability) Has(f SeatCapability) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e SeatCapability) Set(f SeatCapability) SeatCapability {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e SeatCapability) Clear(f SeatCapability) SeatCapability {
+	return e &^ f
+}
+
 func (e SeatCapability) String() string {
-	return e.Name() + "=" + e.Value()
+	if e == 0 {
+		return "0"
+	}
+	var flags []string
+	if e.Has(SeatCapabilityPointer) {
+		flags = append(flags, "pointer")
+		e = e.Clear(SeatCapabilityPointer)
+	}
+	if e.Has(SeatCapabilityKeyboard) {
+		flags = append(flags, "keyboard")
+		e = e.Clear(SeatCapabilityKeyboard)
+	}
+	if e.Has(SeatCapabilityTouch) {
+		flags = append(flags, "touch")
+		e = e.Clear(SeatCapabilityTouch)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
 }
 
 type SeatError uint32
@@ -4482,8 +5706,12 @@ func (e SeatError) String() string {
 // The above behavior also applies to wl_keyboard and wl_touch with the
 // keyboard and touch capabilities, respectively.
 type SeatCapabilitiesEvent struct {
-	Capabilities uint32
+	Capabilities SeatCapability
 }
+
+// SeatCapabilitiesSinceVersion : version of Seat that introduced SeatCapabilitiesEvent
+const SeatCapabilitiesSinceVersion = 1
+
 type SeatCapabilitiesHandlerFunc func(SeatCapabilitiesEvent)
 
 // SetCapabilitiesHandler : sets handler for SeatCapabilitiesEvent
@@ -4512,6 +5740,10 @@ func (i *Seat) SetCapabilitiesHandler(f SeatCapabilitiesHandlerFunc) {
 type SeatNameEvent struct {
 	Name string
 }
+
+// SeatNameSinceVersion : version of Seat that introduced SeatNameEvent
+const SeatNameSinceVersion = 2
+
 type SeatNameHandlerFunc func(SeatNameEvent)
 
 // SetNameHandler : sets handler for SeatNameEvent
@@ -4527,7 +5759,7 @@ func (i *Seat) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e SeatCapabilitiesEvent
 		l := 0
-		e.Capabilities = Uint32(data[l : l+4])
+		e.Capabilities = SeatCapability(Uint32(data[l : l+4]))
 		l += 4
 
 		i.capabilitiesHandler(e)
@@ -4549,6 +5781,117 @@ func (i *Seat) Dispatch(opcode uint32, fd int, data []byte) {
 // PointerName : pointer input device
 const PointerName = "wl_pointer"
 
+// PointerInterface : metadata of the wl_pointer interface
+var PointerInterface = &Interface{
+	Name:    PointerName,
+	Version: 8,
+	New:     func(ctx *Context) Proxy { return NewPointer(ctx) },
+	Requests: []Message{
+		{
+			Name:  "set_cursor",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface", AllowNull: true},
+				{Name: "hotspot_x", Type: ArgTypeInt},
+				{Name: "hotspot_y", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "release",
+			Since: 3,
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface"},
+				{Name: "surface_x", Type: ArgTypeFixed},
+				{Name: "surface_y", Type: ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface"},
+			},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []Arg{
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "surface_x", Type: ArgTypeFixed},
+				{Name: "surface_y", Type: ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "button",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "button", Type: ArgTypeUint},
+				{Name: "state", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "axis",
+			Since: 1,
+			Args: []Arg{
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "axis", Type: ArgTypeUint},
+				{Name: "value", Type: ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 5,
+		},
+		{
+			Name:  "axis_source",
+			Since: 5,
+			Args: []Arg{
+				{Name: "axis_source", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "axis_stop",
+			Since: 5,
+			Args: []Arg{
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "axis", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "axis_discrete",
+			Since: 5,
+			Args: []Arg{
+				{Name: "axis", Type: ArgTypeUint},
+				{Name: "discrete", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "axis_value120",
+			Since: 8,
+			Args: []Arg{
+				{Name: "axis", Type: ArgTypeUint},
+				{Name: "value120", Type: ArgTypeInt},
+			},
+		},
+	},
+}
+
+// Interface : returns PointerInterface
+func (i *Pointer) Interface() *Interface {
+	return PointerInterface
+}
+
 // Pointer : pointer input device
 //
 // The wl_pointer interface represents one or more input devices,
@@ -4589,6 +5932,9 @@ func NewPointer(ctx *Context) *Pointer {
 	return wlPointer
 }
 
+// PointerSetCursorSinceVersion : version of Pointer that introduced SetCursor
+const PointerSetCursorSinceVersion = 1
+
 // SetCursor : set the pointer surface
 //
 // Set the pointer surface, i.e., the surface that contains the
@@ -4657,6 +6003,9 @@ func (i *Pointer) SetCursor(serial uint32, surface *Surface, hotspotX, hotspotY
 	return err
 }
 
+// PointerReleaseSinceVersion : version of Pointer that introduced Release
+const PointerReleaseSinceVersion = 3
+
 // Release : release the pointer object
 //
 // Using this request a client can tell the server that it is not going to
@@ -4665,6 +6014,9 @@ func (i *Pointer) SetCursor(serial uint32, surface *Surface, hotspotX, hotspotY
 // This request destroys the pointer proxy object, so clients must not call
 // wl_pointer_destroy() after using this request.
 func (i *Pointer) Release() error {
+	if v := i.Version(); v < PointerReleaseSinceVersion {
+		return &VersionError{Interface: PointerName, Request: "release", Since: PointerReleaseSinceVersion, Version: v}
+	}
 	defer i.Context().Unregister(i)
 	const opcode = 1
 	const _reqBufLen = 8
@@ -4859,11 +6211,16 @@ func (e PointerAxisSource) String() string {
 // is undefined and a client should respond to this event by setting
 // an appropriate pointer image with the set_cursor request.
 type PointerEnterEvent struct {
-	Serial   uint32
+	Serial uint32
+	// Surface is nil if the client destroyed the object
 	Surface  *Surface
 	SurfaceX float64
 	SurfaceY float64
 }
+
+// PointerEnterSinceVersion : version of Pointer that introduced PointerEnterEvent
+const PointerEnterSinceVersion = 1
+
 type PointerEnterHandlerFunc func(PointerEnterEvent)
 
 // SetEnterHandler : sets handler for PointerEnterEvent
@@ -4879,9 +6236,14 @@ func (i *Pointer) SetEnterHandler(f PointerEnterHandlerFunc) {
 // The leave notification is sent before the enter notification
 // for the new focus.
 type PointerLeaveEvent struct {
-	Serial  uint32
+	Serial uint32
+	// Surface is nil if the client destroyed the object
 	Surface *Surface
 }
+
+// PointerLeaveSinceVersion : version of Pointer that introduced PointerLeaveEvent
+const PointerLeaveSinceVersion = 1
+
 type PointerLeaveHandlerFunc func(PointerLeaveEvent)
 
 // SetLeaveHandler : sets handler for PointerLeaveEvent
@@ -4899,6 +6261,10 @@ type PointerMotionEvent struct {
 	SurfaceX float64
 	SurfaceY float64
 }
+
+// PointerMotionSinceVersion : version of Pointer that introduced PointerMotionEvent
+const PointerMotionSinceVersion = 1
+
 type PointerMotionHandlerFunc func(PointerMotionEvent)
 
 // SetMotionHandler : sets handler for PointerMotionEvent
@@ -4926,8 +6292,12 @@ type PointerButtonEvent struct {
 	Serial uint32
 	Time   uint32
 	Button uint32
-	State  uint32
+	State  PointerButtonState
 }
+
+// PointerButtonSinceVersion : version of Pointer that introduced PointerButtonEvent
+const PointerButtonSinceVersion = 1
+
 type PointerButtonHandlerFunc func(PointerButtonEvent)
 
 // SetButtonHandler : sets handler for PointerButtonEvent
@@ -4955,9 +6325,13 @@ func (i *Pointer) SetButtonHandler(f PointerButtonHandlerFunc) {
 // scroll distance.
 type PointerAxisEvent struct {
 	Time  uint32
-	Axis  uint32
+	Axis  PointerAxis
 	Value float64
 }
+
+// PointerAxisSinceVersion : version of Pointer that introduced PointerAxisEvent
+const PointerAxisSinceVersion = 1
+
 type PointerAxisHandlerFunc func(PointerAxisEvent)
 
 // SetAxisHandler : sets handler for PointerAxisEvent
@@ -5002,6 +6376,10 @@ func (i *Pointer) SetAxisHandler(f PointerAxisHandlerFunc) {
 // wl_pointer.enter event being split across multiple wl_pointer.frame
 // groups.
 type PointerFrameEvent struct{}
+
+// PointerFrameSinceVersion : version of Pointer that introduced PointerFrameEvent
+const PointerFrameSinceVersion = 5
+
 type PointerFrameHandlerFunc func(PointerFrameEvent)
 
 // SetFrameHandler : sets handler for PointerFrameEvent
@@ -5037,8 +6415,12 @@ func (i *Pointer) SetFrameHandler(f PointerFrameHandlerFunc) {
 // The order of wl_pointer.axis_discrete and wl_pointer.axis_source is
 // not guaranteed.
 type PointerAxisSourceEvent struct {
-	AxisSource uint32
+	AxisSource PointerAxisSource
 }
+
+// PointerAxisSourceSinceVersion : version of Pointer that introduced PointerAxisSourceEvent
+const PointerAxisSourceSinceVersion = 5
+
 type PointerAxisSourceHandlerFunc func(PointerAxisSourceEvent)
 
 // SetAxisSourceHandler : sets handler for PointerAxisSourceEvent
@@ -5064,8 +6446,12 @@ func (i *Pointer) SetAxisSourceHandler(f PointerAxisSourceHandlerFunc) {
 // preceding wl_pointer.axis event.
 type PointerAxisStopEvent struct {
 	Time uint32
-	Axis uint32
+	Axis PointerAxis
 }
+
+// PointerAxisStopSinceVersion : version of Pointer that introduced PointerAxisStopEvent
+const PointerAxisStopSinceVersion = 5
+
 type PointerAxisStopHandlerFunc func(PointerAxisStopEvent)
 
 // SetAxisStopHandler : sets handler for PointerAxisStopEvent
@@ -5106,9 +6492,13 @@ func (i *Pointer) SetAxisStopHandler(f PointerAxisStopHandlerFunc) {
 // The order of wl_pointer.axis_discrete and wl_pointer.axis_source is
 // not guaranteed.
 type PointerAxisDiscreteEvent struct {
-	Axis     uint32
+	Axis     PointerAxis
 	Discrete int32
 }
+
+// PointerAxisDiscreteSinceVersion : version of Pointer that introduced PointerAxisDiscreteEvent
+const PointerAxisDiscreteSinceVersion = 5
+
 type PointerAxisDiscreteHandlerFunc func(PointerAxisDiscreteEvent)
 
 // SetAxisDiscreteHandler : sets handler for PointerAxisDiscreteEvent
@@ -5140,9 +6530,13 @@ func (i *Pointer) SetAxisDiscreteHandler(f PointerAxisDiscreteHandlerFunc) {
 // The order of wl_pointer.axis_value120 and wl_pointer.axis_source is
 // not guaranteed.
 type PointerAxisValue120Event struct {
-	Axis     uint32
+	Axis     PointerAxis
 	Value120 int32
 }
+
+// PointerAxisValue120SinceVersion : version of Pointer that introduced PointerAxisValue120Event
+const PointerAxisValue120SinceVersion = 8
+
 type PointerAxisValue120HandlerFunc func(PointerAxisValue120Event)
 
 // SetAxisValue120Handler : sets handler for PointerAxisValue120Event
@@ -5160,7 +6554,7 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 		l := 0
 		e.Serial = Uint32(data[l : l+4])
 		l += 4
-		e.Surface = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
+		e.Surface, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
 		l += 4
 		e.SurfaceX = Fixed(data[l : l+4])
 		l += 4
@@ -5176,7 +6570,7 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 		l := 0
 		e.Serial = Uint32(data[l : l+4])
 		l += 4
-		e.Surface = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
+		e.Surface, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
 		l += 4
 
 		i.leaveHandler(e)
@@ -5206,7 +6600,7 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 		l += 4
 		e.Button = Uint32(data[l : l+4])
 		l += 4
-		e.State = Uint32(data[l : l+4])
+		e.State = PointerButtonState(Uint32(data[l : l+4]))
 		l += 4
 
 		i.buttonHandler(e)
@@ -5218,7 +6612,7 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 		l := 0
 		e.Time = Uint32(data[l : l+4])
 		l += 4
-		e.Axis = Uint32(data[l : l+4])
+		e.Axis = PointerAxis(Uint32(data[l : l+4]))
 		l += 4
 		e.Value = Fixed(data[l : l+4])
 		l += 4
@@ -5237,7 +6631,7 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e PointerAxisSourceEvent
 		l := 0
-		e.AxisSource = Uint32(data[l : l+4])
+		e.AxisSource = PointerAxisSource(Uint32(data[l : l+4]))
 		l += 4
 
 		i.axisSourceHandler(e)
@@ -5249,7 +6643,7 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 		l := 0
 		e.Time = Uint32(data[l : l+4])
 		l += 4
-		e.Axis = Uint32(data[l : l+4])
+		e.Axis = PointerAxis(Uint32(data[l : l+4]))
 		l += 4
 
 		i.axisStopHandler(e)
@@ -5259,7 +6653,7 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e PointerAxisDiscreteEvent
 		l := 0
-		e.Axis = Uint32(data[l : l+4])
+		e.Axis = PointerAxis(Uint32(data[l : l+4]))
 		l += 4
 		e.Discrete = int32(Uint32(data[l : l+4]))
 		l += 4
@@ -5271,7 +6665,7 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e PointerAxisValue120Event
 		l := 0
-		e.Axis = Uint32(data[l : l+4])
+		e.Axis = PointerAxis(Uint32(data[l : l+4]))
 		l += 4
 		e.Value120 = int32(Uint32(data[l : l+4]))
 		l += 4
@@ -5283,6 +6677,81 @@ func (i *Pointer) Dispatch(opcode uint32, fd int, data []byte) {
 // KeyboardName : keyboard input device
 const KeyboardName = "wl_keyboard"
 
+// KeyboardInterface : metadata of the wl_keyboard interface
+var KeyboardInterface = &Interface{
+	Name:    KeyboardName,
+	Version: 8,
+	New:     func(ctx *Context) Proxy { return NewKeyboard(ctx) },
+	Requests: []Message{
+		{
+			Name:  "release",
+			Since: 3,
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "keymap",
+			Since: 1,
+			Args: []Arg{
+				{Name: "format", Type: ArgTypeUint},
+				{Name: "fd", Type: ArgTypeFd},
+				{Name: "size", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "enter",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface"},
+				{Name: "keys", Type: ArgTypeArray},
+			},
+		},
+		{
+			Name:  "leave",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface"},
+			},
+		},
+		{
+			Name:  "key",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "key", Type: ArgTypeUint},
+				{Name: "state", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "modifiers",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "mods_depressed", Type: ArgTypeUint},
+				{Name: "mods_latched", Type: ArgTypeUint},
+				{Name: "mods_locked", Type: ArgTypeUint},
+				{Name: "group", Type: ArgTypeUint},
+			},
+		},
+		{
+			Name:  "repeat_info",
+			Since: 4,
+			Args: []Arg{
+				{Name: "rate", Type: ArgTypeInt},
+				{Name: "delay", Type: ArgTypeInt},
+			},
+		},
+	},
+}
+
+// Interface : returns KeyboardInterface
+func (i *Keyboard) Interface() *Interface {
+	return KeyboardInterface
+}
+
 // Keyboard : keyboard input device
 //
 // The wl_keyboard interface represents one or more keyboards
@@ -5307,8 +6776,14 @@ func NewKeyboard(ctx *Context) *Keyboard {
 	return wlKeyboard
 }
 
+// KeyboardReleaseSinceVersion : version of Keyboard that introduced Release
+const KeyboardReleaseSinceVersion = 3
+
 // Release : release the keyboard object
 func (i *Keyboard) Release() error {
+	if v := i.Version(); v < KeyboardReleaseSinceVersion {
+		return &VersionError{Interface: KeyboardName, Request: "release", Since: KeyboardReleaseSinceVersion, Version: v}
+	}
 	defer i.Context().Unregister(i)
 	const opcode = 0
 	const _reqBufLen = 8
@@ -5408,10 +6883,14 @@ func (e KeyboardKeyState) String() string {
 // From version 7 onwards, the fd must be mapped with MAP_PRIVATE by
 // the recipient, as MAP_SHARED may fail.
 type KeyboardKeymapEvent struct {
-	Format uint32
+	Format KeyboardKeymapFormat
 	Fd     int
 	Size   uint32
 }
+
+// KeyboardKeymapSinceVersion : version of Keyboard that introduced KeyboardKeymapEvent
+const KeyboardKeymapSinceVersion = 1
+
 type KeyboardKeymapHandlerFunc func(KeyboardKeymapEvent)
 
 // SetKeymapHandler : sets handler for KeyboardKeymapEvent
@@ -5427,10 +6906,15 @@ func (i *Keyboard) SetKeymapHandler(f KeyboardKeymapHandlerFunc) {
 // The compositor must send the wl_keyboard.modifiers event after this
 // event.
 type KeyboardEnterEvent struct {
-	Serial  uint32
+	Serial uint32
+	// Surface is nil if the client destroyed the object
 	Surface *Surface
 	Keys    []byte
 }
+
+// KeyboardEnterSinceVersion : version of Keyboard that introduced KeyboardEnterEvent
+const KeyboardEnterSinceVersion = 1
+
 type KeyboardEnterHandlerFunc func(KeyboardEnterEvent)
 
 // SetEnterHandler : sets handler for KeyboardEnterEvent
@@ -5449,9 +6933,14 @@ func (i *Keyboard) SetEnterHandler(f KeyboardEnterHandlerFunc) {
 // After this event client must assume that all keys, including modifiers,
 // are lifted and also it must stop key repeating if there's some going on.
 type KeyboardLeaveEvent struct {
-	Serial  uint32
+	Serial uint32
+	// Surface is nil if the client destroyed the object
 	Surface *Surface
 }
+
+// KeyboardLeaveSinceVersion : version of Keyboard that introduced KeyboardLeaveEvent
+const KeyboardLeaveSinceVersion = 1
+
 type KeyboardLeaveHandlerFunc func(KeyboardLeaveEvent)
 
 // SetLeaveHandler : sets handler for KeyboardLeaveEvent
@@ -5474,8 +6963,12 @@ type KeyboardKeyEvent struct {
 	Serial uint32
 	Time   uint32
 	Key    uint32
-	State  uint32
+	State  KeyboardKeyState
 }
+
+// KeyboardKeySinceVersion : version of Keyboard that introduced KeyboardKeyEvent
+const KeyboardKeySinceVersion = 1
+
 type KeyboardKeyHandlerFunc func(KeyboardKeyEvent)
 
 // SetKeyHandler : sets handler for KeyboardKeyEvent
@@ -5494,6 +6987,10 @@ type KeyboardModifiersEvent struct {
 	ModsLocked    uint32
 	Group         uint32
 }
+
+// KeyboardModifiersSinceVersion : version of Keyboard that introduced KeyboardModifiersEvent
+const KeyboardModifiersSinceVersion = 1
+
 type KeyboardModifiersHandlerFunc func(KeyboardModifiersEvent)
 
 // SetModifiersHandler : sets handler for KeyboardModifiersEvent
@@ -5519,6 +7016,10 @@ type KeyboardRepeatInfoEvent struct {
 	Rate  int32
 	Delay int32
 }
+
+// KeyboardRepeatInfoSinceVersion : version of Keyboard that introduced KeyboardRepeatInfoEvent
+const KeyboardRepeatInfoSinceVersion = 4
+
 type KeyboardRepeatInfoHandlerFunc func(KeyboardRepeatInfoEvent)
 
 // SetRepeatInfoHandler : sets handler for KeyboardRepeatInfoEvent
@@ -5537,7 +7038,7 @@ func (i *Keyboard) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e KeyboardKeymapEvent
 		l := 0
-		e.Format = Uint32(data[l : l+4])
+		e.Format = KeyboardKeymapFormat(Uint32(data[l : l+4]))
 		l += 4
 		e.Fd = fd
 		e.Size = Uint32(data[l : l+4])
@@ -5552,7 +7053,7 @@ func (i *Keyboard) Dispatch(opcode uint32, fd int, data []byte) {
 		l := 0
 		e.Serial = Uint32(data[l : l+4])
 		l += 4
-		e.Surface = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
+		e.Surface, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
 		l += 4
 		keysLen := int(Uint32(data[l : l+4]))
 		l += 4
@@ -5569,7 +7070,7 @@ func (i *Keyboard) Dispatch(opcode uint32, fd int, data []byte) {
 		l := 0
 		e.Serial = Uint32(data[l : l+4])
 		l += 4
-		e.Surface = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
+		e.Surface, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
 		l += 4
 
 		i.leaveHandler(e)
@@ -5585,7 +7086,7 @@ func (i *Keyboard) Dispatch(opcode uint32, fd int, data []byte) {
 		l += 4
 		e.Key = Uint32(data[l : l+4])
 		l += 4
-		e.State = Uint32(data[l : l+4])
+		e.State = KeyboardKeyState(Uint32(data[l : l+4]))
 		l += 4
 
 		i.keyHandler(e)
@@ -5625,6 +7126,82 @@ func (i *Keyboard) Dispatch(opcode uint32, fd int, data []byte) {
 // TouchName : touchscreen input device
 const TouchName = "wl_touch"
 
+// TouchInterface : metadata of the wl_touch interface
+var TouchInterface = &Interface{
+	Name:    TouchName,
+	Version: 8,
+	New:     func(ctx *Context) Proxy { return NewTouch(ctx) },
+	Requests: []Message{
+		{
+			Name:  "release",
+			Since: 3,
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "down",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface"},
+				{Name: "id", Type: ArgTypeInt},
+				{Name: "x", Type: ArgTypeFixed},
+				{Name: "y", Type: ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "up",
+			Since: 1,
+			Args: []Arg{
+				{Name: "serial", Type: ArgTypeUint},
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "id", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []Arg{
+				{Name: "time", Type: ArgTypeUint},
+				{Name: "id", Type: ArgTypeInt},
+				{Name: "x", Type: ArgTypeFixed},
+				{Name: "y", Type: ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+		},
+		{
+			Name:  "cancel",
+			Since: 1,
+		},
+		{
+			Name:  "shape",
+			Since: 6,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeInt},
+				{Name: "major", Type: ArgTypeFixed},
+				{Name: "minor", Type: ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "orientation",
+			Since: 6,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeInt},
+				{Name: "orientation", Type: ArgTypeFixed},
+			},
+		},
+	},
+}
+
+// Interface : returns TouchInterface
+func (i *Touch) Interface() *Interface {
+	return TouchInterface
+}
+
 // Touch : touchscreen input device
 //
 // The wl_touch interface represents a touchscreen
@@ -5662,8 +7239,14 @@ func NewTouch(ctx *Context) *Touch {
 	return wlTouch
 }
 
+// TouchReleaseSinceVersion : version of Touch that introduced Release
+const TouchReleaseSinceVersion = 3
+
 // Release : release the touch object
 func (i *Touch) Release() error {
+	if v := i.Version(); v < TouchReleaseSinceVersion {
+		return &VersionError{Interface: TouchName, Request: "release", Since: TouchReleaseSinceVersion, Version: v}
+	}
 	defer i.Context().Unregister(i)
 	const opcode = 0
 	const _reqBufLen = 8
@@ -5684,13 +7267,18 @@ func (i *Touch) Release() error {
 // this ID. The ID ceases to be valid after a touch up event and may be
 // reused in the future.
 type TouchDownEvent struct {
-	Serial  uint32
-	Time    uint32
+	Serial uint32
+	Time   uint32
+	// Surface is nil if the client destroyed the object
 	Surface *Surface
 	Id      int32
 	X       float64
 	Y       float64
 }
+
+// TouchDownSinceVersion : version of Touch that introduced TouchDownEvent
+const TouchDownSinceVersion = 1
+
 type TouchDownHandlerFunc func(TouchDownEvent)
 
 // SetDownHandler : sets handler for TouchDownEvent
@@ -5708,6 +7296,10 @@ type TouchUpEvent struct {
 	Time   uint32
 	Id     int32
 }
+
+// TouchUpSinceVersion : version of Touch that introduced TouchUpEvent
+const TouchUpSinceVersion = 1
+
 type TouchUpHandlerFunc func(TouchUpEvent)
 
 // SetUpHandler : sets handler for TouchUpEvent
@@ -5724,6 +7316,10 @@ type TouchMotionEvent struct {
 	X    float64
 	Y    float64
 }
+
+// TouchMotionSinceVersion : version of Touch that introduced TouchMotionEvent
+const TouchMotionSinceVersion = 1
+
 type TouchMotionHandlerFunc func(TouchMotionEvent)
 
 // SetMotionHandler : sets handler for TouchMotionEvent
@@ -5742,6 +7338,10 @@ func (i *Touch) SetMotionHandler(f TouchMotionHandlerFunc) {
 // must assume that any state not updated in a frame is unchanged from the
 // previously known state.
 type TouchFrameEvent struct{}
+
+// TouchFrameSinceVersion : version of Touch that introduced TouchFrameEvent
+const TouchFrameSinceVersion = 1
+
 type TouchFrameHandlerFunc func(TouchFrameEvent)
 
 // SetFrameHandler : sets handler for TouchFrameEvent
@@ -5758,6 +7358,10 @@ func (i *Touch) SetFrameHandler(f TouchFrameHandlerFunc) {
 // responsible for finalizing the touch points, future touch points on
 // this surface may reuse the touch point ID.
 type TouchCancelEvent struct{}
+
+// TouchCancelSinceVersion : version of Touch that introduced TouchCancelEvent
+const TouchCancelSinceVersion = 1
+
 type TouchCancelHandlerFunc func(TouchCancelEvent)
 
 // SetCancelHandler : sets handler for TouchCancelEvent
@@ -5797,6 +7401,10 @@ type TouchShapeEvent struct {
 	Major float64
 	Minor float64
 }
+
+// TouchShapeSinceVersion : version of Touch that introduced TouchShapeEvent
+const TouchShapeSinceVersion = 6
+
 type TouchShapeHandlerFunc func(TouchShapeEvent)
 
 // SetShapeHandler : sets handler for TouchShapeEvent
@@ -5833,6 +7441,10 @@ type TouchOrientationEvent struct {
 	Id          int32
 	Orientation float64
 }
+
+// TouchOrientationSinceVersion : version of Touch that introduced TouchOrientationEvent
+const TouchOrientationSinceVersion = 6
+
 type TouchOrientationHandlerFunc func(TouchOrientationEvent)
 
 // SetOrientationHandler : sets handler for TouchOrientationEvent
@@ -5852,7 +7464,7 @@ func (i *Touch) Dispatch(opcode uint32, fd int, data []byte) {
 		l += 4
 		e.Time = Uint32(data[l : l+4])
 		l += 4
-		e.Surface = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
+		e.Surface, _ = i.Context().GetProxy(Uint32(data[l : l+4])).(*Surface)
 		l += 4
 		e.Id = int32(Uint32(data[l : l+4]))
 		l += 4
@@ -5938,6 +7550,75 @@ func (i *Touch) Dispatch(opcode uint32, fd int, data []byte) {
 // OutputName : compositor output region
 const OutputName = "wl_output"
 
+// OutputInterface : metadata of the wl_output interface
+var OutputInterface = &Interface{
+	Name:    OutputName,
+	Version: 4,
+	New:     func(ctx *Context) Proxy { return NewOutput(ctx) },
+	Requests: []Message{
+		{
+			Name:  "release",
+			Since: 3,
+		},
+	},
+	Events: []Message{
+		{
+			Name:  "geometry",
+			Since: 1,
+			Args: []Arg{
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+				{Name: "physical_width", Type: ArgTypeInt},
+				{Name: "physical_height", Type: ArgTypeInt},
+				{Name: "subpixel", Type: ArgTypeInt},
+				{Name: "make", Type: ArgTypeString},
+				{Name: "model", Type: ArgTypeString},
+				{Name: "transform", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "mode",
+			Since: 1,
+			Args: []Arg{
+				{Name: "flags", Type: ArgTypeUint},
+				{Name: "width", Type: ArgTypeInt},
+				{Name: "height", Type: ArgTypeInt},
+				{Name: "refresh", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 2,
+		},
+		{
+			Name:  "scale",
+			Since: 2,
+			Args: []Arg{
+				{Name: "factor", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "name",
+			Since: 4,
+			Args: []Arg{
+				{Name: "name", Type: ArgTypeString},
+			},
+		},
+		{
+			Name:  "description",
+			Since: 4,
+			Args: []Arg{
+				{Name: "description", Type: ArgTypeString},
+			},
+		},
+	},
+}
+
+// Interface : returns OutputInterface
+func (i *Output) Interface() *Interface {
+	return OutputInterface
+}
+
 // Output : compositor output region
 //
 // An output describes part of the compositor geometry.  The
@@ -5970,11 +7651,17 @@ func NewOutput(ctx *Context) *Output {
 	return wlOutput
 }
 
+// OutputReleaseSinceVersion : version of Output that introduced Release
+const OutputReleaseSinceVersion = 3
+
 // Release : release the output object
 //
 // Using this request a client can tell the server that it is not going to
 // use the output object anymore.
 func (i *Output) Release() error {
+	if v := i.Version(); v < OutputReleaseSinceVersion {
+		return &VersionError{Interface: OutputName, Request: "release", Since: OutputReleaseSinceVersion, Version: v}
+	}
 	defer i.Context().Unregister(i)
 	const opcode = 0
 	const _reqBufLen = 8
@@ -6170,8 +7857,39 @@ func (e OutputMode) Value() string {
 	}
 }
 
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e OutputMode) Has(f OutputMode) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e OutputMode) Set(f OutputMode) OutputMode {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e OutputMode) Clear(f OutputMode) OutputMode {
+	return e &^ f
+}
+
 func (e OutputMode) String() string {
-	return e.Name() + "=" + e.Value()
+	if e == 0 {
+		return "0"
+	}
+	var flags []string
+	if e.Has(OutputModeCurrent) {
+		flags = append(flags, "current")
+		e = e.Clear(OutputModeCurrent)
+	}
+	if e.Has(OutputModePreferred) {
+		flags = append(flags, "preferred")
+		e = e.Clear(OutputModePreferred)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
 }
 
 // OutputGeometryEvent : properties of the output
@@ -6197,11 +7915,15 @@ type OutputGeometryEvent struct {
 	Y              int32
 	PhysicalWidth  int32
 	PhysicalHeight int32
-	Subpixel       int32
+	Subpixel       OutputSubpixel
 	Make           string
 	Model          string
-	Transform      int32
+	Transform      OutputTransform
 }
+
+// OutputGeometrySinceVersion : version of Output that introduced OutputGeometryEvent
+const OutputGeometrySinceVersion = 1
+
 type OutputGeometryHandlerFunc func(OutputGeometryEvent)
 
 // SetGeometryHandler : sets handler for OutputGeometryEvent
@@ -6245,11 +7967,15 @@ func (i *Output) SetGeometryHandler(f OutputGeometryHandlerFunc) {
 // compositors, such as those exposing virtual outputs, might fake the
 // refresh rate or the size.
 type OutputModeEvent struct {
-	Flags   uint32
+	Flags   OutputMode
 	Width   int32
 	Height  int32
 	Refresh int32
 }
+
+// OutputModeSinceVersion : version of Output that introduced OutputModeEvent
+const OutputModeSinceVersion = 1
+
 type OutputModeHandlerFunc func(OutputModeEvent)
 
 // SetModeHandler : sets handler for OutputModeEvent
@@ -6265,6 +7991,10 @@ func (i *Output) SetModeHandler(f OutputModeHandlerFunc) {
 // changes to the output properties to be seen as
 // atomic, even if they happen via multiple events.
 type OutputDoneEvent struct{}
+
+// OutputDoneSinceVersion : version of Output that introduced OutputDoneEvent
+const OutputDoneSinceVersion = 2
+
 type OutputDoneHandlerFunc func(OutputDoneEvent)
 
 // SetDoneHandler : sets handler for OutputDoneEvent
@@ -6297,6 +8027,10 @@ func (i *Output) SetDoneHandler(f OutputDoneHandlerFunc) {
 type OutputScaleEvent struct {
 	Factor int32
 }
+
+// OutputScaleSinceVersion : version of Output that introduced OutputScaleEvent
+const OutputScaleSinceVersion = 2
+
 type OutputScaleHandlerFunc func(OutputScaleEvent)
 
 // SetScaleHandler : sets handler for OutputScaleEvent
@@ -6337,6 +8071,10 @@ func (i *Output) SetScaleHandler(f OutputScaleHandlerFunc) {
 type OutputNameEvent struct {
 	Name string
 }
+
+// OutputNameSinceVersion : version of Output that introduced OutputNameEvent
+const OutputNameSinceVersion = 4
+
 type OutputNameHandlerFunc func(OutputNameEvent)
 
 // SetNameHandler : sets handler for OutputNameEvent
@@ -6363,6 +8101,10 @@ func (i *Output) SetNameHandler(f OutputNameHandlerFunc) {
 type OutputDescriptionEvent struct {
 	Description string
 }
+
+// OutputDescriptionSinceVersion : version of Output that introduced OutputDescriptionEvent
+const OutputDescriptionSinceVersion = 4
+
 type OutputDescriptionHandlerFunc func(OutputDescriptionEvent)
 
 // SetDescriptionHandler : sets handler for OutputDescriptionEvent
@@ -6386,7 +8128,7 @@ func (i *Output) Dispatch(opcode uint32, fd int, data []byte) {
 		l += 4
 		e.PhysicalHeight = int32(Uint32(data[l : l+4]))
 		l += 4
-		e.Subpixel = int32(Uint32(data[l : l+4]))
+		e.Subpixel = OutputSubpixel(Uint32(data[l : l+4]))
 		l += 4
 		makeLen := PaddedLen(int(Uint32(data[l : l+4])))
 		l += 4
@@ -6396,7 +8138,7 @@ func (i *Output) Dispatch(opcode uint32, fd int, data []byte) {
 		l += 4
 		e.Model = String(data[l : l+modelLen])
 		l += modelLen
-		e.Transform = int32(Uint32(data[l : l+4]))
+		e.Transform = OutputTransform(Uint32(data[l : l+4]))
 		l += 4
 
 		i.geometryHandler(e)
@@ -6406,7 +8148,7 @@ func (i *Output) Dispatch(opcode uint32, fd int, data []byte) {
 		}
 		var e OutputModeEvent
 		l := 0
-		e.Flags = Uint32(data[l : l+4])
+		e.Flags = OutputMode(Uint32(data[l : l+4]))
 		l += 4
 		e.Width = int32(Uint32(data[l : l+4]))
 		l += 4
@@ -6463,6 +8205,44 @@ func (i *Output) Dispatch(opcode uint32, fd int, data []byte) {
 // RegionName : region interface
 const RegionName = "wl_region"
 
+// RegionInterface : metadata of the wl_region interface
+var RegionInterface = &Interface{
+	Name:    RegionName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewRegion(ctx) },
+	Requests: []Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "add",
+			Since: 1,
+			Args: []Arg{
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+				{Name: "width", Type: ArgTypeInt},
+				{Name: "height", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "subtract",
+			Since: 1,
+			Args: []Arg{
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+				{Name: "width", Type: ArgTypeInt},
+				{Name: "height", Type: ArgTypeInt},
+			},
+		},
+	},
+}
+
+// Interface : returns RegionInterface
+func (i *Region) Interface() *Interface {
+	return RegionInterface
+}
+
 // Region : region interface
 //
 // A region object describes an area.
@@ -6485,6 +8265,9 @@ func NewRegion(ctx *Context) *Region {
 	return wlRegion
 }
 
+// RegionDestroySinceVersion : version of Region that introduced Destroy
+const RegionDestroySinceVersion = 1
+
 // Destroy : destroy region
 //
 // Destroy the region.  This will invalidate the object ID.
@@ -6502,6 +8285,9 @@ func (i *Region) Destroy() error {
 	return err
 }
 
+// RegionAddSinceVersion : version of Region that introduced Add
+const RegionAddSinceVersion = 1
+
 // Add : add rectangle to region
 //
 // Add the specified rectangle to the region.
@@ -6531,6 +8317,9 @@ func (i *Region) Add(x, y, width, height int32) error {
 	return err
 }
 
+// RegionSubtractSinceVersion : version of Region that introduced Subtract
+const RegionSubtractSinceVersion = 1
+
 // Subtract : subtract rectangle from region
 //
 // Subtract the specified rectangle from the region.
@@ -6563,6 +8352,33 @@ func (i *Region) Subtract(x, y, width, height int32) error {
 // SubcompositorName : sub-surface compositing
 const SubcompositorName = "wl_subcompositor"
 
+// SubcompositorInterface : metadata of the wl_subcompositor interface
+var SubcompositorInterface = &Interface{
+	Name:    SubcompositorName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewSubcompositor(ctx) },
+	Requests: []Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "get_subsurface",
+			Since: 1,
+			Args: []Arg{
+				{Name: "id", Type: ArgTypeNewID, Interface: "wl_subsurface"},
+				{Name: "surface", Type: ArgTypeObject, Interface: "wl_surface"},
+				{Name: "parent", Type: ArgTypeObject, Interface: "wl_surface"},
+			},
+		},
+	},
+}
+
+// Interface : returns SubcompositorInterface
+func (i *Subcompositor) Interface() *Interface {
+	return SubcompositorInterface
+}
+
 // Subcompositor : sub-surface compositing
 //
 // The global interface exposing sub-surface compositing capabilities.
@@ -6615,6 +8431,9 @@ func NewSubcompositor(ctx *Context) *Subcompositor {
 	return wlSubcompositor
 }
 
+// SubcompositorDestroySinceVersion : version of Subcompositor that introduced Destroy
+const SubcompositorDestroySinceVersion = 1
+
 // Destroy : unbind from the subcompositor interface
 //
 // Informs the server that the client will not be using this
@@ -6634,6 +8453,9 @@ func (i *Subcompositor) Destroy() error {
 	return err
 }
 
+// SubcompositorGetSubsurfaceSinceVersion : version of Subcompositor that introduced GetSubsurface
+const SubcompositorGetSubsurfaceSinceVersion = 1
+
 // GetSubsurface : give a surface the role sub-surface
 //
 // Create a sub-surface interface for the given surface, and
@@ -6656,6 +8478,7 @@ func (i *Subcompositor) Destroy() error {
 //	parent: the parent surface
 func (i *Subcompositor) GetSubsurface(surface, parent *Surface) (*Subsurface, error) {
 	id := NewSubsurface(i.Context())
+	id.SetVersion(i.Version())
 	const opcode = 1
 	const _reqBufLen = 8 + 4 + 4 + 4
 	var _reqBuf [_reqBufLen]byte
@@ -6707,6 +8530,54 @@ func (e SubcompositorError) String() string {
 // SubsurfaceName : sub-surface interface to a wl_surface
 const SubsurfaceName = "wl_subsurface"
 
+// SubsurfaceInterface : metadata of the wl_subsurface interface
+var SubsurfaceInterface = &Interface{
+	Name:    SubsurfaceName,
+	Version: 1,
+	New:     func(ctx *Context) Proxy { return NewSubsurface(ctx) },
+	Requests: []Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "set_position",
+			Since: 1,
+			Args: []Arg{
+				{Name: "x", Type: ArgTypeInt},
+				{Name: "y", Type: ArgTypeInt},
+			},
+		},
+		{
+			Name:  "place_above",
+			Since: 1,
+			Args: []Arg{
+				{Name: "sibling", Type: ArgTypeObject, Interface: "wl_surface"},
+			},
+		},
+		{
+			Name:  "place_below",
+			Since: 1,
+			Args: []Arg{
+				{Name: "sibling", Type: ArgTypeObject, Interface: "wl_surface"},
+			},
+		},
+		{
+			Name:  "set_sync",
+			Since: 1,
+		},
+		{
+			Name:  "set_desync",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns SubsurfaceInterface
+func (i *Subsurface) Interface() *Interface {
+	return SubsurfaceInterface
+}
+
 // Subsurface : sub-surface interface to a wl_surface
 //
 // An additional interface to a wl_surface object, which has been
@@ -6819,6 +8690,9 @@ func NewSubsurface(ctx *Context) *Subsurface {
 	return wlSubsurface
 }
 
+// SubsurfaceDestroySinceVersion : version of Subsurface that introduced Destroy
+const SubsurfaceDestroySinceVersion = 1
+
 // Destroy : remove sub-surface interface
 //
 // The sub-surface interface is removed from the wl_surface object
@@ -6840,6 +8714,9 @@ func (i *Subsurface) Destroy() error {
 	return err
 }
 
+// SubsurfaceSetPositionSinceVersion : version of Subsurface that introduced SetPosition
+const SubsurfaceSetPositionSinceVersion = 1
+
 // SetPosition : reposition the sub-surface
 //
 // This schedules a sub-surface position change.
@@ -6878,6 +8755,9 @@ func (i *Subsurface) SetPosition(x, y int32) error {
 	return err
 }
 
+// SubsurfacePlaceAboveSinceVersion : version of Subsurface that introduced PlaceAbove
+const SubsurfacePlaceAboveSinceVersion = 1
+
 // PlaceAbove : restack the sub-surface
 //
 // This sub-surface is taken from the stack, and put back just
@@ -6912,6 +8792,9 @@ func (i *Subsurface) PlaceAbove(sibling *Surface) error {
 	return err
 }
 
+// SubsurfacePlaceBelowSinceVersion : version of Subsurface that introduced PlaceBelow
+const SubsurfacePlaceBelowSinceVersion = 1
+
 // PlaceBelow : restack the sub-surface
 //
 // The sub-surface is placed just below the reference surface.
@@ -6933,6 +8816,9 @@ func (i *Subsurface) PlaceBelow(sibling *Surface) error {
 	return err
 }
 
+// SubsurfaceSetSyncSinceVersion : version of Subsurface that introduced SetSync
+const SubsurfaceSetSyncSinceVersion = 1
+
 // SetSync : set sub-surface to synchronized mode
 //
 // Change the commit behaviour of the sub-surface to synchronized
@@ -6961,6 +8847,9 @@ func (i *Subsurface) SetSync() error {
 	return err
 }
 
+// SubsurfaceSetDesyncSinceVersion : version of Subsurface that introduced SetDesync
+const SubsurfaceSetDesyncSinceVersion = 1
+
 // SetDesync : set sub-surface to desynchronized mode
 //
 // Change the commit behaviour of the sub-surface to desynchronized
@@ -7024,3 +8913,28 @@ func (e SubsurfaceError) Value() string {
 func (e SubsurfaceError) String() string {
 	return e.Name() + "=" + e.Value()
 }
+
+func init() {
+	RegisterInterface(DisplayInterface)
+	RegisterInterface(RegistryInterface)
+	RegisterInterface(CallbackInterface)
+	RegisterInterface(CompositorInterface)
+	RegisterInterface(ShmPoolInterface)
+	RegisterInterface(ShmInterface)
+	RegisterInterface(BufferInterface)
+	RegisterInterface(DataOfferInterface)
+	RegisterInterface(DataSourceInterface)
+	RegisterInterface(DataDeviceInterface)
+	RegisterInterface(DataDeviceManagerInterface)
+	RegisterInterface(ShellInterface)
+	RegisterInterface(ShellSurfaceInterface)
+	RegisterInterface(SurfaceInterface)
+	RegisterInterface(SeatInterface)
+	RegisterInterface(PointerInterface)
+	RegisterInterface(KeyboardInterface)
+	RegisterInterface(TouchInterface)
+	RegisterInterface(OutputInterface)
+	RegisterInterface(RegionInterface)
+	RegisterInterface(SubcompositorInterface)
+	RegisterInterface(SubsurfaceInterface)
+}
@@ -9,11 +9,14 @@ type Proxy interface {
 	SetContext(ctx *Context)
 	ID() uint32
 	SetID(id uint32)
+	Version() uint32
+	SetVersion(version uint32)
 }
 
 type BaseProxy struct {
-	ctx *Context
-	id  uint32
+	ctx     *Context
+	id      uint32
+	version uint32
 }
 
 func (p *BaseProxy) ID() uint32 {
@@ -31,3 +34,13 @@ func (p *BaseProxy) Context() *Context {
 func (p *BaseProxy) SetContext(ctx *Context) {
 	p.ctx = ctx
 }
+
+// Version returns the interface version the proxy was bound or
+// created with, objects created through a proxy inherit its version.
+func (p *BaseProxy) Version() uint32 {
+	return p.version
+}
+
+func (p *BaseProxy) SetVersion(version uint32) {
+	p.version = version
+}
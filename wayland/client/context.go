@@ -1,24 +1,49 @@
 package client
 
 import (
-	"errors"
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/sys/unix"
 )
 
 type Context struct {
-	conn      *net.UnixConn
-	objects   map[uint32]Proxy
+	transport Transport
 	currentID uint32
+	closed    atomic.Bool
+	// in holds data read by ReadEvents, not yet consumed by ReadMsg. It
+	// is only used by the goroutine dispatching events.
+	in []byte
+
+	// mu guards the fields below, Close may be called from another
+	// goroutine than the one dispatching events
+	mu      sync.Mutex
+	objects map[uint32]Proxy
+	// zombies holds the interfaces of destroyed objects until the
+	// compositor acknowledges their deletion, so that fds of events
+	// still in flight to them can be consumed
+	zombies map[uint32]*Interface
+	// fds received from the compositor, not yet consumed by an event
+	fds []int
+
+	closeHandlers    map[int]func()
+	nextCloseHandler int
 }
 
 func (ctx *Context) Register(p Proxy) {
-	ctx.currentID++
-	// ensure we don't overwrite an existing object
-	if _, ok := ctx.objects[ctx.currentID]; ok {
-		ctx.Register(p)
-		return
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
+	for {
+		ctx.currentID++
+		// ensure we don't overwrite an existing object
+		if _, ok := ctx.objects[ctx.currentID]; !ok {
+			break
+		}
 	}
 	p.SetID(ctx.currentID)
 	p.SetContext(ctx)
@@ -26,67 +51,336 @@ func (ctx *Context) Register(p Proxy) {
 }
 
 func (ctx *Context) Unregister(p Proxy) {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
+	if ctx.objects[p.ID()] != p {
+		return
+	}
 	delete(ctx.objects, p.ID())
+
+	var iface *Interface
+	if p, ok := p.(interface{ Interface() *Interface }); ok {
+		iface = p.Interface()
+	}
+	ctx.zombies[p.ID()] = iface
+}
+
+// forget removes p without waiting for wl_display.delete_id, for
+// proxies whose creating request was never sent
+func (ctx *Context) forget(p Proxy) {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
+	if ctx.objects[p.ID()] == p {
+		delete(ctx.objects, p.ID())
+	}
 }
 
 func (ctx *Context) GetProxy(id uint32) Proxy {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
 	return ctx.objects[id]
 }
 
 func (ctx *Context) SetProxy(id uint32, p Proxy) {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
 	p.SetID(id)
 	p.SetContext(ctx)
 	ctx.objects[id] = p
+	delete(ctx.zombies, id)
 }
 
+// AddCloseHandler registers f to be called once the context is closed,
+// the returned function unregisters it.
+func (ctx *Context) AddCloseHandler(f func()) (remove func()) {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
+	id := ctx.nextCloseHandler
+	ctx.nextCloseHandler++
+	ctx.closeHandlers[id] = f
+
+	return func() {
+		ctx.mu.Lock()
+		defer ctx.mu.Unlock()
+
+		delete(ctx.closeHandlers, id)
+	}
+}
+
+// Closed reports whether Close was called.
+func (ctx *Context) Closed() bool {
+	return ctx.closed.Load()
+}
+
+// Close shuts the connection down. All proxies are invalidated, their
+// requests fail with ErrClosed from now on, as do Dispatch and any
+// pending Display.Roundtrip. File descriptors received but not consumed
+// by an event are closed, then the close handlers are called.
+//
+// Close may be called from another goroutine than the one dispatching
+// events.
 func (ctx *Context) Close() error {
-	return ctx.conn.Close()
+	if !ctx.closed.CompareAndSwap(false, true) {
+		return ErrClosed
+	}
+
+	err := ctx.transport.Close()
+
+	ctx.mu.Lock()
+	fds := ctx.fds
+	handlers := ctx.closeHandlers
+	ctx.objects = map[uint32]Proxy{}
+	ctx.zombies = map[uint32]*Interface{}
+	ctx.fds = nil
+	ctx.closeHandlers = map[int]func(){}
+	ctx.mu.Unlock()
+
+	for _, fd := range fds {
+		unix.Close(fd)
+	}
+	for _, f := range handlers {
+		f()
+	}
+
+	return err
 }
 
 func (ctx *Context) Dispatch() error {
-	senderID, opcode, fd, data, err := ctx.ReadMsg()
+	if ctx.closed.Load() {
+		return ErrClosed
+	}
+
+	senderID, opcode, data, err := ctx.ReadMsg()
 	if err != nil {
+		if ctx.closed.Load() {
+			return ErrClosed
+		}
 		return fmt.Errorf("ctx.Dispatch: unable to read msg: %w", err)
 	}
 
+	ctx.mu.Lock()
 	sender, ok := ctx.objects[senderID]
-	if ok {
-		if sender, ok := sender.(Dispatcher); ok {
-			sender.Dispatch(opcode, fd, data)
-		} else {
-			return fmt.Errorf("ctx.Dispatch: sender doesn't implement Dispatch method (senderID=%d)", senderID)
+	zombie, isZombie := ctx.zombies[senderID]
+	if senderID == 1 && opcode == 1 && len(data) >= 4 {
+		// wl_display.delete_id, the id is free for reuse
+		delete(ctx.zombies, Uint32(data[:4]))
+	}
+	ctx.mu.Unlock()
+
+	if !ok {
+		if isZombie {
+			// Event sent before the compositor processed our destroy request
+			ctx.closeFds(eventFds(zombie, opcode))
+			return nil
 		}
-	} else {
-		return fmt.Errorf("ctx.Dispatch: unable find sender (senderID=%d)", senderID)
+		return fmt.Errorf("ctx.Dispatch: unable find sender (senderID=%d): %w", senderID, ErrUnknownObject)
+	}
+
+	dispatcher, ok := sender.(Dispatcher)
+	if !ok {
+		return fmt.Errorf("ctx.Dispatch: sender doesn't implement Dispatch method (senderID=%d)", senderID)
 	}
 
+	fd := -1
+	if p, ok := sender.(interface{ Interface() *Interface }); ok {
+		iface := p.Interface()
+		fds := ctx.popFds(eventFds(iface, opcode))
+		if err := checkEvent(iface, opcode, data); err != nil {
+			for _, fd := range fds {
+				unix.Close(fd)
+			}
+			return err
+		}
+		if len(fds) > 0 {
+			fd = fds[0]
+			for _, fd := range fds[1:] {
+				unix.Close(fd)
+			}
+		}
+	}
+
+	var protocolErr *ProtocolError
+	if senderID == 1 && opcode == 0 {
+		protocolErr = ctx.protocolError(data)
+	}
+
+	dispatcher.Dispatch(opcode, fd, data)
+
+	if protocolErr != nil {
+		return protocolErr
+	}
 	return nil
 }
 
-func Connect(addr string) (*Display, error) {
-	if addr == "" {
-		runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
-		if runtimeDir == "" {
-			return nil, errors.New("env XDG_RUNTIME_DIR not set")
+// protocolError decodes a wl_display.error event
+func (ctx *Context) protocolError(data []byte) *ProtocolError {
+	objectID := Uint32(data[0:4])
+	messageLen := PaddedLen(int(Uint32(data[8:12])))
+
+	return &ProtocolError{
+		ObjectID:  objectID,
+		Interface: ctx.interfaceName(objectID),
+		Code:      Uint32(data[4:8]),
+		Message:   String(data[12 : 12+messageLen]),
+	}
+}
+
+// interfaceName returns the interface name of the object with the
+// given id, if known
+func (ctx *Context) interfaceName(id uint32) string {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
+	if p, ok := ctx.objects[id].(interface{ Interface() *Interface }); ok {
+		return p.Interface().Name
+	}
+	if iface := ctx.zombies[id]; iface != nil {
+		return iface.Name
+	}
+	return "unknown"
+}
+
+// checkEvent verifies that data holds exactly the arguments of the event
+func checkEvent(iface *Interface, opcode uint32, data []byte) error {
+	event := iface.Event(opcode)
+	if event == nil {
+		return &DecodeError{Interface: iface.Name, Opcode: opcode, Reason: "unknown opcode"}
+	}
+
+	l := 0
+	for _, arg := range event.Args {
+		switch arg.Type {
+		case ArgTypeFd:
+			continue
+		case ArgTypeString, ArgTypeArray:
+			if len(data) < l+4 {
+				return &DecodeError{Interface: iface.Name, Opcode: opcode, Reason: fmt.Sprintf("message too short for argument %s", arg.Name)}
+			}
+			n := int(Uint32(data[l : l+4]))
+			l += 4
+			if n > len(data)-l {
+				return &DecodeError{Interface: iface.Name, Opcode: opcode, Reason: fmt.Sprintf("message too short for argument %s", arg.Name)}
+			}
+			if arg.Type == ArgTypeString && (n == 0 || data[l+n-1] != 0) {
+				if n == 0 && arg.AllowNull {
+					continue
+				}
+				return &DecodeError{Interface: iface.Name, Opcode: opcode, Reason: fmt.Sprintf("string argument %s is not null terminated", arg.Name)}
+			}
+			l += PaddedLen(n)
+		default:
+			l += 4
 		}
-		if addr == "" {
-			addr = os.Getenv("WAYLAND_DISPLAY")
+		if l > len(data) {
+			return &DecodeError{Interface: iface.Name, Opcode: opcode, Reason: fmt.Sprintf("message too short for argument %s", arg.Name)}
 		}
-		if addr == "" {
-			addr = "wayland-0"
+	}
+	if l != len(data) {
+		return &DecodeError{Interface: iface.Name, Opcode: opcode, Reason: fmt.Sprintf("message size %d doesn't match arguments size %d", len(data), l)}
+	}
+
+	return nil
+}
+
+// eventFds returns the number of fd arguments of an event
+func eventFds(iface *Interface, opcode uint32) int {
+	if iface == nil {
+		return 0
+	}
+	event := iface.Event(opcode)
+	if event == nil {
+		return 0
+	}
+
+	n := 0
+	for _, arg := range event.Args {
+		if arg.Type == ArgTypeFd {
+			n++
 		}
-		addr = runtimeDir + "/" + addr
 	}
+	return n
+}
 
-	ctx := &Context{
-		objects: map[uint32]Proxy{},
+// popFds removes up to n fds from the head of the queue
+func (ctx *Context) popFds(n int) []int {
+	if n == 0 {
+		return nil
+	}
+
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
+	if n > len(ctx.fds) {
+		n = len(ctx.fds)
+	}
+	fds := append([]int(nil), ctx.fds[:n]...)
+	ctx.fds = ctx.fds[n:]
+	return fds
+}
+
+// closeFds closes up to n fds from the head of the queue
+func (ctx *Context) closeFds(n int) {
+	for _, fd := range ctx.popFds(n) {
+		unix.Close(fd)
+	}
+}
+
+func (ctx *Context) queueFds(fds []int) {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+
+	ctx.fds = append(ctx.fds, fds...)
+}
+
+// Connect connects to the compositor listening on addr, a display name
+// relative to XDG_RUNTIME_DIR, e.g. "wayland-1", or an absolute socket
+// path. An empty addr connects to WAYLAND_DISPLAY, "wayland-0" if it is
+// not set.
+func Connect(addr string) (*Display, error) {
+	if addr == "" {
+		addr = os.Getenv("WAYLAND_DISPLAY")
+	}
+	if addr == "" {
+		addr = "wayland-0"
+	}
+	if !filepath.IsAbs(addr) {
+		runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+		if runtimeDir == "" {
+			return nil, ErrNoRuntimeDir
+		}
+		addr = filepath.Join(runtimeDir, addr)
 	}
 
 	conn, err := net.DialUnix("unix", nil, &net.UnixAddr{Name: addr, Net: "unix"})
 	if err != nil {
-		return nil, err
+		return nil, &disconnectedError{err}
+	}
+
+	return ConnectTransport(NewUnixTransport(conn)), nil
+}
+
+// ConnectTransport creates a context communicating over t, e.g. one end
+// of a Pipe, and returns its display. Connect uses a UnixTransport.
+func ConnectTransport(t Transport) *Display {
+	ctx := &Context{
+		transport:     t,
+		objects:       map[uint32]Proxy{},
+		zombies:       map[uint32]*Interface{},
+		closeHandlers: map[int]func(){},
 	}
-	ctx.conn = conn
 
-	return NewDisplay(ctx), nil
+	display := NewDisplay(ctx)
+	display.SetVersion(DisplayInterface.Version)
+
+	return display
+}
+
+// Transport returns the transport the context communicates over.
+func (ctx *Context) Transport() Transport {
+	return ctx.transport
 }
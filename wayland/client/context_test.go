@@ -0,0 +1,172 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+	"golang.org/x/sys/unix"
+)
+
+func TestConnectNoRuntimeDir(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", "")
+
+	if _, err := Connect(""); !errors.Is(err, ErrNoRuntimeDir) {
+		t.Errorf("got error %v, want ErrNoRuntimeDir", err)
+	}
+	if _, err := Connect("wayland-1"); !errors.Is(err, ErrNoRuntimeDir) {
+		t.Errorf("got error %v, want ErrNoRuntimeDir", err)
+	}
+}
+
+// TestConnect connects by display name, by path and to WAYLAND_DISPLAY
+func TestConnect(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+	t.Setenv("WAYLAND_DISPLAY", "wayland-client-test")
+
+	s := server.New()
+	defer s.Close()
+	sock, err := server.Listen("wayland-client-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(sock)
+
+	for _, addr := range []string{"wayland-client-test", sock.Path(), ""} {
+		display, err := Connect(addr)
+		if err != nil {
+			t.Errorf("Connect(%q): %v", addr, err)
+			continue
+		}
+		if err := display.Roundtrip(); err != nil {
+			t.Errorf("roundtrip over %q: %v", addr, err)
+		}
+		display.Context().Close()
+	}
+
+	if _, err := Connect("wayland-missing"); !errors.Is(err, ErrDisconnected) {
+		t.Errorf("got error %v, want ErrDisconnected", err)
+	}
+}
+
+// TestCloseHandlers checks close handlers run once, unless removed
+func TestCloseHandlers(t *testing.T) {
+	c, compositor := Pipe()
+	defer compositor.Close()
+	ctx := ConnectTransport(c).Context()
+
+	calls := 0
+	ctx.AddCloseHandler(func() { calls++ })
+	remove := ctx.AddCloseHandler(func() { t.Error("removed close handler called") })
+	remove()
+
+	if err := ctx.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.Close(); !errors.Is(err, ErrClosed) {
+		t.Errorf("got error %v closing twice, want ErrClosed", err)
+	}
+	if calls != 1 {
+		t.Errorf("close handler called %d times, want once", calls)
+	}
+}
+
+// TestCloseProxies checks proxies are invalidated by Close
+func TestCloseProxies(t *testing.T) {
+	c, compositor := Pipe()
+	defer compositor.Close()
+	display := ConnectTransport(c)
+	ctx := display.Context()
+
+	surface := NewSurface(ctx)
+	if err := ctx.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if ctx.GetProxy(surface.ID()) != nil {
+		t.Error("wl_surface still registered")
+	}
+	if err := surface.Damage(0, 0, 1, 1); !errors.Is(err, ErrClosed) {
+		t.Errorf("got error %v from a request, want ErrClosed", err)
+	}
+	if err := ctx.Dispatch(); !errors.Is(err, ErrClosed) {
+		t.Errorf("got error %v from Dispatch, want ErrClosed", err)
+	}
+	if err := display.Roundtrip(); !errors.Is(err, ErrClosed) {
+		t.Errorf("got error %v from Roundtrip, want ErrClosed", err)
+	}
+}
+
+// TestCloseFds checks fds received but not consumed by an event are
+// closed by Close
+func TestCloseFds(t *testing.T) {
+	c, compositor := Pipe()
+	defer compositor.Close()
+	ctx := ConnectTransport(c).Context()
+
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC|unix.O_NONBLOCK); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(p[0])
+
+	// wl_keyboard.keymap, read but not dispatched
+	keyboard := NewKeyboard(ctx)
+	msg := make([]byte, 16)
+	PutUint32(msg[0:4], keyboard.ID())
+	PutUint32(msg[4:8], uint32(len(msg))<<16)
+	PutUint32(msg[8:12], uint32(KeyboardKeymapFormatXkbV1))
+	PutUint32(msg[12:16], 1)
+	err := compositor.Write(msg, []int{p[1]})
+	unix.Close(p[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.ReadEvents(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The write end of the pipe is only open in the context
+	buf := make([]byte, 1)
+	if _, err := unix.Read(p[0], buf); err != unix.EAGAIN {
+		t.Fatalf("got error %v reading the pipe, want EAGAIN", err)
+	}
+	if err := ctx.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := unix.Read(p[0], buf); n != 0 || err != nil {
+		t.Errorf("got %d bytes, %v reading the pipe, want EOF", n, err)
+	}
+}
+
+// TestCloseRoundtrip closes the context while a roundtrip waits for the
+// compositor, the roundtrip must return
+func TestCloseRoundtrip(t *testing.T) {
+	c, compositor := Pipe()
+	defer compositor.Close()
+	display := ConnectTransport(c)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- display.Roundtrip()
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("roundtrip returned %v without an answer", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := display.Context().Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrClosed) {
+			t.Errorf("got error %v from the pending roundtrip, want ErrClosed", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("roundtrip still pending after Close")
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import "testing"
+
+// sendEvent writes an event from the compositor end of a Pipe
+func sendEvent(t *testing.T, compositor Transport, senderID, opcode uint32, args ...uint32) {
+	t.Helper()
+
+	msg := make([]byte, 8+4*len(args))
+	PutUint32(msg[0:4], senderID)
+	PutUint32(msg[4:8], uint32(len(msg))<<16|opcode)
+	for i, arg := range args {
+		PutUint32(msg[8+4*i:], arg)
+	}
+	if err := compositor.Write(msg, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestDispatchDestroyedObjectArg sends wl_pointer.leave for a surface
+// the client already destroyed, the compositor didn't see the destroy
+// request yet.
+func TestDispatchDestroyedObjectArg(t *testing.T) {
+	c, compositor := Pipe()
+	defer compositor.Close()
+	display := ConnectTransport(c)
+	defer display.Context().Close()
+	ctx := display.Context()
+
+	pointer := NewPointer(ctx)
+	surface := NewSurface(ctx)
+	surfaceID := surface.ID()
+	if err := surface.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got *PointerLeaveEvent
+	pointer.SetLeaveHandler(func(e PointerLeaveEvent) {
+		got = &e
+	})
+	sendEvent(t, compositor, pointer.ID(), 1, 42, surfaceID)
+	if err := ctx.Dispatch(); err != nil {
+		t.Fatal(err)
+	}
+	if got == nil {
+		t.Fatal("leave handler not called")
+	}
+	if got.Serial != 42 || got.Surface != nil {
+		t.Errorf("got serial %d, surface %v, want 42, nil", got.Serial, got.Surface)
+	}
+}
+
+// TestDispatchNewObjectWithoutHandler sends wl_data_device.data_offer
+// without data_offer handler, the events of the new wl_data_offer must
+// still be dispatched.
+func TestDispatchNewObjectWithoutHandler(t *testing.T) {
+	c, compositor := Pipe()
+	defer compositor.Close()
+	display := ConnectTransport(c)
+	defer display.Context().Close()
+	ctx := display.Context()
+
+	dataDevice := NewDataDevice(ctx)
+	const offerID = 0xff000000
+	sendEvent(t, compositor, dataDevice.ID(), 0, offerID)
+	if err := ctx.Dispatch(); err != nil {
+		t.Fatal(err)
+	}
+
+	offer, ok := ctx.GetProxy(offerID).(*DataOffer)
+	if !ok {
+		t.Fatalf("got proxy %T for the new id, want *DataOffer", ctx.GetProxy(offerID))
+	}
+	var mimeType string
+	offer.SetOfferHandler(func(e DataOfferOfferEvent) {
+		mimeType = e.MimeType
+	})
+
+	// wl_data_offer.offer "text/plain"
+	msg := make([]byte, 8+4+12)
+	PutUint32(msg[0:4], offerID)
+	PutUint32(msg[4:8], uint32(len(msg))<<16)
+	PutString(msg[8:], "text/plain", len("text/plain")+1)
+	if err := compositor.Write(msg, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.Dispatch(); err != nil {
+		t.Fatal(err)
+	}
+	if mimeType != "text/plain" {
+		t.Errorf("got mime type %q, want \"text/plain\"", mimeType)
+	}
+}
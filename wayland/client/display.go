@@ -0,0 +1,24 @@
+package client
+
+// Roundtrip blocks until the compositor has processed all requests
+// sent so far, dispatching the events received in the meantime.
+func (i *Display) Roundtrip() error {
+	callback, err := i.Sync()
+	if err != nil {
+		return err
+	}
+	defer callback.Destroy()
+
+	done := false
+	callback.SetDoneHandler(func(CallbackDoneEvent) {
+		done = true
+	})
+
+	for !done {
+		if err := i.Context().Dispatch(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
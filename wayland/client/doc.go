@@ -1,6 +1,14 @@
 // Package client is Go port of wayland-client library
 // for writing pure Go GUI software for wayland supported
 // platforms.
+//
+// Connect talks to the compositor over a Unix socket. ConnectTransport
+// plugs in other transports, e.g. an in-memory Pipe to a mock
+// compositor in tests.
+//
+// client.go is generated from the core protocol XML, the go:generate
+// directive below regenerates all the protocol packages of the module
+// from the XML files vendored with go-wayland-scanner.
 package client
 
-//go:generate go run github.com/hempflower/go-wayland/cmd/go-wayland-scanner -pkg client -prefix wl -o client.go -i https://gitlab.freedesktop.org/wayland/wayland/-/raw/1.21.0/protocol/wayland.xml
+//go:generate go run github.com/hempflower/go-wayland/cmd/go-wayland-scanner -config ../../cmd/go-wayland-scanner/protocols/protocols.json -go 1.19
@@ -0,0 +1,53 @@
+package client
+
+import "testing"
+
+func TestBitfieldHas(t *testing.T) {
+	caps := SeatCapabilityPointer | SeatCapabilityKeyboard
+
+	for _, tt := range []struct {
+		f    SeatCapability
+		want bool
+	}{
+		{SeatCapabilityPointer, true},
+		{SeatCapabilityKeyboard, true},
+		{SeatCapabilityPointer | SeatCapabilityKeyboard, true},
+		{SeatCapabilityTouch, false},
+		{SeatCapabilityPointer | SeatCapabilityTouch, false},
+		{0, false},
+	} {
+		if got := caps.Has(tt.f); got != tt.want {
+			t.Errorf("%v.Has(%v) = %v, want %v", caps, tt.f, got, tt.want)
+		}
+	}
+	if SeatCapability(0).Has(0) {
+		t.Error("0.Has(0) = true, want false")
+	}
+}
+
+func TestBitfieldSetClear(t *testing.T) {
+	caps := SeatCapability(0).Set(SeatCapabilityPointer).Set(SeatCapabilityTouch)
+	if caps != SeatCapabilityPointer|SeatCapabilityTouch {
+		t.Errorf("Set: got %v", caps)
+	}
+	if caps = caps.Clear(SeatCapabilityPointer | SeatCapabilityKeyboard); caps != SeatCapabilityTouch {
+		t.Errorf("Clear: got %v, want touch", caps)
+	}
+}
+
+func TestEnumString(t *testing.T) {
+	for _, tt := range []struct {
+		e    interface{ String() string }
+		want string
+	}{
+		{SeatCapability(0), "0"},
+		{SeatCapabilityKeyboard, "keyboard"},
+		{SeatCapabilityPointer | SeatCapabilityTouch, "pointer|touch"},
+		{SeatCapabilityKeyboard | 0x10, "keyboard|0x10"},
+		{ShmFormatArgb8888, "argb8888=0"},
+	} {
+		if got := tt.e.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
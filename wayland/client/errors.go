@@ -0,0 +1,90 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// VersionError is returned by requests that are not available
+// in the version the proxy was bound with.
+type VersionError struct {
+	Interface string
+	Request   string
+	Since     uint32
+	Version   uint32
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%s.%s: request needs version %d, proxy has version %d", e.Interface, e.Request, e.Since, e.Version)
+}
+
+// Is makes VersionError match ErrVersionTooLow.
+func (e *VersionError) Is(target error) bool {
+	return target == ErrVersionTooLow
+}
+
+// ErrGlobalNotFound is returned when binding an interface that no
+// announced global implements in a suitable version.
+var ErrGlobalNotFound = errors.New("global not found")
+
+// ErrClosed is returned by requests, Dispatch and Display.Roundtrip
+// once the context has been closed.
+var ErrClosed = errors.New("context closed")
+
+// ErrDisconnected is matched by errors caused by the connection to the
+// compositor failing or being closed by the compositor.
+var ErrDisconnected = errors.New("disconnected")
+
+// ErrNoRuntimeDir is returned by Connect for display names relative to
+// XDG_RUNTIME_DIR when it isn't set.
+var ErrNoRuntimeDir = errors.New("env XDG_RUNTIME_DIR not set")
+
+// ErrUnknownObject is matched by errors of events sent to an object id
+// the client doesn't know about.
+var ErrUnknownObject = errors.New("unknown object")
+
+// ErrVersionTooLow is matched by VersionError.
+var ErrVersionTooLow = errors.New("version too low")
+
+// ProtocolError is the fatal error sent by the compositor through
+// wl_display.error, the connection is unusable afterwards.
+type ProtocolError struct {
+	ObjectID  uint32
+	Interface string
+	Code      uint32
+	Message   string
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("protocol error %d on %s@%d: %s", e.Code, e.Interface, e.ObjectID, e.Message)
+}
+
+// DecodeError is returned by Dispatch for messages which don't match
+// the signature of the event they were sent as.
+type DecodeError struct {
+	Interface string
+	Opcode    uint32
+	Reason    string
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("unable to decode %s event %d: %s", e.Interface, e.Opcode, e.Reason)
+}
+
+// disconnectedError wraps connection failures so that they match
+// ErrDisconnected while keeping the underlying error.
+type disconnectedError struct {
+	err error
+}
+
+func (e *disconnectedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *disconnectedError) Unwrap() error {
+	return e.err
+}
+
+func (e *disconnectedError) Is(target error) bool {
+	return target == ErrDisconnected
+}
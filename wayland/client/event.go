@@ -1,40 +1,20 @@
 package client
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
-	"unsafe"
+	"io"
 
-	"golang.org/x/sys/unix"
-
-	_ "unsafe"
+	"github.com/hempflower/go-wayland/wayland/internal/wire"
 )
 
-var oobSpace = unix.CmsgSpace(4)
-
-func (ctx *Context) ReadMsg() (senderID uint32, opcode uint32, fd int, msg []byte, err error) {
-	fd = -1
-
-	oob := make([]byte, oobSpace)
+// ReadMsg reads the next message from the connection. File descriptors
+// received along with it are queued and handed out in order by Dispatch
+// to the events carrying fd arguments.
+func (ctx *Context) ReadMsg() (senderID uint32, opcode uint32, msg []byte, err error) {
 	header := make([]byte, 8)
-
-	n, oobn, _, _, err := ctx.conn.ReadMsgUnix(header, oob)
-	if err != nil {
-		return senderID, opcode, fd, msg, err
-	}
-	if n != 8 {
-		return senderID, opcode, fd, msg, fmt.Errorf("ctx.ReadMsg: incorrect number of bytes read for header (n=%d)", n)
-	}
-
-	if oobn > 0 {
-		fds, err := getFdsFromOob(oob, oobn, "header")
-		if err != nil {
-			return senderID, opcode, fd, msg, fmt.Errorf("ctx.ReadMsg: %w", err)
-		}
-
-		if len(fds) > 0 {
-			fd = fds[0]
-		}
+	if err := ctx.readFull(header, "header"); err != nil {
+		return senderID, opcode, msg, fmt.Errorf("ctx.ReadMsg: %w", err)
 	}
 
 	senderID = Uint32(header[:4])
@@ -43,78 +23,154 @@ func (ctx *Context) ReadMsg() (senderID uint32, opcode uint32, fd int, msg []byt
 	size := opcodeAndSize >> 16
 
 	msgSize := int(size) - 8
+	if msgSize < 0 {
+		return senderID, opcode, msg, &DecodeError{
+			Interface: ctx.interfaceName(senderID),
+			Opcode:    opcode,
+			Reason:    fmt.Sprintf("incorrect message size (size=%d)", size),
+		}
+	}
 	if msgSize == 0 {
-		return senderID, opcode, fd, nil, nil
+		return senderID, opcode, nil, nil
 	}
 
 	msg = make([]byte, msgSize)
+	if err := ctx.readFull(msg, "msg"); err != nil {
+		return senderID, opcode, msg, fmt.Errorf("ctx.ReadMsg: %w", err)
+	}
+
+	return senderID, opcode, msg, nil
+}
 
-	if fd == -1 {
-		// if something was read before, then zero it out
-		if oobn > 0 {
-			oob = make([]byte, oobSpace)
+// readFull fills b from the data read by ReadEvents, then from the
+// transport, queueing received fds
+func (ctx *Context) readFull(b []byte, source string) error {
+	for read := 0; read < len(b); {
+		if len(ctx.in) > 0 {
+			n := copy(b[read:], ctx.in)
+			ctx.in = ctx.in[n:]
+			read += n
+			continue
 		}
 
-		n, oobn, _, _, err = ctx.conn.ReadMsgUnix(msg, oob)
-	} else {
-		n, err = ctx.conn.Read(msg)
+		n, fds, err := ctx.transport.Read(b[read:])
+		ctx.queueFds(fds)
+		if errors.Is(err, io.EOF) {
+			return &disconnectedError{fmt.Errorf("connection closed while reading %s (n=%d, size=%d)", source, read, len(b))}
+		}
+		if err != nil {
+			return &disconnectedError{err}
+		}
+		read += n
 	}
-	if err != nil {
-		return senderID, opcode, fd, msg, fmt.Errorf("ctx.ReadMsg: %w", err)
+
+	return nil
+}
+
+// Fd returns the file descriptor of the connection, for event loops to
+// poll it, or -1 if the transport isn't a PollableTransport. It must
+// only be used to wait for the connection to be readable, with
+// ReadEvents.
+func (ctx *Context) Fd() int {
+	if t, ok := ctx.transport.(PollableTransport); ok {
+		return t.Fd()
+	}
+	return -1
+}
+
+// ReadEvents reads the data available on the connection without
+// blocking. It is the read step of the cycle of event loops, which
+// dispatch the events already read with DispatchPending, wait for Fd to
+// be readable, then call ReadEvents:
+//
+//	for {
+//		if err := ctx.DispatchPending(); err != nil {
+//			return err
+//		}
+//		// wait for ctx.Fd() to be readable
+//		if err := ctx.ReadEvents(); err != nil {
+//			return err
+//		}
+//	}
+//
+// Dispatch uses the data read by ReadEvents before reading from the
+// connection, both can be mixed. The transport must be a
+// PollableTransport.
+func (ctx *Context) ReadEvents() error {
+	if ctx.closed.Load() {
+		return ErrClosed
 	}
-	if n != msgSize {
-		return senderID, opcode, fd, msg, fmt.Errorf("ctx.ReadMsg: incorrect number of bytes read for msg (n=%d, msgSize=%d)", n, msgSize)
+	t, ok := ctx.transport.(PollableTransport)
+	if !ok {
+		return ErrNotPollable
 	}
 
-	if fd == -1 && oobn > 0 {
-		fds, err := getFdsFromOob(oob, oobn, "msg")
+	buf := make([]byte, 4096)
+	for read := 0; ; {
+		n, fds, err := t.ReadNonblock(buf)
+		ctx.queueFds(fds)
+		if err != nil && read > 0 {
+			// Dispatch what was read first, e.g. the protocol error
+			// preceding the disconnection, the error is sticky
+			return nil
+		}
+		if errors.Is(err, io.EOF) {
+			return &disconnectedError{errors.New("connection closed while reading events")}
+		}
 		if err != nil {
-			return senderID, opcode, fd, msg, fmt.Errorf("ctx.ReadMsg: %w", err)
+			if ctx.closed.Load() {
+				return ErrClosed
+			}
+			return &disconnectedError{err}
 		}
-
-		if len(fds) > 0 {
-			fd = fds[0]
+		if n == 0 {
+			return nil
 		}
-	}
 
-	return senderID, opcode, fd, msg, nil
+		ctx.in = append(ctx.in, buf[:n]...)
+		read += n
+	}
 }
 
-func getFdsFromOob(oob []byte, oobn int, source string) ([]int, error) {
-	if oobn > len(oob) {
-		return nil, fmt.Errorf("getFdsFromOob: incorrect number of bytes read from %s for oob (oobn=%d)", source, oobn)
-	}
-	scms, err := unix.ParseSocketControlMessage(oob)
-	if err != nil {
-		return nil, fmt.Errorf("getFdsFromOob: unable to parse control message from %s: %w", source, err)
+// Pending reports whether a complete event read by ReadEvents is waiting
+// to be dispatched.
+func (ctx *Context) Pending() bool {
+	if len(ctx.in) < 8 {
+		return false
 	}
+	// Invalid sizes are reported by Dispatch
+	size := Uint32(ctx.in[4:8]) >> 16
+	return int(size) <= len(ctx.in)
+}
 
-	var fdsRet []int
-	for _, scm := range scms {
-		fds, err := unix.ParseUnixRights(&scm)
-		if err != nil {
-			return nil, fmt.Errorf("getFdsFromOob: unable to parse unix rights from %s: %w", source, err)
+// DispatchPending dispatches the events read by ReadEvents, it doesn't
+// block.
+func (ctx *Context) DispatchPending() error {
+	for ctx.Pending() {
+		if err := ctx.Dispatch(); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		fdsRet = append(fdsRet, fds...)
+func getFdsFromOob(oob []byte, oobn int, source string) ([]int, error) {
+	fds, err := wire.ParseFds(oob, oobn, source)
+	if err != nil {
+		return nil, fmt.Errorf("getFdsFromOob: %w", err)
 	}
 
-	return fdsRet, nil
+	return fds, nil
 }
 
 func Uint32(src []byte) uint32 {
-	_ = src[3]
-	return *(*uint32)(unsafe.Pointer(&src[0]))
+	return wire.Uint32(src)
 }
 
 func String(src []byte) string {
-	idx := bytes.IndexByte(src, 0)
-	src = src[:idx:idx]
-	return *(*string)(unsafe.Pointer(&src))
+	return wire.String(src)
 }
 
 func Fixed(src []byte) float64 {
-	_ = src[3]
-	fx := *(*int32)(unsafe.Pointer(&src[0]))
-	return fixedToFloat64(fx)
+	return wire.Fixed(src)
 }
@@ -0,0 +1,240 @@
+package client
+
+import "fmt"
+
+// Global is a global object announced by the compositor.
+type Global struct {
+	Name      uint32
+	Interface string
+	Version   uint32
+}
+
+// GlobalsEvent is passed to subscribers of Globals when a global
+// is announced or removed.
+type GlobalsEvent struct {
+	Global  Global
+	Removed bool
+}
+
+type GlobalsHandlerFunc func(GlobalsEvent)
+
+type globalsHandler struct {
+	id int
+	f  GlobalsHandlerFunc
+}
+
+// Globals keeps track of the globals announced on a registry and
+// of the proxies bound to them.
+type Globals struct {
+	registry *Registry
+
+	// globals in order of announcement
+	globals []Global
+	bound   map[uint32][]Proxy
+
+	// handlers in order of subscription
+	handlers        []globalsHandler
+	nextHandler     int
+	destroyOnRemove bool
+}
+
+// NewGlobals creates a registry on display and performs a roundtrip,
+// so that all globals present at the time of the call are known
+// when it returns.
+func NewGlobals(display *Display) (*Globals, error) {
+	registry, err := display.GetRegistry()
+	if err != nil {
+		return nil, fmt.Errorf("client.NewGlobals: unable to get registry: %w", err)
+	}
+
+	g := &Globals{
+		registry: registry,
+		bound:    map[uint32][]Proxy{},
+	}
+	registry.SetGlobalHandler(g.handleGlobal)
+	registry.SetGlobalRemoveHandler(g.handleGlobalRemove)
+
+	if err := display.Roundtrip(); err != nil {
+		return nil, fmt.Errorf("client.NewGlobals: roundtrip failed: %w", err)
+	}
+
+	return g, nil
+}
+
+// Registry returns the registry the globals are tracked on.
+func (g *Globals) Registry() *Registry {
+	return g.registry
+}
+
+// SetDestroyOnRemove sets whether proxies bound through g are destroyed
+// when their global is removed, with their destructor request if the
+// bound version has one. Subscribers are notified before the proxies
+// are destroyed.
+func (g *Globals) SetDestroyOnRemove(destroy bool) {
+	g.destroyOnRemove = destroy
+}
+
+// Subscribe registers f to be called for every global announced or
+// removed from now on, the returned function unregisters it. Subscribers
+// are called in order of subscription.
+func (g *Globals) Subscribe(f GlobalsHandlerFunc) (unsubscribe func()) {
+	id := g.nextHandler
+	g.nextHandler++
+	g.handlers = append(g.handlers, globalsHandler{id: id, f: f})
+
+	return func() {
+		for idx, h := range g.handlers {
+			if h.id == id {
+				g.handlers = append(g.handlers[:idx], g.handlers[idx+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// List returns all current globals in order of announcement.
+func (g *Globals) List() []Global {
+	return append([]Global(nil), g.globals...)
+}
+
+// Lookup returns all current globals implementing iface.
+func (g *Globals) Lookup(iface string) []Global {
+	var globals []Global
+	for _, global := range g.globals {
+		if global.Interface == iface {
+			globals = append(globals, global)
+		}
+	}
+	return globals
+}
+
+// Bind binds global with the highest version supported by the compositor,
+// the generated code and max. The proxy is created through the interface
+// registry, so the package declaring the interface must be imported.
+//
+// max of 0 means no limit other than the generated code.
+func (g *Globals) Bind(global Global, min, max uint32) (Proxy, error) {
+	iface := LookupInterface(global.Interface)
+	if iface == nil {
+		return nil, fmt.Errorf("client.Globals.Bind: interface %s is not registered", global.Interface)
+	}
+
+	return g.bind(iface, global, min, max)
+}
+
+func (g *Globals) bind(iface *Interface, global Global, min, max uint32) (Proxy, error) {
+	version := global.Version
+	if version > iface.Version {
+		version = iface.Version
+	}
+	if max != 0 && version > max {
+		version = max
+	}
+	if version < min {
+		return nil, fmt.Errorf("client.Globals.Bind: %s version %d is lower than %d: %w", global.Interface, version, min, ErrGlobalNotFound)
+	}
+
+	p := iface.New(g.registry.Context())
+	if err := g.registry.Bind(global.Name, global.Interface, version, p); err != nil {
+		g.registry.Context().forget(p)
+		return nil, err
+	}
+	g.bound[global.Name] = append(g.bound[global.Name], p)
+
+	return p, nil
+}
+
+// BindGlobal binds the first announced global implementing the interface
+// of T, see Globals.Bind for version selection.
+//
+//	compositor, err := client.BindGlobal[*client.Compositor](globals, 4, 0)
+func BindGlobal[T interface {
+	Proxy
+	Interface() *Interface
+}](g *Globals, min, max uint32) (T, error) {
+	var zero T
+	iface := zero.Interface()
+
+	for _, global := range g.globals {
+		if global.Interface != iface.Name || global.Version < min {
+			continue
+		}
+
+		p, err := g.bind(iface, global, min, max)
+		if err != nil {
+			return zero, err
+		}
+		return p.(T), nil
+	}
+
+	return zero, fmt.Errorf("client.BindGlobal: %s version >= %d: %w", iface.Name, min, ErrGlobalNotFound)
+}
+
+func (g *Globals) handleGlobal(e RegistryGlobalEvent) {
+	global := Global{
+		Name:      e.Name,
+		Interface: e.Interface,
+		Version:   e.Version,
+	}
+	g.globals = append(g.globals, global)
+
+	g.notify(GlobalsEvent{Global: global})
+}
+
+func (g *Globals) handleGlobalRemove(e RegistryGlobalRemoveEvent) {
+	for idx, global := range g.globals {
+		if global.Name != e.Name {
+			continue
+		}
+
+		g.globals = append(g.globals[:idx], g.globals[idx+1:]...)
+		g.notify(GlobalsEvent{Global: global, Removed: true})
+
+		bound := g.bound[e.Name]
+		delete(g.bound, e.Name)
+		if g.destroyOnRemove {
+			for _, p := range bound {
+				// Skip proxies the application destroyed already, their
+				// id may even be reused by another object
+				if p.Context().GetProxy(p.ID()) != p {
+					continue
+				}
+				destroyProxy(p)
+			}
+		}
+		return
+	}
+}
+
+// destroyProxy sends the destructor request of p if the version it was
+// bound with has one, e.g. wl_output.release since version 3, otherwise
+// p is only forgotten by its context. Without destructor the compositor
+// never sends wl_display.delete_id, so p isn't kept as a zombie.
+func destroyProxy(p Proxy) {
+	var destructor string
+	if ip, ok := p.(interface{ Interface() *Interface }); ok {
+		for _, req := range ip.Interface().Requests {
+			if (req.Name == "destroy" || req.Name == "release") && len(req.Args) == 0 && req.Since <= p.Version() {
+				destructor = req.Name
+				break
+			}
+		}
+	}
+
+	if d, ok := p.(interface{ Destroy() error }); ok && destructor == "destroy" {
+		_ = d.Destroy()
+		return
+	}
+	if d, ok := p.(interface{ Release() error }); ok && destructor == "release" {
+		_ = d.Release()
+		return
+	}
+	p.Context().forget(p)
+}
+
+func (g *Globals) notify(e GlobalsEvent) {
+	// Subscribers may unsubscribe while being notified
+	for _, h := range append([]globalsHandler(nil), g.handlers...) {
+		h.f(e)
+	}
+}
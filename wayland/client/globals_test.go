@@ -0,0 +1,249 @@
+package client
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+	"golang.org/x/sys/unix"
+)
+
+type outputHandler struct {
+	released chan uint32
+}
+
+func (h outputHandler) Release(r *server.Output) {
+	h.released <- r.Version()
+}
+
+// connectServer connects a context to s over a socketpair
+func connectServer(t *testing.T, s *server.Server) *Display {
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conns := make([]*net.UnixConn, 2)
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "socketpair")
+		c, err := net.FileConn(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = c.(*net.UnixConn)
+	}
+
+	s.CreateClient(conns[0])
+	return ConnectTransport(NewUnixTransport(conns[1]))
+}
+
+// TestGlobalsDestroyOnRemove removes wl_output globals bound with and
+// without the release request.
+func TestGlobalsDestroyOnRemove(t *testing.T) {
+	s := server.New()
+	defer s.Close()
+
+	h := outputHandler{released: make(chan uint32, 2)}
+	bind := func(r server.Resource) {
+		r.(*server.Output).SetHandler(h)
+	}
+	v2, err := s.CreateGlobal(server.OutputInterface, 2, bind)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v4, err := s.CreateGlobal(server.OutputInterface, 4, bind)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	display := connectServer(t, s)
+	ctx := display.Context()
+	defer ctx.Close()
+
+	g, err := NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.SetDestroyOnRemove(true)
+
+	var outputs []Proxy
+	for _, global := range g.Lookup(OutputName) {
+		p, err := g.Bind(global, 1, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		outputs = append(outputs, p)
+	}
+	if len(outputs) != 2 || outputs[0].Version() != 2 || outputs[1].Version() != 4 {
+		t.Fatalf("bound %d outputs, want versions 2 and 4", len(outputs))
+	}
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	v2.Remove()
+	v4.Remove()
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range outputs {
+		if ctx.GetProxy(p.ID()) != nil {
+			t.Errorf("wl_output version %d still registered", p.Version())
+		}
+	}
+	// Without release request the compositor never deletes the id
+	ctx.mu.Lock()
+	if _, ok := ctx.zombies[outputs[0].ID()]; ok {
+		t.Errorf("wl_output version 2 left as a zombie")
+	}
+	ctx.mu.Unlock()
+	if len(g.Lookup(OutputName)) != 0 {
+		t.Errorf("removed globals still listed")
+	}
+
+	// Only the version 4 output is released, version 2 has no release
+	// request
+	select {
+	case v := <-h.released:
+		if v != 4 {
+			t.Errorf("wl_output version %d released", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("wl_output version 4 not released")
+	}
+	select {
+	case v := <-h.released:
+		t.Errorf("wl_output version %d released", v)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestGlobalsRemoveReleased removes a wl_output global the application
+// already released, the release request mustn't be sent again on the
+// dead id.
+func TestGlobalsRemoveReleased(t *testing.T) {
+	s := server.New()
+	defer s.Close()
+
+	h := outputHandler{released: make(chan uint32, 2)}
+	global, err := s.CreateGlobal(server.OutputInterface, 4, func(r server.Resource) {
+		r.(*server.Output).SetHandler(h)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	display := connectServer(t, s)
+	ctx := display.Context()
+	defer ctx.Close()
+
+	g, err := NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.SetDestroyOnRemove(true)
+
+	output, err := BindGlobal[*Output](g, 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := output.Release(); err != nil {
+		t.Fatal(err)
+	}
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	global.Remove()
+	// A second release would be a request to an invalid object, fatal
+	// to the connection
+	for i := 0; i < 2; i++ {
+		if err := display.Roundtrip(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case <-h.released:
+	case <-time.After(time.Second):
+		t.Fatal("wl_output not released")
+	}
+}
+
+// TestGlobalsSubscribeOrder checks subscribers are notified in order of
+// subscription.
+func TestGlobalsSubscribeOrder(t *testing.T) {
+	s := server.New()
+	defer s.Close()
+
+	display := connectServer(t, s)
+	defer display.Context().Close()
+
+	g, err := NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var order []int
+	var unsubscribe []func()
+	for i := 0; i < 8; i++ {
+		i := i
+		unsubscribe = append(unsubscribe, g.Subscribe(func(GlobalsEvent) {
+			order = append(order, i)
+		}))
+	}
+	unsubscribe[3]()
+
+	if _, err := s.CreateGlobal(server.OutputInterface, 4, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []int{0, 1, 2, 4, 5, 6, 7}
+	if fmt.Sprint(order) != fmt.Sprint(want) {
+		t.Errorf("subscribers called in order %v, want %v", order, want)
+	}
+}
+
+// TestGlobalsBindFailure binds a global once the compositor is gone, the
+// proxy created for it mustn't stay registered.
+func TestGlobalsBindFailure(t *testing.T) {
+	s := server.New()
+	defer s.Close()
+
+	if _, err := s.CreateGlobal(server.OutputInterface, 4, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	display := connectServer(t, s)
+	ctx := display.Context()
+	defer ctx.Close()
+
+	g, err := NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.Close()
+	if _, err := BindGlobal[*Output](g, 1, 0); err == nil {
+		t.Fatal("bind succeeded without a compositor")
+	}
+
+	// The id of the output is the last one allocated
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	id := ctx.currentID
+	if _, ok := ctx.objects[id]; ok {
+		t.Errorf("wl_output@%d still registered", id)
+	}
+	if _, ok := ctx.zombies[id]; ok {
+		t.Errorf("wl_output@%d left as a zombie", id)
+	}
+}
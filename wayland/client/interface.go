@@ -0,0 +1,136 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Interface describes a protocol interface as declared in
+// the protocol XML, it is generated alongside every proxy type.
+type Interface struct {
+	Name    string
+	Version uint32
+	// New creates a proxy of the generated type for this interface.
+	New      func(ctx *Context) Proxy
+	Requests []Message
+	Events   []Message
+}
+
+// Message describes a request or an event of an interface.
+// Opcodes are the indexes into Interface.Requests and
+// Interface.Events.
+type Message struct {
+	Name  string
+	Since uint32
+	Args  []Arg
+}
+
+// Arg describes an argument of a message.
+type Arg struct {
+	Name string
+	Type ArgType
+	// Interface of object and new_id arguments, empty if
+	// the argument accepts any interface.
+	Interface string
+	AllowNull bool
+}
+
+type ArgType uint8
+
+const (
+	ArgTypeInt ArgType = iota
+	ArgTypeUint
+	ArgTypeFixed
+	ArgTypeString
+	ArgTypeObject
+	ArgTypeNewID
+	ArgTypeArray
+	ArgTypeFd
+)
+
+var argTypeNames = [...]string{
+	ArgTypeInt:    "int",
+	ArgTypeUint:   "uint",
+	ArgTypeFixed:  "fixed",
+	ArgTypeString: "string",
+	ArgTypeObject: "object",
+	ArgTypeNewID:  "new_id",
+	ArgTypeArray:  "array",
+	ArgTypeFd:     "fd",
+}
+
+var argTypeCodes = [...]byte{
+	ArgTypeInt:    'i',
+	ArgTypeUint:   'u',
+	ArgTypeFixed:  'f',
+	ArgTypeString: 's',
+	ArgTypeObject: 'o',
+	ArgTypeNewID:  'n',
+	ArgTypeArray:  'a',
+	ArgTypeFd:     'h',
+}
+
+// String returns the name of the type as used in protocol XML.
+func (t ArgType) String() string {
+	if int(t) < len(argTypeNames) {
+		return argTypeNames[t]
+	}
+	return fmt.Sprintf("ArgType(%d)", t)
+}
+
+// Signature returns the message signature in libwayland notation,
+// e.g. "?oii" for wl_surface.attach.
+func (m *Message) Signature() string {
+	sb := strings.Builder{}
+	for _, arg := range m.Args {
+		if arg.AllowNull {
+			sb.WriteByte('?')
+		}
+		if arg.Type == ArgTypeNewID && arg.Interface == "" {
+			// Untyped new_id is sent as interface name, version and id
+			sb.WriteString("su")
+		}
+		sb.WriteByte(argTypeCodes[arg.Type])
+	}
+	return sb.String()
+}
+
+// Request returns the request with the given opcode, or nil.
+func (i *Interface) Request(opcode uint32) *Message {
+	if int(opcode) < len(i.Requests) {
+		return &i.Requests[opcode]
+	}
+	return nil
+}
+
+// Event returns the event with the given opcode, or nil.
+func (i *Interface) Event(opcode uint32) *Message {
+	if int(opcode) < len(i.Events) {
+		return &i.Events[opcode]
+	}
+	return nil
+}
+
+var (
+	interfacesMu sync.RWMutex
+	interfaces   = map[string]*Interface{}
+)
+
+// RegisterInterface makes iface available to LookupInterface,
+// generated packages register all of their interfaces on init.
+func RegisterInterface(iface *Interface) {
+	interfacesMu.Lock()
+	defer interfacesMu.Unlock()
+
+	interfaces[iface.Name] = iface
+}
+
+// LookupInterface returns the registered interface with the given
+// name, or nil if no imported package declares it.
+func LookupInterface(name string) *Interface {
+	interfacesMu.RLock()
+	defer interfacesMu.RUnlock()
+
+	return interfaces[name]
+}
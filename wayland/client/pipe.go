@@ -0,0 +1,207 @@
+package client
+
+import (
+	"io"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Pipe returns the two ends of an in-memory connection, e.g. for a
+// context talking to a mock compositor in tests. Fds written to one end
+// are duplicated and received with the data they were written with on
+// the other end, as with a Unix socket. Both ends are
+// PollableTransports.
+func Pipe() (Transport, Transport) {
+	a, b := newPipeBuffer(), newPipeBuffer()
+	return &pipeEnd{r: a, w: b}, &pipeEnd{r: b, w: a}
+}
+
+// pipeEnd reads from r and writes to the r of the other end
+type pipeEnd struct {
+	r, w *pipeBuffer
+}
+
+func (p *pipeEnd) Read(b []byte) (int, []int, error) {
+	return p.r.read(b, true)
+}
+
+func (p *pipeEnd) ReadNonblock(b []byte) (int, []int, error) {
+	return p.r.read(b, false)
+}
+
+func (p *pipeEnd) Write(b []byte, fds []int) error {
+	return p.w.write(b, fds)
+}
+
+func (p *pipeEnd) Close() error {
+	p.w.closeWriter()
+	return p.r.closeReader()
+}
+
+func (p *pipeEnd) Fd() int {
+	return p.r.fd()
+}
+
+// pipeChunk is the data of a write, fds are received with its first
+// byte
+type pipeChunk struct {
+	data []byte
+	fds  []int
+}
+
+// pipeBuffer is one direction of a pipe
+type pipeBuffer struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	chunks []pipeChunk
+	// eof is set once the writer closed its end, closed once the reader
+	// did
+	eof    bool
+	closed bool
+
+	// efd is an eventfd, created by fd, signaled while a read wouldn't
+	// block
+	efd      int
+	signaled bool
+}
+
+func newPipeBuffer() *pipeBuffer {
+	b := &pipeBuffer{efd: -1}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+func (b *pipeBuffer) read(p []byte, block bool) (int, []int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	defer b.update()
+
+	for len(b.chunks) == 0 && !b.eof && !b.closed {
+		if !block {
+			return 0, nil, nil
+		}
+		b.cond.Wait()
+	}
+	if b.closed {
+		return 0, nil, io.ErrClosedPipe
+	}
+	if len(b.chunks) == 0 {
+		return 0, nil, io.EOF
+	}
+
+	c := &b.chunks[0]
+	n := copy(p, c.data)
+	c.data = c.data[n:]
+	fds := c.fds
+	c.fds = nil
+	if len(c.data) == 0 {
+		b.chunks = b.chunks[1:]
+	}
+
+	return n, fds, nil
+}
+
+func (b *pipeBuffer) write(p []byte, fds []int) error {
+	var dups []int
+	for _, fd := range fds {
+		dup, err := unix.FcntlInt(uintptr(fd), unix.F_DUPFD_CLOEXEC, 0)
+		if err != nil {
+			closeAll(dups)
+			return err
+		}
+		dups = append(dups, dup)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.eof || b.closed {
+		closeAll(dups)
+		return io.ErrClosedPipe
+	}
+	if len(p) > 0 || len(dups) > 0 {
+		b.chunks = append(b.chunks, pipeChunk{data: append([]byte(nil), p...), fds: dups})
+	}
+	b.cond.Broadcast()
+	b.update()
+
+	return nil
+}
+
+func (b *pipeBuffer) closeWriter() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.eof = true
+	b.cond.Broadcast()
+	b.update()
+}
+
+func (b *pipeBuffer) closeReader() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return io.ErrClosedPipe
+	}
+	b.closed = true
+	for _, c := range b.chunks {
+		closeAll(c.fds)
+	}
+	b.chunks = nil
+	b.cond.Broadcast()
+
+	if b.efd >= 0 {
+		unix.Close(b.efd)
+		b.efd = -1
+	}
+	return nil
+}
+
+func (b *pipeBuffer) fd() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.efd < 0 && !b.closed {
+		efd, err := unix.Eventfd(0, unix.EFD_NONBLOCK|unix.EFD_CLOEXEC)
+		if err != nil {
+			return -1
+		}
+		b.efd = efd
+		b.signaled = false
+		b.update()
+	}
+	return b.efd
+}
+
+// update signals the eventfd while there is data or the writer closed
+// its end, b.mu must be held
+func (b *pipeBuffer) update() {
+	if b.efd < 0 {
+		return
+	}
+
+	ready := len(b.chunks) > 0 || b.eof
+	if ready == b.signaled {
+		return
+	}
+	// The counter is a uint64 in host byte order
+	var v uint64
+	buf := (*[8]byte)(unsafe.Pointer(&v))[:]
+	if ready {
+		v = 1
+		unix.Write(b.efd, buf)
+	} else {
+		unix.Read(b.efd, buf)
+	}
+	b.signaled = ready
+}
+
+func closeAll(fds []int) {
+	for _, fd := range fds {
+		unix.Close(fd)
+	}
+}
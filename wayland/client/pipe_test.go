@@ -0,0 +1,184 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// readable reports whether fd is readable, without waiting
+func readable(t *testing.T, fd int) bool {
+	t.Helper()
+
+	pfd := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+	n, err := unix.Poll(pfd, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n > 0
+}
+
+func TestPipeBlockingRead(t *testing.T) {
+	a, b := Pipe()
+	defer a.Close()
+	defer b.Close()
+
+	type result struct {
+		data []byte
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		buf := make([]byte, 16)
+		n, _, err := b.Read(buf)
+		done <- result{buf[:n], err}
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("read returned before a write")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := a.Write([]byte("hello"), nil); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case r := <-done:
+		if r.err != nil || string(r.data) != "hello" {
+			t.Errorf("got %q, %v, want \"hello\"", r.data, r.err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read still blocked after a write")
+	}
+}
+
+func TestPipeClose(t *testing.T) {
+	a, b := Pipe()
+	defer b.Close()
+
+	if err := a.Write([]byte("bye"), nil); err != nil {
+		t.Fatal(err)
+	}
+	a.Close()
+
+	// Data written before the close is still read, then EOF
+	buf := make([]byte, 16)
+	if n, _, err := b.Read(buf); err != nil || string(buf[:n]) != "bye" {
+		t.Errorf("got %q, %v, want \"bye\"", buf[:n], err)
+	}
+	if _, _, err := b.Read(buf); err != io.EOF {
+		t.Errorf("got error %v after close, want io.EOF", err)
+	}
+	if err := b.Write([]byte("late"), nil); !errors.Is(err, io.ErrClosedPipe) {
+		t.Errorf("got error %v writing to a closed end, want io.ErrClosedPipe", err)
+	}
+	if err := a.Close(); !errors.Is(err, io.ErrClosedPipe) {
+		t.Errorf("got error %v closing twice, want io.ErrClosedPipe", err)
+	}
+}
+
+func TestPipeCloseUnblocksRead(t *testing.T) {
+	a, b := Pipe()
+	defer a.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		_, _, err := b.Read(make([]byte, 16))
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	b.Close()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, io.ErrClosedPipe) {
+			t.Errorf("got error %v, want io.ErrClosedPipe", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read still blocked after close")
+	}
+}
+
+func TestPipeFds(t *testing.T) {
+	a, b := Pipe()
+	defer a.Close()
+	defer b.Close()
+
+	fd, err := unix.MemfdCreate("pipe-test", unix.MFD_CLOEXEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	if err := a.Write([]byte("first"), []int{fd}); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Write([]byte("second"), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The fds come with the first byte of their write only
+	buf := make([]byte, 2)
+	n, fds, err := b.Read(buf)
+	if err != nil || string(buf[:n]) != "fi" || len(fds) != 1 {
+		t.Fatalf("got %q with %d fds, %v, want \"fi\" with 1 fd", buf[:n], len(fds), err)
+	}
+	defer unix.Close(fds[0])
+	buf = make([]byte, 16)
+	if n, fds, err := b.Read(buf); err != nil || string(buf[:n]) != "rst" || len(fds) != 0 {
+		t.Errorf("got %q with %d fds, %v, want \"rst\" without fds", buf[:n], len(fds), err)
+	}
+
+	// The fd is a duplicate, the writer keeps its own
+	if fds[0] == fd {
+		t.Error("received the fd of the writer")
+	}
+	var want, got unix.Stat_t
+	unix.Fstat(fd, &want)
+	unix.Fstat(fds[0], &got)
+	if got.Dev != want.Dev || got.Ino != want.Ino {
+		t.Error("received fd isn't the one written")
+	}
+}
+
+func TestPipeNonblock(t *testing.T) {
+	a, b := Pipe()
+	defer a.Close()
+	defer b.Close()
+
+	p := b.(PollableTransport)
+	fd := p.Fd()
+	if fd < 0 {
+		t.Fatal("no fd to poll")
+	}
+
+	buf := make([]byte, 16)
+	if n, _, err := p.ReadNonblock(buf); n != 0 || err != nil {
+		t.Errorf("got %d bytes, %v from an empty pipe, want 0, nil", n, err)
+	}
+	if readable(t, fd) {
+		t.Error("fd readable without data")
+	}
+
+	if err := a.Write([]byte("data"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if !readable(t, fd) {
+		t.Error("fd not readable with data")
+	}
+	if n, _, err := p.ReadNonblock(buf); err != nil || string(buf[:n]) != "data" {
+		t.Errorf("got %q, %v, want \"data\"", buf[:n], err)
+	}
+	if readable(t, fd) {
+		t.Error("fd still readable once the data is read")
+	}
+
+	a.Close()
+	if !readable(t, fd) {
+		t.Error("fd not readable at EOF")
+	}
+}
@@ -2,38 +2,47 @@ package client
 
 import (
 	"fmt"
-	"unsafe"
+
+	"github.com/hempflower/go-wayland/wayland/internal/wire"
 )
 
 func (ctx *Context) WriteMsg(b []byte, oob []byte) error {
-	n, oobn, err := ctx.conn.WriteMsgUnix(b, oob, nil)
-	if err != nil {
-		return err
+	if ctx.closed.Load() {
+		return ErrClosed
+	}
+
+	// Generated requests pass their fds as SCM_RIGHTS control
+	// messages, decoded for the transport
+	var fds []int
+	if len(oob) > 0 {
+		var err error
+		if fds, err = wire.ParseFds(oob, len(oob), "request"); err != nil {
+			return fmt.Errorf("ctx.WriteMsg: %w", err)
+		}
 	}
-	if n != len(b) || oobn != len(oob) {
-		return fmt.Errorf("ctx.WriteMsg: incorrect number of bytes written (n=%d oobn=%d)", n, oobn)
+
+	if err := ctx.transport.Write(b, fds); err != nil {
+		if ctx.closed.Load() {
+			return ErrClosed
+		}
+		return &disconnectedError{fmt.Errorf("ctx.WriteMsg: %w", err)}
 	}
 
 	return nil
 }
 
 func PutUint32(dst []byte, v uint32) {
-	_ = dst[3]
-	*(*uint32)(unsafe.Pointer(&dst[0])) = v
+	wire.PutUint32(dst, v)
 }
 
 func PutFixed(dst []byte, f float64) {
-	fx := fixedFromfloat64(f)
-	_ = dst[3]
-	*(*int32)(unsafe.Pointer(&dst[0])) = fx
+	wire.PutFixed(dst, f)
 }
 
 func PutString(dst []byte, v string, l int) {
-	PutUint32(dst[:4], uint32(l))
-	copy(dst[4:], []byte(v))
+	wire.PutString(dst, v, l)
 }
 
 func PutArray(dst []byte, a []byte) {
-	PutUint32(dst[:4], uint32(len(a)))
-	copy(dst[4:], a)
+	wire.PutArray(dst, a)
 }
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/hempflower/go-wayland/wayland/internal/wire"
+	"golang.org/x/sys/unix"
+)
+
+// Transport carries the bytes of a connection along with the file
+// descriptors passed with them. A Context reads and writes its messages
+// through a Transport, a Unix socket by default, see ConnectTransport.
+type Transport interface {
+	// Read reads up to len(b) bytes, blocking until some are available,
+	// and returns the fds received with them, owned by the caller. It
+	// returns io.EOF once the peer closed the connection.
+	Read(b []byte) (n int, fds []int, err error)
+	// Write writes b along with fds, which remain owned by the caller.
+	Write(b []byte, fds []int) error
+	// Close closes the connection, unblocking pending reads.
+	Close() error
+}
+
+// PollableTransport is a Transport whose reads can be waited on by an
+// event loop, Context.Fd and Context.ReadEvents need it.
+type PollableTransport interface {
+	Transport
+	// Fd returns a file descriptor which is readable while data is
+	// available.
+	Fd() int
+	// ReadNonblock reads like Read, without blocking: it returns 0 bytes
+	// and no error if no data is available.
+	ReadNonblock(b []byte) (n int, fds []int, err error)
+}
+
+// ErrNotPollable is returned by Context.ReadEvents when the transport
+// of the context isn't a PollableTransport.
+var ErrNotPollable = errors.New("transport can't be polled")
+
+// UnixTransport is the Transport of connections to a compositor over a
+// Unix socket, fds are passed as SCM_RIGHTS control messages.
+type UnixTransport struct {
+	conn *net.UnixConn
+}
+
+// NewUnixTransport returns a transport using conn.
+func NewUnixTransport(conn *net.UnixConn) *UnixTransport {
+	return &UnixTransport{conn: conn}
+}
+
+// Conn returns the socket of the transport.
+func (t *UnixTransport) Conn() *net.UnixConn {
+	return t.conn
+}
+
+func (t *UnixTransport) Read(b []byte) (int, []int, error) {
+	oob := make([]byte, wire.OobSpace)
+	n, oobn, _, _, err := t.conn.ReadMsgUnix(b, oob)
+	if err != nil {
+		return 0, nil, err
+	}
+	if n == 0 && len(b) > 0 {
+		return 0, nil, io.EOF
+	}
+
+	return t.fds(n, oob, oobn)
+}
+
+func (t *UnixTransport) Write(b []byte, fds []int) error {
+	var oob []byte
+	if len(fds) > 0 {
+		oob = unix.UnixRights(fds...)
+	}
+
+	n, oobn, err := t.conn.WriteMsgUnix(b, oob, nil)
+	if err != nil {
+		return err
+	}
+	if n != len(b) || oobn != len(oob) {
+		return fmt.Errorf("incorrect number of bytes written (n=%d oobn=%d)", n, oobn)
+	}
+
+	return nil
+}
+
+func (t *UnixTransport) Close() error {
+	return t.conn.Close()
+}
+
+func (t *UnixTransport) Fd() int {
+	fd := -1
+	if rc, err := t.conn.SyscallConn(); err == nil {
+		rc.Control(func(s uintptr) {
+			fd = int(s)
+		})
+	}
+	return fd
+}
+
+func (t *UnixTransport) ReadNonblock(b []byte) (int, []int, error) {
+	rc, err := t.conn.SyscallConn()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	oob := make([]byte, wire.OobSpace)
+	var n, oobn int
+	var rerr error
+	err = rc.Read(func(fd uintptr) bool {
+		n, oobn, _, _, rerr = unix.Recvmsg(int(fd), b, oob, unix.MSG_DONTWAIT|unix.MSG_CMSG_CLOEXEC)
+		return true
+	})
+	if err == nil {
+		err = rerr
+	}
+	if errors.Is(err, unix.EAGAIN) {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	if n == 0 && len(b) > 0 {
+		return 0, nil, io.EOF
+	}
+
+	return t.fds(n, oob, oobn)
+}
+
+func (t *UnixTransport) fds(n int, oob []byte, oobn int) (int, []int, error) {
+	if oobn == 0 {
+		return n, nil, nil
+	}
+
+	fds, err := getFdsFromOob(oob, oobn, "transport")
+	if err != nil {
+		return 0, nil, err
+	}
+	return n, fds, nil
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestRequestSince sends a request introduced after the version of the
+// proxy, it must fail without reaching the compositor.
+func TestRequestSince(t *testing.T) {
+	c, compositor := Pipe()
+	defer compositor.Close()
+	display := ConnectTransport(c)
+	defer display.Context().Close()
+
+	wlCompositor := NewCompositor(display.Context())
+	wlCompositor.SetVersion(2)
+	surface, err := wlCompositor.CreateSurface()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// wl_surface.set_buffer_scale is available since version 3
+	err = surface.SetBufferScale(2)
+	var versionErr *VersionError
+	if !errors.As(err, &versionErr) || !errors.Is(err, ErrVersionTooLow) {
+		t.Fatalf("got error %v, want a VersionError", err)
+	}
+	want := VersionError{
+		Interface: SurfaceName,
+		Request:   "set_buffer_scale",
+		Since:     SurfaceSetBufferScaleSinceVersion,
+		Version:   2,
+	}
+	if *versionErr != want {
+		t.Errorf("got %+v, want %+v", *versionErr, want)
+	}
+
+	// Only wl_compositor.create_surface was sent
+	b := make([]byte, 64)
+	n, _, err := compositor.(PollableTransport).ReadNonblock(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 12 || Uint32(b[0:4]) != wlCompositor.ID() {
+		t.Errorf("compositor received %d bytes, want wl_compositor.create_surface only", n)
+	}
+	if n, _, _ := compositor.(PollableTransport).ReadNonblock(b); n != 0 {
+		t.Errorf("compositor received %d more bytes, want none", n)
+	}
+
+	// Requests up to the version of the proxy go through
+	if err := surface.Damage(0, 0, 1, 1); err != nil {
+		t.Errorf("wl_surface.damage: %v", err)
+	}
+}
+
+// TestNewIDVersion checks objects created by requests and by events
+// inherit the version of their parent.
+func TestNewIDVersion(t *testing.T) {
+	c, compositor := Pipe()
+	defer compositor.Close()
+	display := ConnectTransport(c)
+	defer display.Context().Close()
+	ctx := display.Context()
+
+	wlCompositor := NewCompositor(ctx)
+	wlCompositor.SetVersion(4)
+	surface, err := wlCompositor.CreateSurface()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if surface.Version() != 4 {
+		t.Errorf("wl_surface created with version %d, want 4", surface.Version())
+	}
+	callback, err := surface.Frame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if callback.Version() != 4 {
+		t.Errorf("wl_callback created with version %d, want 4", callback.Version())
+	}
+
+	dataDevice := NewDataDevice(ctx)
+	dataDevice.SetVersion(3)
+	const offerID = 0xff000000
+	sendEvent(t, compositor, dataDevice.ID(), 0, offerID)
+	if err := ctx.Dispatch(); err != nil {
+		t.Fatal(err)
+	}
+	offer, ok := ctx.GetProxy(offerID).(*DataOffer)
+	if !ok || offer.Version() != 3 {
+		t.Fatalf("got %T for the new wl_data_offer, want version 3", ctx.GetProxy(offerID))
+	}
+
+	// wl_data_offer.set_actions is available since version 3
+	if err := offer.SetActions(0, 0); err != nil {
+		t.Errorf("wl_data_offer.set_actions: %v", err)
+	}
+}
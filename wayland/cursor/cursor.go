@@ -101,6 +101,10 @@ func (pool *shmPool) Allocate(size int) (int, error) {
 }
 
 func (pool *shmPool) Destroy() error {
+	if pool.f == nil {
+		return nil
+	}
+
 	if err := unix.Munmap(pool.Data); err != nil {
 		return err
 	}
@@ -113,6 +117,7 @@ func (pool *shmPool) Destroy() error {
 	if err := pool.f.Close(); err != nil {
 		return err
 	}
+	pool.f = nil
 
 	return nil
 }
@@ -148,7 +153,7 @@ func (image *Image) GetBuffer() (*client.Buffer, error) {
 	if image.buffer == nil {
 		buffer, err := theme.pool.pool.CreateBuffer(
 			int32(image.offset), int32(image.Width), int32(image.Height),
-			int32(image.Width)*4, uint32(client.ShmFormatArgb8888),
+			int32(image.Width)*4, client.ShmFormatArgb8888,
 		)
 		if err != nil {
 			return nil, err
@@ -286,6 +291,8 @@ type Theme struct {
 	cursors map[string]*Cursor
 	pool    *shmPool
 	size    int
+
+	removeCloseHandler func()
 }
 
 func (theme *Theme) loadCallback(name string, images []xcursor.Image) {
@@ -336,10 +343,21 @@ func LoadTheme(name string, size int, shm *client.Shm) (*Theme, error) {
 		return nil, errors.New("unable to find cursors in specified theme")
 	}
 
+	// Release the shm pool along with the connection, requests
+	// of the destroyed objects fail but the memory is unmapped
+	theme.removeCloseHandler = shm.Context().AddCloseHandler(func() {
+		_ = theme.Destroy()
+	})
+
 	return theme, nil
 }
 
 func (theme *Theme) Destroy() error {
+	if theme.removeCloseHandler != nil {
+		theme.removeCloseHandler()
+		theme.removeCloseHandler = nil
+	}
+
 	err := MultiError{}
 
 	for _, cursor := range theme.cursors {
@@ -0,0 +1,25 @@
+// Package eventloop is a single threaded event loop in the spirit of
+// wl_event_loop, built on epoll: it dispatches callbacks for file
+// descriptors, timers (timerfd), signals and idle time, and lets sources
+// be removed from any callback.
+//
+// Wayland clients are added with AddClient, so that waiting on the
+// connection can be combined with other sources, servers with AddServer:
+//
+//	loop, err := eventloop.New()
+//	...
+//	defer loop.Close()
+//	loop.AddClient(display.Context())
+//	timer, err := loop.AddTimer(func() error {
+//		return redraw()
+//	})
+//	timer.SetTimer(time.Second)
+//	loop.AddSignal(unix.SIGTERM, func(os.Signal) error {
+//		loop.Stop()
+//		return nil
+//	})
+//	err = loop.Run()
+//
+// A Loop is not safe for concurrent use, only Stop may be called from
+// other goroutines.
+package eventloop
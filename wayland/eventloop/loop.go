@@ -0,0 +1,214 @@
+package eventloop
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// ErrClosed is returned when using a closed loop.
+var ErrClosed = errors.New("event loop closed")
+
+// Loop waits for events of its sources and calls their callbacks.
+type Loop struct {
+	epfd int
+	// wake is an eventfd written by Stop
+	wake    int
+	stopped atomic.Bool
+	closed  bool
+
+	sources map[int32]*Source
+	nextID  int32
+	idles   []*Source
+	// dispatching is set while callbacks run, sources removed meanwhile
+	// are destroyed once they are done
+	dispatching bool
+	destroyList []*Source
+
+	// do runs the callbacks, see AddServer
+	do func(f func())
+
+	destroyHandlers []func()
+}
+
+// New creates an event loop.
+func New() (*Loop, error) {
+	epfd, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	wake, err := unix.Eventfd(0, unix.EFD_NONBLOCK|unix.EFD_CLOEXEC)
+	if err != nil {
+		unix.Close(epfd)
+		return nil, err
+	}
+
+	l := &Loop{
+		epfd:    epfd,
+		wake:    wake,
+		sources: map[int32]*Source{},
+		do:      func(f func()) { f() },
+	}
+	if _, err := l.addFd(wake, Readable, func(Mask) error {
+		drain(wake)
+		return nil
+	}, nil); err != nil {
+		l.Close()
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Fd returns the epoll file descriptor of the loop, it is readable when
+// the loop has events to dispatch. It allows nesting the loop in
+// another one.
+func (l *Loop) Fd() int {
+	return l.epfd
+}
+
+// AddDestroyHandler registers f to be called when the loop is closed.
+func (l *Loop) AddDestroyHandler(f func()) {
+	l.destroyHandlers = append(l.destroyHandlers, f)
+}
+
+// Dispatch waits up to timeout for events, a negative timeout waits
+// forever, and calls the callbacks of the sources that are ready. Idle
+// callbacks are called before waiting and after dispatching the
+// events.
+//
+// All the ready sources are dispatched, the first error returned by
+// their callbacks is returned.
+func (l *Loop) Dispatch(timeout time.Duration) error {
+	if l.closed {
+		return ErrClosed
+	}
+
+	if len(l.idles) > 0 {
+		l.run(l.dispatchIdle)
+	}
+	if len(l.idles) > 0 {
+		// Idle callbacks added by idle callbacks run next time,
+		// without waiting
+		timeout = 0
+	}
+
+	ms := -1
+	if timeout >= 0 {
+		ms = int((timeout + time.Millisecond - 1) / time.Millisecond)
+	}
+
+	events := make([]unix.EpollEvent, 32)
+	n, err := unix.EpollWait(l.epfd, events, ms)
+	if err == unix.EINTR {
+		// Interrupted by a signal, the idle callbacks still run
+		n = 0
+	} else if err != nil {
+		return err
+	}
+
+	var first error
+	l.run(func() {
+		for _, ev := range events[:n] {
+			s := l.sources[ev.Fd]
+			if s == nil || s.removed {
+				continue
+			}
+			if err := s.dispatch(maskFromEpoll(ev.Events)); err != nil && first == nil {
+				first = err
+			}
+		}
+		l.dispatchIdle()
+	})
+
+	return first
+}
+
+// Run dispatches events until Stop is called or a callback returns an
+// error, which is returned.
+func (l *Loop) Run() error {
+	for !l.stopped.Load() {
+		if err := l.Dispatch(-1); err != nil {
+			return err
+		}
+	}
+	l.stopped.Store(false)
+
+	return nil
+}
+
+// Stop makes Run return after the current iteration. It may be called
+// from any goroutine.
+func (l *Loop) Stop() {
+	l.stopped.Store(true)
+
+	var b [8]byte
+	b[0] = 1
+	unix.Write(l.wake, b[:])
+}
+
+// Close removes all the sources and calls the destroy handlers.
+func (l *Loop) Close() error {
+	if l.closed {
+		return ErrClosed
+	}
+
+	for _, s := range l.sources {
+		s.Remove()
+	}
+	for _, s := range l.idles {
+		s.Remove()
+	}
+	l.destroy()
+	l.closed = true
+
+	for i := len(l.destroyHandlers) - 1; i >= 0; i-- {
+		l.destroyHandlers[i]()
+	}
+	l.destroyHandlers = nil
+
+	unix.Close(l.wake)
+	return unix.Close(l.epfd)
+}
+
+// run calls f with the callbacks of removed sources deferred
+func (l *Loop) run(f func()) {
+	l.dispatching = true
+	l.do(f)
+	l.dispatching = false
+	l.destroy()
+}
+
+// dispatchIdle calls the idle callbacks added before it started
+func (l *Loop) dispatchIdle() {
+	idles := l.idles
+	l.idles = nil
+	for _, s := range idles {
+		if s.removed {
+			continue
+		}
+		s.dispatch(0)
+		s.Remove()
+	}
+}
+
+// destroy releases the removed sources
+func (l *Loop) destroy() {
+	for _, s := range l.destroyList {
+		delete(l.sources, s.id)
+		if s.close != nil {
+			s.close()
+		}
+	}
+	l.destroyList = nil
+}
+
+// drain resets an eventfd or the expiration count of a timerfd, it
+// fails with EAGAIN if it wasn't signaled
+func drain(fd int) error {
+	var b [8]byte
+	_, err := unix.Read(fd, b[:])
+	return err
+}
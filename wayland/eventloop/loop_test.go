@@ -0,0 +1,399 @@
+package eventloop
+
+import (
+	"os"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"github.com/hempflower/go-wayland/wayland/server"
+	"golang.org/x/sys/unix"
+)
+
+// TestDispatchInterrupted sends a signal to the thread waiting in
+// Dispatch, epoll_wait fails with EINTR.
+func TestDispatchInterrupted(t *testing.T) {
+	l, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	received := false
+	if _, err := l.AddSignal(unix.SIGUSR1, func(os.Signal) error {
+		received = true
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tids := make(chan int)
+	errs := make(chan error)
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		tids <- unix.Gettid()
+		deadline := time.Now().Add(5 * time.Second)
+		for !received && time.Now().Before(deadline) {
+			if err := l.Dispatch(time.Second); err != nil {
+				errs <- err
+				return
+			}
+		}
+		errs <- nil
+	}()
+
+	tid := <-tids
+	// Let the loop block in epoll_wait
+	time.Sleep(100 * time.Millisecond)
+	if err := unix.Tgkill(unix.Getpid(), tid, unix.SIGUSR1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := <-errs; err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	if !received {
+		t.Fatal("signal callback not called")
+	}
+}
+
+// dispatchUntil dispatches events until cond holds, failing the test
+// after a few seconds
+func dispatchUntil(t *testing.T, l *Loop, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out dispatching")
+		}
+		if err := l.Dispatch(100 * time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// notify makes the eventfd fd readable
+func notify(t *testing.T, fd int) {
+	t.Helper()
+
+	var b [8]byte
+	b[0] = 1
+	if _, err := unix.Write(fd, b[:]); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTimer(t *testing.T) {
+	l, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	fired := 0
+	timer, err := l.AddTimer(func() error {
+		fired++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Disarmed until set
+	if err := l.Dispatch(20 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if fired != 0 {
+		t.Fatal("timer fired before being armed")
+	}
+
+	if err := timer.SetTimer(10 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	dispatchUntil(t, l, func() bool { return fired == 1 })
+
+	// Armed again once expired
+	if err := timer.SetTimer(10 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	dispatchUntil(t, l, func() bool { return fired == 2 })
+
+	// Disarmed before expiring
+	if err := timer.SetTimer(10 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if err := timer.SetTimer(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Dispatch(50 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	// Re-armed after expiring but before being dispatched
+	if err := timer.SetTimer(time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if err := timer.SetTimer(time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Dispatch(20 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if fired != 2 {
+		t.Errorf("timer fired %d times, want 2", fired)
+	}
+
+	timer.Remove()
+	if err := timer.SetTimer(time.Millisecond); err == nil {
+		t.Error("removed timer armed")
+	}
+}
+
+func TestIdle(t *testing.T) {
+	l, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var calls []string
+	l.AddIdle(func() {
+		calls = append(calls, "first")
+		// Runs after the events, which aren't waited for
+		l.AddIdle(func() {
+			calls = append(calls, "nested")
+		})
+	})
+	cancelled := l.AddIdle(func() {
+		calls = append(calls, "cancelled")
+	})
+	cancelled.Remove()
+
+	start := time.Now()
+	if err := l.Dispatch(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Error("dispatch waited with idle callbacks pending")
+	}
+
+	if len(calls) != 2 || calls[0] != "first" || calls[1] != "nested" {
+		t.Errorf("got idle calls %v, want [first nested]", calls)
+	}
+}
+
+// TestRemoveDuringDispatch makes two sources ready, whichever is
+// dispatched first removes the other.
+func TestRemoveDuringDispatch(t *testing.T) {
+	l, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var fds [2]int
+	var sources [2]*Source
+	calls := 0
+	for i := range fds {
+		fd, err := unix.Eventfd(0, unix.EFD_NONBLOCK|unix.EFD_CLOEXEC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer unix.Close(fd)
+		fds[i] = fd
+
+		other := 1 - i
+		sources[i], err = l.AddFd(fd, Readable, func(fd int, mask Mask) error {
+			calls++
+			drain(fd)
+			return sources[other].Remove()
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	notify(t, fds[0])
+	notify(t, fds[1])
+	if err := l.Dispatch(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Fatalf("got %d callbacks, want 1", calls)
+	}
+
+	// The source left removes itself from its callback
+	left := sources[0]
+	if left.removed {
+		left = sources[1]
+	}
+	calls = 0
+	left.dispatch = func(Mask) error {
+		calls++
+		return left.Remove()
+	}
+	notify(t, left.fd)
+	if err := l.Dispatch(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Dispatch(20 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("got %d callbacks, want 1", calls)
+	}
+	if len(l.sources) != 1 {
+		t.Errorf("got %d sources, want the wake up eventfd only", len(l.sources))
+	}
+}
+
+func TestSignal(t *testing.T) {
+	l, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	received := 0
+	if _, err := l.AddSignal(unix.SIGUSR2, func(sig os.Signal) error {
+		if sig != unix.SIGUSR2 {
+			t.Errorf("got signal %v", sig)
+		}
+		received++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := unix.Kill(unix.Getpid(), unix.SIGUSR2); err != nil {
+		t.Fatal(err)
+	}
+	dispatchUntil(t, l, func() bool { return received > 0 })
+}
+
+func TestStop(t *testing.T) {
+	l, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		l.Stop()
+	}()
+
+	done := make(chan error, 1)
+	go func() { done <- l.Run() }()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run didn't return after Stop")
+	}
+}
+
+func TestAddClient(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+
+	s := server.New()
+	defer s.Close()
+	sock, err := server.Listen("wayland-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(sock)
+
+	display, err := client.Connect(sock.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer display.Context().Close()
+
+	l, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	if _, err := l.AddClient(display.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	callback, err := display.Sync()
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := false
+	callback.SetDoneHandler(func(client.CallbackDoneEvent) {
+		done = true
+	})
+	dispatchUntil(t, l, func() bool { return done })
+
+	// The compositor going away is reported by Dispatch
+	s.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if err := l.Dispatch(100 * time.Millisecond); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("disconnection not reported")
+		}
+	}
+}
+
+// TestAddServer checks that callbacks run while the server doesn't
+// dispatch requests, nor run other code through Do.
+func TestAddServer(t *testing.T) {
+	s := server.New()
+	defer s.Close()
+
+	l, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	l.AddServer(s)
+
+	var mu sync.Mutex
+	done := false
+	l.AddIdle(func() {
+		go s.Do(func() {
+			mu.Lock()
+			done = true
+			mu.Unlock()
+		})
+		time.Sleep(50 * time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if done {
+			t.Error("Do ran during a callback")
+		}
+	})
+	if err := l.Dispatch(0); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mu.Lock()
+		ran := done
+		mu.Unlock()
+		if ran {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Do blocked after the callback")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,239 @@
+package eventloop
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// Mask is a set of events of a file descriptor.
+type Mask uint32
+
+const (
+	Readable Mask = 1 << iota
+	Writable
+	// Hangup and Error are always reported, they don't need to be
+	// part of the mask of a source
+	Hangup
+	Error
+)
+
+func (m Mask) epoll() uint32 {
+	var events uint32
+	if m&Readable != 0 {
+		events |= unix.EPOLLIN
+	}
+	if m&Writable != 0 {
+		events |= unix.EPOLLOUT
+	}
+	return events
+}
+
+func maskFromEpoll(events uint32) Mask {
+	var m Mask
+	if events&unix.EPOLLIN != 0 {
+		m |= Readable
+	}
+	if events&unix.EPOLLOUT != 0 {
+		m |= Writable
+	}
+	if events&unix.EPOLLHUP != 0 {
+		m |= Hangup
+	}
+	if events&unix.EPOLLERR != 0 {
+		m |= Error
+	}
+	return m
+}
+
+// Source is a source of events added to a loop.
+type Source struct {
+	loop *Loop
+	id   int32
+	fd   int
+	// timer is set for timer sources, idle for idle sources
+	timer   bool
+	idle    bool
+	removed bool
+
+	dispatch func(mask Mask) error
+	// close releases the resources of the source once it is removed
+	close func()
+}
+
+// AddFd calls f when fd has one of the events of mask, along with the
+// events it has. The loop doesn't take ownership of fd, which must stay
+// open until the source is removed.
+func (l *Loop) AddFd(fd int, mask Mask, f func(fd int, mask Mask) error) (*Source, error) {
+	return l.addFd(fd, mask, func(mask Mask) error {
+		return f(fd, mask)
+	}, nil)
+}
+
+func (l *Loop) addFd(fd int, mask Mask, dispatch func(mask Mask) error, close func()) (*Source, error) {
+	if l.closed {
+		return nil, ErrClosed
+	}
+
+	l.nextID++
+	s := &Source{
+		loop:     l,
+		id:       l.nextID,
+		fd:       fd,
+		dispatch: dispatch,
+		close:    close,
+	}
+	ev := unix.EpollEvent{Events: mask.epoll(), Fd: s.id}
+	if err := unix.EpollCtl(l.epfd, unix.EPOLL_CTL_ADD, fd, &ev); err != nil {
+		return nil, err
+	}
+	l.sources[s.id] = s
+
+	return s, nil
+}
+
+// SetMask changes the events an fd source is dispatched for.
+func (s *Source) SetMask(mask Mask) error {
+	if s.removed || s.idle {
+		return errors.New("eventloop: not an fd source")
+	}
+
+	ev := unix.EpollEvent{Events: mask.epoll(), Fd: s.id}
+	return unix.EpollCtl(s.loop.epfd, unix.EPOLL_CTL_MOD, s.fd, &ev)
+}
+
+// AddTimer creates a timer calling f when it expires, it is disarmed
+// until SetTimer is called.
+func (l *Loop) AddTimer(f func() error) (*Source, error) {
+	fd, err := unix.TimerfdCreate(unix.CLOCK_MONOTONIC, unix.TFD_NONBLOCK|unix.TFD_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := l.addFd(fd, Readable, func(Mask) error {
+		if err := drain(fd); err != nil {
+			// Rearmed or disarmed since it expired
+			return nil
+		}
+		return f()
+	}, func() {
+		unix.Close(fd)
+	})
+	if err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	s.timer = true
+
+	return s, nil
+}
+
+// SetTimer arms a timer source to expire once after d, 0 disarms it.
+func (s *Source) SetTimer(d time.Duration) error {
+	if s.removed || !s.timer {
+		return errors.New("eventloop: not a timer source")
+	}
+
+	spec := unix.ItimerSpec{Value: unix.NsecToTimespec(d.Nanoseconds())}
+	return unix.TimerfdSettime(s.fd, 0, &spec, nil)
+}
+
+// AddSignal calls f when the process receives sig, several deliveries
+// before the loop dispatches the source are coalesced.
+//
+// Signals are received with os/signal rather than signalfd, which only
+// gets signals blocked in every thread of the process: the Go runtime
+// doesn't allow it. The source is woken up through an eventfd.
+func (l *Loop) AddSignal(sig os.Signal, f func(sig os.Signal) error) (*Source, error) {
+	fd, err := unix.Eventfd(0, unix.EFD_NONBLOCK|unix.EFD_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+
+	c := make(chan os.Signal, 1)
+	stop := make(chan struct{})
+	signal.Notify(c, sig)
+	go func() {
+		// The goroutine owns the eventfd, it may be writing it when
+		// the source is removed
+		defer unix.Close(fd)
+
+		var b [8]byte
+		b[0] = 1
+		for {
+			select {
+			case <-c:
+				unix.Write(fd, b[:])
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	s, err := l.addFd(fd, Readable, func(Mask) error {
+		if err := drain(fd); err != nil {
+			return nil
+		}
+		return f(sig)
+	}, func() {
+		signal.Stop(c)
+		close(stop)
+	})
+	if err != nil {
+		signal.Stop(c)
+		close(stop)
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// AddIdle calls f once, the next time the loop is about to wait or is
+// done dispatching events. Removing the source before cancels it.
+func (l *Loop) AddIdle(f func()) *Source {
+	l.nextID++
+	s := &Source{
+		loop: l,
+		id:   l.nextID,
+		fd:   -1,
+		idle: true,
+		dispatch: func(Mask) error {
+			f()
+			return nil
+		},
+	}
+	l.idles = append(l.idles, s)
+
+	return s
+}
+
+// Remove removes the source from its loop. It may be called from any
+// callback, including the one of the source: a source removed while the
+// loop dispatches events isn't dispatched anymore, and is destroyed
+// once the loop is done. Fds of fd sources are left open.
+func (s *Source) Remove() error {
+	if s.removed {
+		return nil
+	}
+	s.removed = true
+
+	l := s.loop
+	var err error
+	if !s.idle {
+		err = unix.EpollCtl(l.epfd, unix.EPOLL_CTL_DEL, s.fd, nil)
+		if err == unix.EBADF || err == unix.ENOENT {
+			// Already closed by the owner of the fd
+			err = nil
+		}
+	}
+
+	l.destroyList = append(l.destroyList, s)
+	if !l.dispatching {
+		l.destroy()
+	}
+
+	return err
+}
@@ -0,0 +1,34 @@
+package eventloop
+
+import (
+	"github.com/hempflower/go-wayland/wayland/client"
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+// AddClient dispatches the events of a client connection from the
+// loop: they are read with ReadEvents when the connection is readable,
+// then dispatched with DispatchPending. The errors of the connection,
+// e.g. protocol errors or the compositor going away, are returned by
+// Dispatch and Run.
+//
+// Events of the connection must not be dispatched elsewhere meanwhile,
+// except by blocking calls such as Roundtrip made from the callbacks.
+func (l *Loop) AddClient(ctx *client.Context) (*Source, error) {
+	return l.AddFd(ctx.Fd(), Readable, func(fd int, mask Mask) error {
+		if err := ctx.ReadEvents(); err != nil {
+			return err
+		}
+		return ctx.DispatchPending()
+	})
+}
+
+// AddServer serializes the callbacks of the loop with the requests
+// dispatched by s: they run within s.Do, so they can use the state of
+// request handlers, e.g. send events from timers. Callbacks must not
+// call s.Do themselves.
+//
+// Clients of s are still served by their own goroutines, started by
+// Serve or CreateClient.
+func (l *Loop) AddServer(s *server.Server) {
+	l.do = s.Do
+}
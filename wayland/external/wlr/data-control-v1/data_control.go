@@ -0,0 +1,880 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : wlr/data-control/wlr-data-control-unstable-v1.xml
+// XML sha256 : 6e9fe065139d58fa7c48aa45a55b32134cdba1d87ac6578916459a2150d19d1e
+//
+// wlr_data_control_unstable_v1 Protocol Copyright:
+//
+// Copyright © 2018 Simon Ser
+// Copyright © 2019 Ivan Molodetskikh
+//
+// Permission to use, copy, modify, distribute, and sell this
+// software and its documentation for any purpose is hereby granted
+// without fee, provided that the above copyright notice appear in
+// all copies and that both that copyright notice and this permission
+// notice appear in supporting documentation, and that the name of
+// the copyright holders not be used in advertising or publicity
+// pertaining to distribution of the software without specific,
+// written prior permission.  The copyright holders make no
+// representations about the suitability of this software for any
+// purpose.  It is provided "as is" without express or implied
+// warranty.
+//
+// THE COPYRIGHT HOLDERS DISCLAIM ALL WARRANTIES WITH REGARD TO THIS
+// SOFTWARE, INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND
+// FITNESS, IN NO EVENT SHALL THE COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// SPECIAL, INDIRECT OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN
+// AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION,
+// ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF
+// THIS SOFTWARE.
+
+package data_control
+
+import (
+	"github.com/hempflower/go-wayland/wayland/client"
+	"golang.org/x/sys/unix"
+)
+
+// DataControlManagerName : manager to control data devices
+const DataControlManagerName = "zwlr_data_control_manager_v1"
+
+// DataControlManagerInterface : metadata of the zwlr_data_control_manager_v1 interface
+var DataControlManagerInterface = &client.Interface{
+	Name:    DataControlManagerName,
+	Version: 2,
+	New:     func(ctx *client.Context) client.Proxy { return NewDataControlManager(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "create_data_source",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwlr_data_control_source_v1"},
+			},
+		},
+		{
+			Name:  "get_data_device",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwlr_data_control_device_v1"},
+				{Name: "seat", Type: client.ArgTypeObject, Interface: "wl_seat"},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns DataControlManagerInterface
+func (i *DataControlManager) Interface() *client.Interface {
+	return DataControlManagerInterface
+}
+
+// DataControlManager : manager to control data devices
+//
+// This interface is a manager that allows creating per-seat data device
+// controls.
+type DataControlManager struct {
+	client.BaseProxy
+}
+
+// NewDataControlManager : manager to control data devices
+//
+// This interface is a manager that allows creating per-seat data device
+// controls.
+func NewDataControlManager(ctx *client.Context) *DataControlManager {
+	zwlrDataControlManagerV1 := &DataControlManager{}
+	ctx.Register(zwlrDataControlManagerV1)
+	return zwlrDataControlManagerV1
+}
+
+// DataControlManagerCreateDataSourceSinceVersion : version of DataControlManager that introduced CreateDataSource
+const DataControlManagerCreateDataSourceSinceVersion = 1
+
+// CreateDataSource : create a new data source
+//
+// Create a new data source.
+func (i *DataControlManager) CreateDataSource() (*DataControlSource, error) {
+	id := NewDataControlSource(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 0
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+// DataControlManagerGetDataDeviceSinceVersion : version of DataControlManager that introduced GetDataDevice
+const DataControlManagerGetDataDeviceSinceVersion = 1
+
+// GetDataDevice : get a data device for a seat
+//
+// Create a data device that can be used to manage a seat's selection.
+func (i *DataControlManager) GetDataDevice(seat *client.Seat) (*DataControlDevice, error) {
+	id := NewDataControlDevice(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 1
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], seat.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+// DataControlManagerDestroySinceVersion : version of DataControlManager that introduced Destroy
+const DataControlManagerDestroySinceVersion = 1
+
+// Destroy : destroy the manager
+//
+// All objects created by the manager will still remain valid, until their
+// appropriate destroy request has been called.
+func (i *DataControlManager) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 2
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// DataControlDeviceName : manage a data device for a seat
+const DataControlDeviceName = "zwlr_data_control_device_v1"
+
+// DataControlDeviceInterface : metadata of the zwlr_data_control_device_v1 interface
+var DataControlDeviceInterface = &client.Interface{
+	Name:    DataControlDeviceName,
+	Version: 2,
+	New:     func(ctx *client.Context) client.Proxy { return NewDataControlDevice(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "set_selection",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "source", Type: client.ArgTypeObject, Interface: "zwlr_data_control_source_v1", AllowNull: true},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "set_primary_selection",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "source", Type: client.ArgTypeObject, Interface: "zwlr_data_control_source_v1", AllowNull: true},
+			},
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "data_offer",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwlr_data_control_offer_v1"},
+			},
+		},
+		{
+			Name:  "selection",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeObject, Interface: "zwlr_data_control_offer_v1", AllowNull: true},
+			},
+		},
+		{
+			Name:  "finished",
+			Since: 1,
+		},
+		{
+			Name:  "primary_selection",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeObject, Interface: "zwlr_data_control_offer_v1", AllowNull: true},
+			},
+		},
+	},
+}
+
+// Interface : returns DataControlDeviceInterface
+func (i *DataControlDevice) Interface() *client.Interface {
+	return DataControlDeviceInterface
+}
+
+// DataControlDevice : manage a data device for a seat
+//
+// This interface allows a client to manage a seat's selection.
+//
+// When the seat is destroyed, this object becomes inert.
+type DataControlDevice struct {
+	client.BaseProxy
+	dataOfferHandler        DataControlDeviceDataOfferHandlerFunc
+	selectionHandler        DataControlDeviceSelectionHandlerFunc
+	finishedHandler         DataControlDeviceFinishedHandlerFunc
+	primarySelectionHandler DataControlDevicePrimarySelectionHandlerFunc
+}
+
+// NewDataControlDevice : manage a data device for a seat
+//
+// This interface allows a client to manage a seat's selection.
+//
+// When the seat is destroyed, this object becomes inert.
+func NewDataControlDevice(ctx *client.Context) *DataControlDevice {
+	zwlrDataControlDeviceV1 := &DataControlDevice{}
+	ctx.Register(zwlrDataControlDeviceV1)
+	return zwlrDataControlDeviceV1
+}
+
+// DataControlDeviceSetSelectionSinceVersion : version of DataControlDevice that introduced SetSelection
+const DataControlDeviceSetSelectionSinceVersion = 1
+
+// SetSelection : copy data to the selection
+//
+// This request asks the compositor to set the selection to the data from
+// the source on behalf of the client.
+//
+// The given source may not be used in any further set_selection or
+// set_primary_selection requests. Attempting to use a previously used
+// source is a protocol error.
+//
+// To unset the selection, set the source to NULL.
+func (i *DataControlDevice) SetSelection(source *DataControlSource) error {
+	const opcode = 0
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	if source == nil {
+		client.PutUint32(_reqBuf[l:l+4], 0)
+		l += 4
+	} else {
+		client.PutUint32(_reqBuf[l:l+4], source.ID())
+		l += 4
+	}
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// DataControlDeviceDestroySinceVersion : version of DataControlDevice that introduced Destroy
+const DataControlDeviceDestroySinceVersion = 1
+
+// Destroy : destroy this data device
+//
+// Destroys the data device object.
+func (i *DataControlDevice) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// DataControlDeviceSetPrimarySelectionSinceVersion : version of DataControlDevice that introduced SetPrimarySelection
+const DataControlDeviceSetPrimarySelectionSinceVersion = 2
+
+// SetPrimarySelection : copy data to the primary selection
+//
+// This request asks the compositor to set the primary selection to the
+// data from the source on behalf of the client.
+//
+// The given source may not be used in any further set_selection or
+// set_primary_selection requests. Attempting to use a previously used
+// source is a protocol error.
+//
+// To unset the primary selection, set the source to NULL.
+//
+// The compositor will ignore this request if it does not support primary
+// selection.
+func (i *DataControlDevice) SetPrimarySelection(source *DataControlSource) error {
+	if v := i.Version(); v < DataControlDeviceSetPrimarySelectionSinceVersion {
+		return &client.VersionError{Interface: DataControlDeviceName, Request: "set_primary_selection", Since: DataControlDeviceSetPrimarySelectionSinceVersion, Version: v}
+	}
+	const opcode = 2
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	if source == nil {
+		client.PutUint32(_reqBuf[l:l+4], 0)
+		l += 4
+	} else {
+		client.PutUint32(_reqBuf[l:l+4], source.ID())
+		l += 4
+	}
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type DataControlDeviceError uint32
+
+// DataControlDeviceError :
+const (
+	// DataControlDeviceErrorUsedSource : source given to set_selection or set_primary_selection was already used before
+	DataControlDeviceErrorUsedSource DataControlDeviceError = 1
+)
+
+func (e DataControlDeviceError) Name() string {
+	switch e {
+	case DataControlDeviceErrorUsedSource:
+		return "used_source"
+	default:
+		return ""
+	}
+}
+
+func (e DataControlDeviceError) Value() string {
+	switch e {
+	case DataControlDeviceErrorUsedSource:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+func (e DataControlDeviceError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// DataControlDeviceDataOfferEvent : introduce a new wlr_data_control_offer
+//
+// The data_offer event introduces a new wlr_data_control_offer object,
+// which will subsequently be used in either the
+// wlr_data_control_device.selection event (for the regular clipboard
+// selections) or the wlr_data_control_device.primary_selection event (for
+// the primary clipboard selections). Immediately following the
+// wlr_data_control_device.data_offer event, the new data_offer object
+// will send out wlr_data_control_offer.offer events to describe the MIME
+// types it offers.
+type DataControlDeviceDataOfferEvent struct {
+	Id *DataControlOffer
+}
+
+// DataControlDeviceDataOfferSinceVersion : version of DataControlDevice that introduced DataControlDeviceDataOfferEvent
+const DataControlDeviceDataOfferSinceVersion = 1
+
+type DataControlDeviceDataOfferHandlerFunc func(DataControlDeviceDataOfferEvent)
+
+// SetDataOfferHandler : sets handler for DataControlDeviceDataOfferEvent
+func (i *DataControlDevice) SetDataOfferHandler(f DataControlDeviceDataOfferHandlerFunc) {
+	i.dataOfferHandler = f
+}
+
+// DataControlDeviceSelectionEvent : advertise new selection
+//
+// The selection event is sent out to notify the client of a new
+// wlr_data_control_offer for the selection for this device. The
+// wlr_data_control_device.data_offer and the wlr_data_control_offer.offer
+// events are sent out immediately before this event to introduce the data
+// offer object. The selection event is sent to a client when a new
+// selection is set. The wlr_data_control_offer is valid until a new
+// wlr_data_control_offer or NULL is received. The client must destroy the
+// previous selection wlr_data_control_offer, if any, upon receiving this
+// event.
+//
+// The first selection event is sent upon binding the
+// wlr_data_control_device object.
+type DataControlDeviceSelectionEvent struct {
+	// Id is nil if it is null or the client destroyed the object
+	Id *DataControlOffer
+}
+
+// DataControlDeviceSelectionSinceVersion : version of DataControlDevice that introduced DataControlDeviceSelectionEvent
+const DataControlDeviceSelectionSinceVersion = 1
+
+type DataControlDeviceSelectionHandlerFunc func(DataControlDeviceSelectionEvent)
+
+// SetSelectionHandler : sets handler for DataControlDeviceSelectionEvent
+func (i *DataControlDevice) SetSelectionHandler(f DataControlDeviceSelectionHandlerFunc) {
+	i.selectionHandler = f
+}
+
+// DataControlDeviceFinishedEvent : this data control is no longer valid
+//
+// This data control object is no longer valid and should be destroyed by
+// the client.
+type DataControlDeviceFinishedEvent struct{}
+
+// DataControlDeviceFinishedSinceVersion : version of DataControlDevice that introduced DataControlDeviceFinishedEvent
+const DataControlDeviceFinishedSinceVersion = 1
+
+type DataControlDeviceFinishedHandlerFunc func(DataControlDeviceFinishedEvent)
+
+// SetFinishedHandler : sets handler for DataControlDeviceFinishedEvent
+func (i *DataControlDevice) SetFinishedHandler(f DataControlDeviceFinishedHandlerFunc) {
+	i.finishedHandler = f
+}
+
+// DataControlDevicePrimarySelectionEvent : advertise new primary selection
+//
+// The primary_selection event is sent out to notify the client of a new
+// wlr_data_control_offer for the primary selection for this device. The
+// wlr_data_control_device.data_offer and the wlr_data_control_offer.offer
+// events are sent out immediately before this event to introduce the data
+// offer object. The primary_selection event is sent to a client when a
+// new primary selection is set. The wlr_data_control_offer is valid until
+// a new wlr_data_control_offer or NULL is received. The client must
+// destroy the previous primary selection wlr_data_control_offer, if any,
+// upon receiving this event.
+//
+// If the compositor supports primary selection, the first
+// primary_selection event is sent upon binding the
+// wlr_data_control_device object.
+type DataControlDevicePrimarySelectionEvent struct {
+	// Id is nil if it is null or the client destroyed the object
+	Id *DataControlOffer
+}
+
+// DataControlDevicePrimarySelectionSinceVersion : version of DataControlDevice that introduced DataControlDevicePrimarySelectionEvent
+const DataControlDevicePrimarySelectionSinceVersion = 2
+
+type DataControlDevicePrimarySelectionHandlerFunc func(DataControlDevicePrimarySelectionEvent)
+
+// SetPrimarySelectionHandler : sets handler for DataControlDevicePrimarySelectionEvent
+func (i *DataControlDevice) SetPrimarySelectionHandler(f DataControlDevicePrimarySelectionHandlerFunc) {
+	i.primarySelectionHandler = f
+}
+
+func (i *DataControlDevice) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		var e DataControlDeviceDataOfferEvent
+		l := 0
+		id := &DataControlOffer{}
+		i.Context().SetProxy(client.Uint32(data[l:l+4]), id)
+		id.SetVersion(i.Version())
+		e.Id = id
+		l += 4
+		if i.dataOfferHandler == nil {
+			return
+		}
+
+		i.dataOfferHandler(e)
+	case 1:
+		if i.selectionHandler == nil {
+			return
+		}
+		var e DataControlDeviceSelectionEvent
+		l := 0
+		e.Id, _ = i.Context().GetProxy(client.Uint32(data[l : l+4])).(*DataControlOffer)
+		l += 4
+
+		i.selectionHandler(e)
+	case 2:
+		if i.finishedHandler == nil {
+			return
+		}
+		var e DataControlDeviceFinishedEvent
+
+		i.finishedHandler(e)
+	case 3:
+		if i.primarySelectionHandler == nil {
+			return
+		}
+		var e DataControlDevicePrimarySelectionEvent
+		l := 0
+		e.Id, _ = i.Context().GetProxy(client.Uint32(data[l : l+4])).(*DataControlOffer)
+		l += 4
+
+		i.primarySelectionHandler(e)
+	}
+}
+
+// DataControlSourceName : offer to transfer data
+const DataControlSourceName = "zwlr_data_control_source_v1"
+
+// DataControlSourceInterface : metadata of the zwlr_data_control_source_v1 interface
+var DataControlSourceInterface = &client.Interface{
+	Name:    DataControlSourceName,
+	Version: 1,
+	New:     func(ctx *client.Context) client.Proxy { return NewDataControlSource(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "offer",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "mime_type", Type: client.ArgTypeString},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "send",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "mime_type", Type: client.ArgTypeString},
+				{Name: "fd", Type: client.ArgTypeFd},
+			},
+		},
+		{
+			Name:  "cancelled",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns DataControlSourceInterface
+func (i *DataControlSource) Interface() *client.Interface {
+	return DataControlSourceInterface
+}
+
+// DataControlSource : offer to transfer data
+//
+// The wlr_data_control_source object is the source side of a
+// wlr_data_control_offer. It is created by the source client in a data
+// transfer and provides a way to describe the offered data and a way to
+// respond to requests to transfer the data.
+type DataControlSource struct {
+	client.BaseProxy
+	sendHandler      DataControlSourceSendHandlerFunc
+	cancelledHandler DataControlSourceCancelledHandlerFunc
+}
+
+// NewDataControlSource : offer to transfer data
+//
+// The wlr_data_control_source object is the source side of a
+// wlr_data_control_offer. It is created by the source client in a data
+// transfer and provides a way to describe the offered data and a way to
+// respond to requests to transfer the data.
+func NewDataControlSource(ctx *client.Context) *DataControlSource {
+	zwlrDataControlSourceV1 := &DataControlSource{}
+	ctx.Register(zwlrDataControlSourceV1)
+	return zwlrDataControlSourceV1
+}
+
+// DataControlSourceOfferSinceVersion : version of DataControlSource that introduced Offer
+const DataControlSourceOfferSinceVersion = 1
+
+// Offer : add an offered MIME type
+//
+// This request adds a MIME type to the set of MIME types advertised to
+// targets. Can be called several times to offer multiple types.
+//
+// Calling this after wlr_data_control_device.set_selection is a protocol
+// error.
+//
+//	mimeType: MIME type offered by the data source
+func (i *DataControlSource) Offer(mimeType string) error {
+	const opcode = 0
+	mimeTypeLen := client.PaddedLen(len(mimeType) + 1)
+	_reqBufLen := 8 + (4 + mimeTypeLen)
+	_reqBuf := make([]byte, _reqBufLen)
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutString(_reqBuf[l:l+(4+mimeTypeLen)], mimeType, mimeTypeLen)
+	l += (4 + mimeTypeLen)
+	err := i.Context().WriteMsg(_reqBuf, nil)
+	return err
+}
+
+// DataControlSourceDestroySinceVersion : version of DataControlSource that introduced Destroy
+const DataControlSourceDestroySinceVersion = 1
+
+// Destroy : destroy this source
+//
+// Destroys the data source object.
+func (i *DataControlSource) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type DataControlSourceError uint32
+
+// DataControlSourceError :
+const (
+	// DataControlSourceErrorInvalidOffer : offer sent after wlr_data_control_device.set_selection
+	DataControlSourceErrorInvalidOffer DataControlSourceError = 1
+)
+
+func (e DataControlSourceError) Name() string {
+	switch e {
+	case DataControlSourceErrorInvalidOffer:
+		return "invalid_offer"
+	default:
+		return ""
+	}
+}
+
+func (e DataControlSourceError) Value() string {
+	switch e {
+	case DataControlSourceErrorInvalidOffer:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+func (e DataControlSourceError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// DataControlSourceSendEvent : send the data
+//
+// Request for data from the client. Send the data as the specified MIME
+// type over the passed file descriptor, then close it.
+type DataControlSourceSendEvent struct {
+	MimeType string
+	Fd       int
+}
+
+// DataControlSourceSendSinceVersion : version of DataControlSource that introduced DataControlSourceSendEvent
+const DataControlSourceSendSinceVersion = 1
+
+type DataControlSourceSendHandlerFunc func(DataControlSourceSendEvent)
+
+// SetSendHandler : sets handler for DataControlSourceSendEvent
+func (i *DataControlSource) SetSendHandler(f DataControlSourceSendHandlerFunc) {
+	i.sendHandler = f
+}
+
+// DataControlSourceCancelledEvent : selection was cancelled
+//
+// This data source is no longer valid. The data source has been replaced
+// by another data source.
+//
+// The client should clean up and destroy this data source.
+type DataControlSourceCancelledEvent struct{}
+
+// DataControlSourceCancelledSinceVersion : version of DataControlSource that introduced DataControlSourceCancelledEvent
+const DataControlSourceCancelledSinceVersion = 1
+
+type DataControlSourceCancelledHandlerFunc func(DataControlSourceCancelledEvent)
+
+// SetCancelledHandler : sets handler for DataControlSourceCancelledEvent
+func (i *DataControlSource) SetCancelledHandler(f DataControlSourceCancelledHandlerFunc) {
+	i.cancelledHandler = f
+}
+
+func (i *DataControlSource) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.sendHandler == nil {
+			if fd != -1 {
+				unix.Close(fd)
+			}
+			return
+		}
+		var e DataControlSourceSendEvent
+		l := 0
+		mimeTypeLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.MimeType = client.String(data[l : l+mimeTypeLen])
+		l += mimeTypeLen
+		e.Fd = fd
+
+		i.sendHandler(e)
+	case 1:
+		if i.cancelledHandler == nil {
+			return
+		}
+		var e DataControlSourceCancelledEvent
+
+		i.cancelledHandler(e)
+	}
+}
+
+// DataControlOfferName : offer to transfer data
+const DataControlOfferName = "zwlr_data_control_offer_v1"
+
+// DataControlOfferInterface : metadata of the zwlr_data_control_offer_v1 interface
+var DataControlOfferInterface = &client.Interface{
+	Name:    DataControlOfferName,
+	Version: 1,
+	New:     func(ctx *client.Context) client.Proxy { return NewDataControlOffer(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "receive",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "mime_type", Type: client.ArgTypeString},
+				{Name: "fd", Type: client.ArgTypeFd},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "offer",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "mime_type", Type: client.ArgTypeString},
+			},
+		},
+	},
+}
+
+// Interface : returns DataControlOfferInterface
+func (i *DataControlOffer) Interface() *client.Interface {
+	return DataControlOfferInterface
+}
+
+// DataControlOffer : offer to transfer data
+//
+// A wlr_data_control_offer represents a piece of data offered for transfer
+// by another client (the source client). The offer describes the different
+// MIME types that the data can be converted to and provides the mechanism
+// for transferring the data directly from the source client.
+type DataControlOffer struct {
+	client.BaseProxy
+	offerHandler DataControlOfferOfferHandlerFunc
+}
+
+// NewDataControlOffer : offer to transfer data
+//
+// A wlr_data_control_offer represents a piece of data offered for transfer
+// by another client (the source client). The offer describes the different
+// MIME types that the data can be converted to and provides the mechanism
+// for transferring the data directly from the source client.
+func NewDataControlOffer(ctx *client.Context) *DataControlOffer {
+	zwlrDataControlOfferV1 := &DataControlOffer{}
+	ctx.Register(zwlrDataControlOfferV1)
+	return zwlrDataControlOfferV1
+}
+
+// DataControlOfferReceiveSinceVersion : version of DataControlOffer that introduced Receive
+const DataControlOfferReceiveSinceVersion = 1
+
+// Receive : request that the data is transferred
+//
+// To transfer the offered data, the client issues this request and
+// indicates the MIME type it wants to receive. The transfer happens
+// through the passed file descriptor (typically created with the pipe
+// system call). The source client writes the data in the MIME type
+// representation requested and then closes the file descriptor.
+//
+// The receiving client reads from the read end of the pipe until EOF and
+// then closes its end, at which point the transfer is complete.
+//
+// This request may happen multiple times for different MIME types.
+//
+//	mimeType: MIME type desired by receiver
+//	fd: file descriptor for data transfer
+func (i *DataControlOffer) Receive(mimeType string, fd int) error {
+	const opcode = 0
+	mimeTypeLen := client.PaddedLen(len(mimeType) + 1)
+	_reqBufLen := 8 + (4 + mimeTypeLen)
+	_reqBuf := make([]byte, _reqBufLen)
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutString(_reqBuf[l:l+(4+mimeTypeLen)], mimeType, mimeTypeLen)
+	l += (4 + mimeTypeLen)
+	oob := unix.UnixRights(int(fd))
+	err := i.Context().WriteMsg(_reqBuf, oob)
+	return err
+}
+
+// DataControlOfferDestroySinceVersion : version of DataControlOffer that introduced Destroy
+const DataControlOfferDestroySinceVersion = 1
+
+// Destroy : destroy this offer
+//
+// Destroys the data offer object.
+func (i *DataControlOffer) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// DataControlOfferOfferEvent : advertise offered MIME type
+//
+// Sent immediately after creating the wlr_data_control_offer object.
+// One event per offered MIME type.
+type DataControlOfferOfferEvent struct {
+	MimeType string
+}
+
+// DataControlOfferOfferSinceVersion : version of DataControlOffer that introduced DataControlOfferOfferEvent
+const DataControlOfferOfferSinceVersion = 1
+
+type DataControlOfferOfferHandlerFunc func(DataControlOfferOfferEvent)
+
+// SetOfferHandler : sets handler for DataControlOfferOfferEvent
+func (i *DataControlOffer) SetOfferHandler(f DataControlOfferOfferHandlerFunc) {
+	i.offerHandler = f
+}
+
+func (i *DataControlOffer) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.offerHandler == nil {
+			return
+		}
+		var e DataControlOfferOfferEvent
+		l := 0
+		mimeTypeLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.MimeType = client.String(data[l : l+mimeTypeLen])
+		l += mimeTypeLen
+
+		i.offerHandler(e)
+	}
+}
+
+func init() {
+	client.RegisterInterface(DataControlManagerInterface)
+	client.RegisterInterface(DataControlDeviceInterface)
+	client.RegisterInterface(DataControlSourceInterface)
+	client.RegisterInterface(DataControlOfferInterface)
+}
@@ -0,0 +1,7 @@
+// Package data_control is Go binding of the wlr-data-control-unstable-v1
+// protocol, used by clipboard managers to control seat selections.
+//
+// Stability: unstable. wlr-protocols are maintained outside of
+// wayland-protocols and only implemented by wlroots based compositors,
+// backward incompatible changes bump the version in the interface names.
+package data_control
@@ -0,0 +1,8 @@
+// Package foreign_toplevel is Go binding of the
+// wlr-foreign-toplevel-management-unstable-v1 protocol, used by taskbars to
+// list and control the toplevels of other clients.
+//
+// Stability: unstable. wlr-protocols are maintained outside of
+// wayland-protocols and only implemented by wlroots based compositors,
+// backward incompatible changes bump the version in the interface names.
+package foreign_toplevel
@@ -0,0 +1,913 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : wlr/foreign-toplevel/wlr-foreign-toplevel-management-unstable-v1.xml
+// XML sha256 : 84d0683d68d4c947bfd4f5af4722424b65f1a999dd393402c64b6d187af74111
+//
+// wlr_foreign_toplevel_management_unstable_v1 Protocol Copyright:
+//
+// Copyright © 2018 Ilia Bozhinov
+//
+// Permission to use, copy, modify, distribute, and sell this
+// software and its documentation for any purpose is hereby granted
+// without fee, provided that the above copyright notice appear in
+// all copies and that both that copyright notice and this permission
+// notice appear in supporting documentation, and that the name of
+// the copyright holders not be used in advertising or publicity
+// pertaining to distribution of the software without specific,
+// written prior permission.  The copyright holders make no
+// representations about the suitability of this software for any
+// purpose.  It is provided "as is" without express or implied
+// warranty.
+//
+// THE COPYRIGHT HOLDERS DISCLAIM ALL WARRANTIES WITH REGARD TO THIS
+// SOFTWARE, INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND
+// FITNESS, IN NO EVENT SHALL THE COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// SPECIAL, INDIRECT OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN
+// AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION,
+// ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF
+// THIS SOFTWARE.
+
+package foreign_toplevel
+
+import "github.com/hempflower/go-wayland/wayland/client"
+
+// ForeignToplevelManagerName : list and control opened apps
+const ForeignToplevelManagerName = "zwlr_foreign_toplevel_manager_v1"
+
+// ForeignToplevelManagerInterface : metadata of the zwlr_foreign_toplevel_manager_v1 interface
+var ForeignToplevelManagerInterface = &client.Interface{
+	Name:    ForeignToplevelManagerName,
+	Version: 3,
+	New:     func(ctx *client.Context) client.Proxy { return NewForeignToplevelManager(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "stop",
+			Since: 1,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "toplevel",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "toplevel", Type: client.ArgTypeNewID, Interface: "zwlr_foreign_toplevel_handle_v1"},
+			},
+		},
+		{
+			Name:  "finished",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns ForeignToplevelManagerInterface
+func (i *ForeignToplevelManager) Interface() *client.Interface {
+	return ForeignToplevelManagerInterface
+}
+
+// ForeignToplevelManager : list and control opened apps
+//
+// The purpose of this protocol is to enable the creation of taskbars
+// and docks by providing them with a list of opened applications and
+// letting them request certain actions on them, like maximizing, etc.
+//
+// After a client binds the zwlr_foreign_toplevel_manager_v1, each opened
+// toplevel window will be sent via the toplevel event
+type ForeignToplevelManager struct {
+	client.BaseProxy
+	toplevelHandler ForeignToplevelManagerToplevelHandlerFunc
+	finishedHandler ForeignToplevelManagerFinishedHandlerFunc
+}
+
+// NewForeignToplevelManager : list and control opened apps
+//
+// The purpose of this protocol is to enable the creation of taskbars
+// and docks by providing them with a list of opened applications and
+// letting them request certain actions on them, like maximizing, etc.
+//
+// After a client binds the zwlr_foreign_toplevel_manager_v1, each opened
+// toplevel window will be sent via the toplevel event
+func NewForeignToplevelManager(ctx *client.Context) *ForeignToplevelManager {
+	zwlrForeignToplevelManagerV1 := &ForeignToplevelManager{}
+	ctx.Register(zwlrForeignToplevelManagerV1)
+	return zwlrForeignToplevelManagerV1
+}
+
+// ForeignToplevelManagerStopSinceVersion : version of ForeignToplevelManager that introduced Stop
+const ForeignToplevelManagerStopSinceVersion = 1
+
+// Stop : stop sending events
+//
+// Indicates the client no longer wishes to receive events for new toplevels.
+// However the compositor may emit further toplevel_created events, until
+// the finished event is emitted.
+//
+// The client must not send any more requests after this one.
+func (i *ForeignToplevelManager) Stop() error {
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+func (i *ForeignToplevelManager) Destroy() error {
+	i.Context().Unregister(i)
+	return nil
+}
+
+// ForeignToplevelManagerToplevelEvent : a toplevel has been created
+//
+// This event is emitted whenever a new toplevel window is created. It
+// is emitted for all toplevels, regardless of the app that has created
+// them.
+//
+// All initial details of the toplevel(title, app_id, states, etc.) will
+// be sent immediately after this event via the corresponding events in
+// zwlr_foreign_toplevel_handle_v1.
+type ForeignToplevelManagerToplevelEvent struct {
+	Toplevel *ForeignToplevelHandle
+}
+
+// ForeignToplevelManagerToplevelSinceVersion : version of ForeignToplevelManager that introduced ForeignToplevelManagerToplevelEvent
+const ForeignToplevelManagerToplevelSinceVersion = 1
+
+type ForeignToplevelManagerToplevelHandlerFunc func(ForeignToplevelManagerToplevelEvent)
+
+// SetToplevelHandler : sets handler for ForeignToplevelManagerToplevelEvent
+func (i *ForeignToplevelManager) SetToplevelHandler(f ForeignToplevelManagerToplevelHandlerFunc) {
+	i.toplevelHandler = f
+}
+
+// ForeignToplevelManagerFinishedEvent : the compositor has finished with the toplevel manager
+//
+// This event indicates that the compositor is done sending events to the
+// zwlr_foreign_toplevel_manager_v1. The server will destroy the object
+// immediately after sending this request, so it will become invalid and
+// the client should free any resources associated with it.
+type ForeignToplevelManagerFinishedEvent struct{}
+
+// ForeignToplevelManagerFinishedSinceVersion : version of ForeignToplevelManager that introduced ForeignToplevelManagerFinishedEvent
+const ForeignToplevelManagerFinishedSinceVersion = 1
+
+type ForeignToplevelManagerFinishedHandlerFunc func(ForeignToplevelManagerFinishedEvent)
+
+// SetFinishedHandler : sets handler for ForeignToplevelManagerFinishedEvent
+func (i *ForeignToplevelManager) SetFinishedHandler(f ForeignToplevelManagerFinishedHandlerFunc) {
+	i.finishedHandler = f
+}
+
+func (i *ForeignToplevelManager) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		var e ForeignToplevelManagerToplevelEvent
+		l := 0
+		toplevel := &ForeignToplevelHandle{}
+		i.Context().SetProxy(client.Uint32(data[l:l+4]), toplevel)
+		toplevel.SetVersion(i.Version())
+		e.Toplevel = toplevel
+		l += 4
+		if i.toplevelHandler == nil {
+			return
+		}
+
+		i.toplevelHandler(e)
+	case 1:
+		if i.finishedHandler == nil {
+			return
+		}
+		var e ForeignToplevelManagerFinishedEvent
+
+		i.finishedHandler(e)
+	}
+}
+
+// ForeignToplevelHandleName : an opened toplevel
+const ForeignToplevelHandleName = "zwlr_foreign_toplevel_handle_v1"
+
+// ForeignToplevelHandleInterface : metadata of the zwlr_foreign_toplevel_handle_v1 interface
+var ForeignToplevelHandleInterface = &client.Interface{
+	Name:    ForeignToplevelHandleName,
+	Version: 3,
+	New:     func(ctx *client.Context) client.Proxy { return NewForeignToplevelHandle(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "set_maximized",
+			Since: 1,
+		},
+		{
+			Name:  "unset_maximized",
+			Since: 1,
+		},
+		{
+			Name:  "set_minimized",
+			Since: 1,
+		},
+		{
+			Name:  "unset_minimized",
+			Since: 1,
+		},
+		{
+			Name:  "activate",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "seat", Type: client.ArgTypeObject, Interface: "wl_seat"},
+			},
+		},
+		{
+			Name:  "close",
+			Since: 1,
+		},
+		{
+			Name:  "set_rectangle",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "surface", Type: client.ArgTypeObject, Interface: "wl_surface"},
+				{Name: "x", Type: client.ArgTypeInt},
+				{Name: "y", Type: client.ArgTypeInt},
+				{Name: "width", Type: client.ArgTypeInt},
+				{Name: "height", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "set_fullscreen",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "output", Type: client.ArgTypeObject, Interface: "wl_output", AllowNull: true},
+			},
+		},
+		{
+			Name:  "unset_fullscreen",
+			Since: 2,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "title",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "title", Type: client.ArgTypeString},
+			},
+		},
+		{
+			Name:  "app_id",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "app_id", Type: client.ArgTypeString},
+			},
+		},
+		{
+			Name:  "output_enter",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "output", Type: client.ArgTypeObject, Interface: "wl_output"},
+			},
+		},
+		{
+			Name:  "output_leave",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "output", Type: client.ArgTypeObject, Interface: "wl_output"},
+			},
+		},
+		{
+			Name:  "state",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "state", Type: client.ArgTypeArray},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 1,
+		},
+		{
+			Name:  "closed",
+			Since: 1,
+		},
+		{
+			Name:  "parent",
+			Since: 3,
+			Args: []client.Arg{
+				{Name: "parent", Type: client.ArgTypeObject, Interface: "zwlr_foreign_toplevel_handle_v1", AllowNull: true},
+			},
+		},
+	},
+}
+
+// Interface : returns ForeignToplevelHandleInterface
+func (i *ForeignToplevelHandle) Interface() *client.Interface {
+	return ForeignToplevelHandleInterface
+}
+
+// ForeignToplevelHandle : an opened toplevel
+//
+// A zwlr_foreign_toplevel_handle_v1 object represents an opened toplevel
+// window. Each app may have multiple opened toplevels.
+//
+// Each toplevel has a list of outputs it is visible on, conveyed to the
+// client with the output_enter and output_leave events.
+type ForeignToplevelHandle struct {
+	client.BaseProxy
+	titleHandler       ForeignToplevelHandleTitleHandlerFunc
+	appIdHandler       ForeignToplevelHandleAppIdHandlerFunc
+	outputEnterHandler ForeignToplevelHandleOutputEnterHandlerFunc
+	outputLeaveHandler ForeignToplevelHandleOutputLeaveHandlerFunc
+	stateHandler       ForeignToplevelHandleStateHandlerFunc
+	doneHandler        ForeignToplevelHandleDoneHandlerFunc
+	closedHandler      ForeignToplevelHandleClosedHandlerFunc
+	parentHandler      ForeignToplevelHandleParentHandlerFunc
+}
+
+// NewForeignToplevelHandle : an opened toplevel
+//
+// A zwlr_foreign_toplevel_handle_v1 object represents an opened toplevel
+// window. Each app may have multiple opened toplevels.
+//
+// Each toplevel has a list of outputs it is visible on, conveyed to the
+// client with the output_enter and output_leave events.
+func NewForeignToplevelHandle(ctx *client.Context) *ForeignToplevelHandle {
+	zwlrForeignToplevelHandleV1 := &ForeignToplevelHandle{}
+	ctx.Register(zwlrForeignToplevelHandleV1)
+	return zwlrForeignToplevelHandleV1
+}
+
+// ForeignToplevelHandleSetMaximizedSinceVersion : version of ForeignToplevelHandle that introduced SetMaximized
+const ForeignToplevelHandleSetMaximizedSinceVersion = 1
+
+// SetMaximized : requests that the toplevel be maximized
+//
+// Requests that the toplevel be maximized. If the maximized state actually
+// changes, this will be indicated by the state event.
+func (i *ForeignToplevelHandle) SetMaximized() error {
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleUnsetMaximizedSinceVersion : version of ForeignToplevelHandle that introduced UnsetMaximized
+const ForeignToplevelHandleUnsetMaximizedSinceVersion = 1
+
+// UnsetMaximized : requests that the toplevel be unmaximized
+//
+// Requests that the toplevel be unmaximized. If the maximized state actually
+// changes, this will be indicated by the state event.
+func (i *ForeignToplevelHandle) UnsetMaximized() error {
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleSetMinimizedSinceVersion : version of ForeignToplevelHandle that introduced SetMinimized
+const ForeignToplevelHandleSetMinimizedSinceVersion = 1
+
+// SetMinimized : requests that the toplevel be minimized
+//
+// Requests that the toplevel be minimized. If the minimized state actually
+// changes, this will be indicated by the state event.
+func (i *ForeignToplevelHandle) SetMinimized() error {
+	const opcode = 2
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleUnsetMinimizedSinceVersion : version of ForeignToplevelHandle that introduced UnsetMinimized
+const ForeignToplevelHandleUnsetMinimizedSinceVersion = 1
+
+// UnsetMinimized : requests that the toplevel be unminimized
+//
+// Requests that the toplevel be unminimized. If the minimized state actually
+// changes, this will be indicated by the state event.
+func (i *ForeignToplevelHandle) UnsetMinimized() error {
+	const opcode = 3
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleActivateSinceVersion : version of ForeignToplevelHandle that introduced Activate
+const ForeignToplevelHandleActivateSinceVersion = 1
+
+// Activate : activate the toplevel
+//
+// Request that this toplevel be activated on the given seat.
+// There is no guarantee the toplevel will be actually activated.
+func (i *ForeignToplevelHandle) Activate(seat *client.Seat) error {
+	const opcode = 4
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], seat.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleCloseSinceVersion : version of ForeignToplevelHandle that introduced Close
+const ForeignToplevelHandleCloseSinceVersion = 1
+
+// Close : request that the toplevel be closed
+//
+// Send a request to the toplevel to close itself. The compositor would
+// typically use a shell-specific method to carry out this request, for
+// example sending the xdg_toplevel.close event. However, this gives no
+// guarantees the toplevel will actually be destroyed. If and when this
+// happens, the zwlr_foreign_toplevel_handle_v1.closed event will be
+// emitted.
+func (i *ForeignToplevelHandle) Close() error {
+	const opcode = 5
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleSetRectangleSinceVersion : version of ForeignToplevelHandle that introduced SetRectangle
+const ForeignToplevelHandleSetRectangleSinceVersion = 1
+
+// SetRectangle : the rectangle which represents the toplevel
+//
+// The rectangle of the surface specified in this request corresponds to
+// the place where the app using this protocol represents the given toplevel.
+// It can be used by the compositor as a hint for some operations, e.g
+// minimizing. The client is however not required to set this, in which
+// case the compositor is free to decide some default value.
+//
+// If the client specifies more than one rectangle, only the last one is
+// considered.
+//
+// The dimensions are given in surface-local coordinates.
+// Setting width=height=0 removes the already-set rectangle.
+func (i *ForeignToplevelHandle) SetRectangle(surface *client.Surface, x, y, width, height int32) error {
+	const opcode = 6
+	const _reqBufLen = 8 + 4 + 4 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], surface.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(x))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(y))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(width))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(height))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleDestroySinceVersion : version of ForeignToplevelHandle that introduced Destroy
+const ForeignToplevelHandleDestroySinceVersion = 1
+
+// Destroy : destroy the zwlr_foreign_toplevel_handle_v1 object
+//
+// Destroys the zwlr_foreign_toplevel_handle_v1 object.
+//
+// This request should be called either when the client does not want to
+// use the toplevel anymore or after the closed event to finalize the
+// destruction of the object.
+func (i *ForeignToplevelHandle) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 7
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleSetFullscreenSinceVersion : version of ForeignToplevelHandle that introduced SetFullscreen
+const ForeignToplevelHandleSetFullscreenSinceVersion = 2
+
+// SetFullscreen : request that the toplevel be fullscreened
+//
+// Requests that the toplevel be fullscreened on the given output. If the
+// fullscreen state and/or the outputs the toplevel is visible on actually
+// change, this will be indicated by the state and output_enter/leave
+// events.
+//
+// The output parameter is only a hint to the compositor. Also, if output
+// is NULL, the compositor should decide which output the toplevel will be
+// fullscreened on, if at all.
+func (i *ForeignToplevelHandle) SetFullscreen(output *client.Output) error {
+	if v := i.Version(); v < ForeignToplevelHandleSetFullscreenSinceVersion {
+		return &client.VersionError{Interface: ForeignToplevelHandleName, Request: "set_fullscreen", Since: ForeignToplevelHandleSetFullscreenSinceVersion, Version: v}
+	}
+	const opcode = 8
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	if output == nil {
+		client.PutUint32(_reqBuf[l:l+4], 0)
+		l += 4
+	} else {
+		client.PutUint32(_reqBuf[l:l+4], output.ID())
+		l += 4
+	}
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ForeignToplevelHandleUnsetFullscreenSinceVersion : version of ForeignToplevelHandle that introduced UnsetFullscreen
+const ForeignToplevelHandleUnsetFullscreenSinceVersion = 2
+
+// UnsetFullscreen : request that the toplevel be unfullscreened
+//
+// Requests that the toplevel be unfullscreened. If the fullscreen state
+// actually changes, this will be indicated by the state event.
+func (i *ForeignToplevelHandle) UnsetFullscreen() error {
+	if v := i.Version(); v < ForeignToplevelHandleUnsetFullscreenSinceVersion {
+		return &client.VersionError{Interface: ForeignToplevelHandleName, Request: "unset_fullscreen", Since: ForeignToplevelHandleUnsetFullscreenSinceVersion, Version: v}
+	}
+	const opcode = 9
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type ForeignToplevelHandleState uint32
+
+// ForeignToplevelHandleState : types of states on the toplevel
+//
+// The different states that a toplevel can have. These have the same meaning
+// as the states with the same names defined in xdg-toplevel
+const (
+	// ForeignToplevelHandleStateMaximized : the toplevel is maximized
+	ForeignToplevelHandleStateMaximized ForeignToplevelHandleState = 0
+	// ForeignToplevelHandleStateMinimized : the toplevel is minimized
+	ForeignToplevelHandleStateMinimized ForeignToplevelHandleState = 1
+	// ForeignToplevelHandleStateActivated : the toplevel is active
+	ForeignToplevelHandleStateActivated ForeignToplevelHandleState = 2
+	// ForeignToplevelHandleStateFullscreen : the toplevel is fullscreen
+	ForeignToplevelHandleStateFullscreen ForeignToplevelHandleState = 3
+)
+
+func (e ForeignToplevelHandleState) Name() string {
+	switch e {
+	case ForeignToplevelHandleStateMaximized:
+		return "maximized"
+	case ForeignToplevelHandleStateMinimized:
+		return "minimized"
+	case ForeignToplevelHandleStateActivated:
+		return "activated"
+	case ForeignToplevelHandleStateFullscreen:
+		return "fullscreen"
+	default:
+		return ""
+	}
+}
+
+func (e ForeignToplevelHandleState) Value() string {
+	switch e {
+	case ForeignToplevelHandleStateMaximized:
+		return "0"
+	case ForeignToplevelHandleStateMinimized:
+		return "1"
+	case ForeignToplevelHandleStateActivated:
+		return "2"
+	case ForeignToplevelHandleStateFullscreen:
+		return "3"
+	default:
+		return ""
+	}
+}
+
+func (e ForeignToplevelHandleState) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type ForeignToplevelHandleError uint32
+
+// ForeignToplevelHandleError :
+const (
+	// ForeignToplevelHandleErrorInvalidRectangle : the provided rectangle is invalid
+	ForeignToplevelHandleErrorInvalidRectangle ForeignToplevelHandleError = 0
+)
+
+func (e ForeignToplevelHandleError) Name() string {
+	switch e {
+	case ForeignToplevelHandleErrorInvalidRectangle:
+		return "invalid_rectangle"
+	default:
+		return ""
+	}
+}
+
+func (e ForeignToplevelHandleError) Value() string {
+	switch e {
+	case ForeignToplevelHandleErrorInvalidRectangle:
+		return "0"
+	default:
+		return ""
+	}
+}
+
+func (e ForeignToplevelHandleError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// ForeignToplevelHandleTitleEvent : title change
+//
+// This event is emitted whenever the title of the toplevel changes.
+type ForeignToplevelHandleTitleEvent struct {
+	Title string
+}
+
+// ForeignToplevelHandleTitleSinceVersion : version of ForeignToplevelHandle that introduced ForeignToplevelHandleTitleEvent
+const ForeignToplevelHandleTitleSinceVersion = 1
+
+type ForeignToplevelHandleTitleHandlerFunc func(ForeignToplevelHandleTitleEvent)
+
+// SetTitleHandler : sets handler for ForeignToplevelHandleTitleEvent
+func (i *ForeignToplevelHandle) SetTitleHandler(f ForeignToplevelHandleTitleHandlerFunc) {
+	i.titleHandler = f
+}
+
+// ForeignToplevelHandleAppIdEvent : app-id change
+//
+// This event is emitted whenever the app-id of the toplevel changes.
+type ForeignToplevelHandleAppIdEvent struct {
+	AppId string
+}
+
+// ForeignToplevelHandleAppIdSinceVersion : version of ForeignToplevelHandle that introduced ForeignToplevelHandleAppIdEvent
+const ForeignToplevelHandleAppIdSinceVersion = 1
+
+type ForeignToplevelHandleAppIdHandlerFunc func(ForeignToplevelHandleAppIdEvent)
+
+// SetAppIdHandler : sets handler for ForeignToplevelHandleAppIdEvent
+func (i *ForeignToplevelHandle) SetAppIdHandler(f ForeignToplevelHandleAppIdHandlerFunc) {
+	i.appIdHandler = f
+}
+
+// ForeignToplevelHandleOutputEnterEvent : toplevel entered an output
+//
+// This event is emitted whenever the toplevel becomes visible on
+// the given output. A toplevel may be visible on multiple outputs.
+type ForeignToplevelHandleOutputEnterEvent struct {
+	// Output is nil if the client destroyed the object
+	Output *client.Output
+}
+
+// ForeignToplevelHandleOutputEnterSinceVersion : version of ForeignToplevelHandle that introduced ForeignToplevelHandleOutputEnterEvent
+const ForeignToplevelHandleOutputEnterSinceVersion = 1
+
+type ForeignToplevelHandleOutputEnterHandlerFunc func(ForeignToplevelHandleOutputEnterEvent)
+
+// SetOutputEnterHandler : sets handler for ForeignToplevelHandleOutputEnterEvent
+func (i *ForeignToplevelHandle) SetOutputEnterHandler(f ForeignToplevelHandleOutputEnterHandlerFunc) {
+	i.outputEnterHandler = f
+}
+
+// ForeignToplevelHandleOutputLeaveEvent : toplevel left an output
+//
+// This event is emitted whenever the toplevel stops being visible on
+// the given output. It is guaranteed that an entered-output event
+// with the same output has been emitted before this event.
+type ForeignToplevelHandleOutputLeaveEvent struct {
+	// Output is nil if the client destroyed the object
+	Output *client.Output
+}
+
+// ForeignToplevelHandleOutputLeaveSinceVersion : version of ForeignToplevelHandle that introduced ForeignToplevelHandleOutputLeaveEvent
+const ForeignToplevelHandleOutputLeaveSinceVersion = 1
+
+type ForeignToplevelHandleOutputLeaveHandlerFunc func(ForeignToplevelHandleOutputLeaveEvent)
+
+// SetOutputLeaveHandler : sets handler for ForeignToplevelHandleOutputLeaveEvent
+func (i *ForeignToplevelHandle) SetOutputLeaveHandler(f ForeignToplevelHandleOutputLeaveHandlerFunc) {
+	i.outputLeaveHandler = f
+}
+
+// ForeignToplevelHandleStateEvent : the toplevel state changed
+//
+// This event is emitted immediately after the zlw_foreign_toplevel_handle_v1
+// is created and each time the toplevel state changes, either because of a
+// compositor action or because of a request in this protocol.
+type ForeignToplevelHandleStateEvent struct {
+	State []byte
+}
+
+// ForeignToplevelHandleStateSinceVersion : version of ForeignToplevelHandle that introduced ForeignToplevelHandleStateEvent
+const ForeignToplevelHandleStateSinceVersion = 1
+
+type ForeignToplevelHandleStateHandlerFunc func(ForeignToplevelHandleStateEvent)
+
+// SetStateHandler : sets handler for ForeignToplevelHandleStateEvent
+func (i *ForeignToplevelHandle) SetStateHandler(f ForeignToplevelHandleStateHandlerFunc) {
+	i.stateHandler = f
+}
+
+// ForeignToplevelHandleDoneEvent : all information about the toplevel has been sent
+//
+// This event is sent after all changes in the toplevel state have been
+// sent.
+//
+// This allows changes to the zwlr_foreign_toplevel_handle_v1 properties
+// to be seen as atomic, even if they happen via multiple events.
+type ForeignToplevelHandleDoneEvent struct{}
+
+// ForeignToplevelHandleDoneSinceVersion : version of ForeignToplevelHandle that introduced ForeignToplevelHandleDoneEvent
+const ForeignToplevelHandleDoneSinceVersion = 1
+
+type ForeignToplevelHandleDoneHandlerFunc func(ForeignToplevelHandleDoneEvent)
+
+// SetDoneHandler : sets handler for ForeignToplevelHandleDoneEvent
+func (i *ForeignToplevelHandle) SetDoneHandler(f ForeignToplevelHandleDoneHandlerFunc) {
+	i.doneHandler = f
+}
+
+// ForeignToplevelHandleClosedEvent : this toplevel has been destroyed
+//
+// This event means the toplevel has been destroyed. It is guaranteed there
+// won't be any more events for this zwlr_foreign_toplevel_handle_v1. The
+// toplevel itself becomes inert so any requests will be ignored except the
+// destroy request.
+type ForeignToplevelHandleClosedEvent struct{}
+
+// ForeignToplevelHandleClosedSinceVersion : version of ForeignToplevelHandle that introduced ForeignToplevelHandleClosedEvent
+const ForeignToplevelHandleClosedSinceVersion = 1
+
+type ForeignToplevelHandleClosedHandlerFunc func(ForeignToplevelHandleClosedEvent)
+
+// SetClosedHandler : sets handler for ForeignToplevelHandleClosedEvent
+func (i *ForeignToplevelHandle) SetClosedHandler(f ForeignToplevelHandleClosedHandlerFunc) {
+	i.closedHandler = f
+}
+
+// ForeignToplevelHandleParentEvent : parent change
+//
+// This event is emitted whenever the parent of the toplevel changes.
+//
+// No event is emitted when the parent handle is destroyed by the client.
+type ForeignToplevelHandleParentEvent struct {
+	// Parent is nil if it is null or the client destroyed the object
+	Parent *ForeignToplevelHandle
+}
+
+// ForeignToplevelHandleParentSinceVersion : version of ForeignToplevelHandle that introduced ForeignToplevelHandleParentEvent
+const ForeignToplevelHandleParentSinceVersion = 3
+
+type ForeignToplevelHandleParentHandlerFunc func(ForeignToplevelHandleParentEvent)
+
+// SetParentHandler : sets handler for ForeignToplevelHandleParentEvent
+func (i *ForeignToplevelHandle) SetParentHandler(f ForeignToplevelHandleParentHandlerFunc) {
+	i.parentHandler = f
+}
+
+func (i *ForeignToplevelHandle) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.titleHandler == nil {
+			return
+		}
+		var e ForeignToplevelHandleTitleEvent
+		l := 0
+		titleLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.Title = client.String(data[l : l+titleLen])
+		l += titleLen
+
+		i.titleHandler(e)
+	case 1:
+		if i.appIdHandler == nil {
+			return
+		}
+		var e ForeignToplevelHandleAppIdEvent
+		l := 0
+		appIdLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.AppId = client.String(data[l : l+appIdLen])
+		l += appIdLen
+
+		i.appIdHandler(e)
+	case 2:
+		if i.outputEnterHandler == nil {
+			return
+		}
+		var e ForeignToplevelHandleOutputEnterEvent
+		l := 0
+		e.Output, _ = i.Context().GetProxy(client.Uint32(data[l : l+4])).(*client.Output)
+		l += 4
+
+		i.outputEnterHandler(e)
+	case 3:
+		if i.outputLeaveHandler == nil {
+			return
+		}
+		var e ForeignToplevelHandleOutputLeaveEvent
+		l := 0
+		e.Output, _ = i.Context().GetProxy(client.Uint32(data[l : l+4])).(*client.Output)
+		l += 4
+
+		i.outputLeaveHandler(e)
+	case 4:
+		if i.stateHandler == nil {
+			return
+		}
+		var e ForeignToplevelHandleStateEvent
+		l := 0
+		stateLen := int(client.Uint32(data[l : l+4]))
+		l += 4
+		e.State = make([]byte, stateLen)
+		copy(e.State, data[l:l+stateLen])
+		l += stateLen
+
+		i.stateHandler(e)
+	case 5:
+		if i.doneHandler == nil {
+			return
+		}
+		var e ForeignToplevelHandleDoneEvent
+
+		i.doneHandler(e)
+	case 6:
+		if i.closedHandler == nil {
+			return
+		}
+		var e ForeignToplevelHandleClosedEvent
+
+		i.closedHandler(e)
+	case 7:
+		if i.parentHandler == nil {
+			return
+		}
+		var e ForeignToplevelHandleParentEvent
+		l := 0
+		e.Parent, _ = i.Context().GetProxy(client.Uint32(data[l : l+4])).(*ForeignToplevelHandle)
+		l += 4
+
+		i.parentHandler(e)
+	}
+}
+
+func init() {
+	client.RegisterInterface(ForeignToplevelManagerInterface)
+	client.RegisterInterface(ForeignToplevelHandleInterface)
+}
@@ -0,0 +1,7 @@
+// Package gamma_control is Go binding of the wlr-gamma-control-unstable-v1
+// protocol, used to set the gamma tables of outputs.
+//
+// Stability: unstable. wlr-protocols are maintained outside of
+// wayland-protocols and only implemented by wlroots based compositors,
+// backward incompatible changes bump the version in the interface names.
+package gamma_control
@@ -0,0 +1,356 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : wlr/gamma-control/wlr-gamma-control-unstable-v1.xml
+// XML sha256 : 4065cbc291a80348b7ef311168fbfb5cf245efe977a6dc32211291ef1a9529a1
+//
+// wlr_gamma_control_unstable_v1 Protocol Copyright:
+//
+// Copyright © 2015 Giulio camuffo
+// Copyright © 2018 Simon Ser
+//
+// Permission to use, copy, modify, distribute, and sell this
+// software and its documentation for any purpose is hereby granted
+// without fee, provided that the above copyright notice appear in
+// all copies and that both that copyright notice and this permission
+// notice appear in supporting documentation, and that the name of
+// the copyright holders not be used in advertising or publicity
+// pertaining to distribution of the software without specific,
+// written prior permission.  The copyright holders make no
+// representations about the suitability of this software for any
+// purpose.  It is provided "as is" without express or implied
+// warranty.
+//
+// THE COPYRIGHT HOLDERS DISCLAIM ALL WARRANTIES WITH REGARD TO THIS
+// SOFTWARE, INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND
+// FITNESS, IN NO EVENT SHALL THE COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// SPECIAL, INDIRECT OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN
+// AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION,
+// ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF
+// THIS SOFTWARE.
+
+package gamma_control
+
+import (
+	"github.com/hempflower/go-wayland/wayland/client"
+	"golang.org/x/sys/unix"
+)
+
+// GammaControlManagerName : manager to create per-output gamma controls
+const GammaControlManagerName = "zwlr_gamma_control_manager_v1"
+
+// GammaControlManagerInterface : metadata of the zwlr_gamma_control_manager_v1 interface
+var GammaControlManagerInterface = &client.Interface{
+	Name:    GammaControlManagerName,
+	Version: 1,
+	New:     func(ctx *client.Context) client.Proxy { return NewGammaControlManager(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "get_gamma_control",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwlr_gamma_control_v1"},
+				{Name: "output", Type: client.ArgTypeObject, Interface: "wl_output"},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns GammaControlManagerInterface
+func (i *GammaControlManager) Interface() *client.Interface {
+	return GammaControlManagerInterface
+}
+
+// GammaControlManager : manager to create per-output gamma controls
+//
+// This interface is a manager that allows creating per-output gamma
+// controls.
+type GammaControlManager struct {
+	client.BaseProxy
+}
+
+// NewGammaControlManager : manager to create per-output gamma controls
+//
+// This interface is a manager that allows creating per-output gamma
+// controls.
+func NewGammaControlManager(ctx *client.Context) *GammaControlManager {
+	zwlrGammaControlManagerV1 := &GammaControlManager{}
+	ctx.Register(zwlrGammaControlManagerV1)
+	return zwlrGammaControlManagerV1
+}
+
+// GammaControlManagerGetGammaControlSinceVersion : version of GammaControlManager that introduced GetGammaControl
+const GammaControlManagerGetGammaControlSinceVersion = 1
+
+// GetGammaControl : get a gamma control for an output
+//
+// Create a gamma control that can be used to adjust gamma tables for the
+// provided output.
+func (i *GammaControlManager) GetGammaControl(output *client.Output) (*GammaControl, error) {
+	id := NewGammaControl(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 0
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], output.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+// GammaControlManagerDestroySinceVersion : version of GammaControlManager that introduced Destroy
+const GammaControlManagerDestroySinceVersion = 1
+
+// Destroy : destroy the manager
+//
+// All objects created by the manager will still remain valid, until their
+// appropriate destroy request has been called.
+func (i *GammaControlManager) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// GammaControlName : adjust gamma tables for an output
+const GammaControlName = "zwlr_gamma_control_v1"
+
+// GammaControlInterface : metadata of the zwlr_gamma_control_v1 interface
+var GammaControlInterface = &client.Interface{
+	Name:    GammaControlName,
+	Version: 1,
+	New:     func(ctx *client.Context) client.Proxy { return NewGammaControl(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "set_gamma",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "fd", Type: client.ArgTypeFd},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "gamma_size",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "size", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "failed",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns GammaControlInterface
+func (i *GammaControl) Interface() *client.Interface {
+	return GammaControlInterface
+}
+
+// GammaControl : adjust gamma tables for an output
+//
+// This interface allows a client to adjust gamma tables for a particular
+// output.
+//
+// The client will receive the gamma size, and will then be able to set gamma
+// tables. At any time the compositor can send a failed event indicating that
+// this object is no longer valid.
+//
+// There can only be at most one gamma control object per output, which
+// has exclusive access to this particular output. When the gamma control
+// object is destroyed, the gamma table is restored to its original value.
+type GammaControl struct {
+	client.BaseProxy
+	gammaSizeHandler GammaControlGammaSizeHandlerFunc
+	failedHandler    GammaControlFailedHandlerFunc
+}
+
+// NewGammaControl : adjust gamma tables for an output
+//
+// This interface allows a client to adjust gamma tables for a particular
+// output.
+//
+// The client will receive the gamma size, and will then be able to set gamma
+// tables. At any time the compositor can send a failed event indicating that
+// this object is no longer valid.
+//
+// There can only be at most one gamma control object per output, which
+// has exclusive access to this particular output. When the gamma control
+// object is destroyed, the gamma table is restored to its original value.
+func NewGammaControl(ctx *client.Context) *GammaControl {
+	zwlrGammaControlV1 := &GammaControl{}
+	ctx.Register(zwlrGammaControlV1)
+	return zwlrGammaControlV1
+}
+
+// GammaControlSetGammaSinceVersion : version of GammaControl that introduced SetGamma
+const GammaControlSetGammaSinceVersion = 1
+
+// SetGamma : set the gamma table
+//
+// Set the gamma table. The file descriptor can be memory-mapped to provide
+// the raw gamma table, which contains successive gamma ramps for the red,
+// green and blue channels. Each gamma ramp is an array of 16-byte unsigned
+// integers which has the same length as the gamma size.
+//
+// The file descriptor data must have the same length as three times the
+// gamma size.
+//
+//	fd: gamma table file descriptor
+func (i *GammaControl) SetGamma(fd int) error {
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	oob := unix.UnixRights(int(fd))
+	err := i.Context().WriteMsg(_reqBuf[:], oob)
+	return err
+}
+
+// GammaControlDestroySinceVersion : version of GammaControl that introduced Destroy
+const GammaControlDestroySinceVersion = 1
+
+// Destroy : destroy this control
+//
+// Destroys the gamma control object. If the object is still valid, this
+// restores the original gamma tables.
+func (i *GammaControl) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type GammaControlError uint32
+
+// GammaControlError :
+const (
+	// GammaControlErrorInvalidGamma : invalid gamma tables
+	GammaControlErrorInvalidGamma GammaControlError = 1
+)
+
+func (e GammaControlError) Name() string {
+	switch e {
+	case GammaControlErrorInvalidGamma:
+		return "invalid_gamma"
+	default:
+		return ""
+	}
+}
+
+func (e GammaControlError) Value() string {
+	switch e {
+	case GammaControlErrorInvalidGamma:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+func (e GammaControlError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// GammaControlGammaSizeEvent : size of gamma ramps
+//
+// Advertise the size of each gamma ramp.
+//
+// This event is sent immediately when the gamma control object is created.
+type GammaControlGammaSizeEvent struct {
+	Size uint32
+}
+
+// GammaControlGammaSizeSinceVersion : version of GammaControl that introduced GammaControlGammaSizeEvent
+const GammaControlGammaSizeSinceVersion = 1
+
+type GammaControlGammaSizeHandlerFunc func(GammaControlGammaSizeEvent)
+
+// SetGammaSizeHandler : sets handler for GammaControlGammaSizeEvent
+func (i *GammaControl) SetGammaSizeHandler(f GammaControlGammaSizeHandlerFunc) {
+	i.gammaSizeHandler = f
+}
+
+// GammaControlFailedEvent : object no longer valid
+//
+// This event indicates that the gamma control is no longer valid. This
+// can happen for a number of reasons, including:
+// - The output doesn't support gamma tables
+// - Setting the gamma tables failed
+// - Another client already has exclusive gamma control for this output
+// - The compositor has transferred gamma control to another client
+//
+// Upon receiving this event, the client should destroy this object.
+type GammaControlFailedEvent struct{}
+
+// GammaControlFailedSinceVersion : version of GammaControl that introduced GammaControlFailedEvent
+const GammaControlFailedSinceVersion = 1
+
+type GammaControlFailedHandlerFunc func(GammaControlFailedEvent)
+
+// SetFailedHandler : sets handler for GammaControlFailedEvent
+func (i *GammaControl) SetFailedHandler(f GammaControlFailedHandlerFunc) {
+	i.failedHandler = f
+}
+
+func (i *GammaControl) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.gammaSizeHandler == nil {
+			return
+		}
+		var e GammaControlGammaSizeEvent
+		l := 0
+		e.Size = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.gammaSizeHandler(e)
+	case 1:
+		if i.failedHandler == nil {
+			return
+		}
+		var e GammaControlFailedEvent
+
+		i.failedHandler(e)
+	}
+}
+
+func init() {
+	client.RegisterInterface(GammaControlManagerInterface)
+	client.RegisterInterface(GammaControlInterface)
+}
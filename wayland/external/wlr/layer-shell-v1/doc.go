@@ -0,0 +1,7 @@
+// Package layer_shell is Go binding of the wlr-layer-shell-unstable-v1
+// protocol, used by panels, docks and backgrounds to create desktop layers.
+//
+// Stability: unstable. wlr-protocols are maintained outside of
+// wayland-protocols and only implemented by wlroots based compositors,
+// backward incompatible changes bump the version in the interface names.
+package layer_shell
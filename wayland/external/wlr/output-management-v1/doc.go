@@ -0,0 +1,8 @@
+// Package output_management is Go binding of the
+// wlr-output-management-unstable-v1 protocol, used to read and apply
+// output device configuration.
+//
+// Stability: unstable. wlr-protocols are maintained outside of
+// wayland-protocols and only implemented by wlroots based compositors,
+// backward incompatible changes bump the version in the interface names.
+package output_management
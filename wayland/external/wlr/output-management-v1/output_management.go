@@ -0,0 +1,1882 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : wlr/output-management/wlr-output-management-unstable-v1.xml
+// XML sha256 : 3ddd85b2e7b5d16889c37170ce264e4d388ecf52f703a3199f0731664b2d51de
+//
+// wlr_output_management_unstable_v1 Protocol Copyright:
+//
+// Copyright © 2019 Purism SPC
+//
+// Permission to use, copy, modify, distribute, and sell this
+// software and its documentation for any purpose is hereby granted
+// without fee, provided that the above copyright notice appear in
+// all copies and that both that copyright notice and this permission
+// notice appear in supporting documentation, and that the name of
+// the copyright holders not be used in advertising or publicity
+// pertaining to distribution of the software without specific,
+// written prior permission.  The copyright holders make no
+// representations about the suitability of this software for any
+// purpose.  It is provided "as is" without express or implied
+// warranty.
+//
+// THE COPYRIGHT HOLDERS DISCLAIM ALL WARRANTIES WITH REGARD TO THIS
+// SOFTWARE, INCLUDING ALL IMPLIED WARRANTIES OF MERCHANTABILITY AND
+// FITNESS, IN NO EVENT SHALL THE COPYRIGHT HOLDERS BE LIABLE FOR ANY
+// SPECIAL, INDIRECT OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN
+// AN ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION,
+// ARISING OUT OF OR IN CONNECTION WITH THE USE OR PERFORMANCE OF
+// THIS SOFTWARE.
+
+package output_management
+
+import "github.com/hempflower/go-wayland/wayland/client"
+
+// OutputManagerName : output device configuration manager
+const OutputManagerName = "zwlr_output_manager_v1"
+
+// OutputManagerInterface : metadata of the zwlr_output_manager_v1 interface
+var OutputManagerInterface = &client.Interface{
+	Name:    OutputManagerName,
+	Version: 4,
+	New:     func(ctx *client.Context) client.Proxy { return NewOutputManager(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "create_configuration",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwlr_output_configuration_v1"},
+				{Name: "serial", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "stop",
+			Since: 1,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "head",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "head", Type: client.ArgTypeNewID, Interface: "zwlr_output_head_v1"},
+			},
+		},
+		{
+			Name:  "done",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "serial", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "finished",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns OutputManagerInterface
+func (i *OutputManager) Interface() *client.Interface {
+	return OutputManagerInterface
+}
+
+// OutputManager : output device configuration manager
+//
+// This interface is a manager that allows reading and writing the current
+// output device configuration.
+//
+// Output devices that display pixels (e.g. a physical monitor or a virtual
+// output in a window) are represented as heads. Heads cannot be created nor
+// destroyed by the client, but they can be enabled or disabled and their
+// properties can be changed. Each head may have one or more available modes.
+//
+// Whenever a head appears (e.g. a monitor is plugged in), it will be
+// advertised via the head event. Immediately after the output manager is
+// bound, all current heads are advertised.
+//
+// Whenever a head's properties change, the relevant wlr_output_head events
+// will be sent. Not all head properties will be sent: only properties that
+// have changed need to.
+//
+// Whenever a head disappears (e.g. a monitor is unplugged), a
+// wlr_output_head.finished event will be sent.
+//
+// After one or more heads appear, change or disappear, the done event will
+// be sent. It carries a serial which can be used in a create_configuration
+// request to update heads properties.
+//
+// The information obtained from this protocol should only be used for output
+// configuration purposes. This protocol is not designed to be a generic
+// output property advertisement protocol for regular clients. Instead,
+// protocols such as xdg-output should be used.
+type OutputManager struct {
+	client.BaseProxy
+	headHandler     OutputManagerHeadHandlerFunc
+	doneHandler     OutputManagerDoneHandlerFunc
+	finishedHandler OutputManagerFinishedHandlerFunc
+}
+
+// NewOutputManager : output device configuration manager
+//
+// This interface is a manager that allows reading and writing the current
+// output device configuration.
+//
+// Output devices that display pixels (e.g. a physical monitor or a virtual
+// output in a window) are represented as heads. Heads cannot be created nor
+// destroyed by the client, but they can be enabled or disabled and their
+// properties can be changed. Each head may have one or more available modes.
+//
+// Whenever a head appears (e.g. a monitor is plugged in), it will be
+// advertised via the head event. Immediately after the output manager is
+// bound, all current heads are advertised.
+//
+// Whenever a head's properties change, the relevant wlr_output_head events
+// will be sent. Not all head properties will be sent: only properties that
+// have changed need to.
+//
+// Whenever a head disappears (e.g. a monitor is unplugged), a
+// wlr_output_head.finished event will be sent.
+//
+// After one or more heads appear, change or disappear, the done event will
+// be sent. It carries a serial which can be used in a create_configuration
+// request to update heads properties.
+//
+// The information obtained from this protocol should only be used for output
+// configuration purposes. This protocol is not designed to be a generic
+// output property advertisement protocol for regular clients. Instead,
+// protocols such as xdg-output should be used.
+func NewOutputManager(ctx *client.Context) *OutputManager {
+	zwlrOutputManagerV1 := &OutputManager{}
+	ctx.Register(zwlrOutputManagerV1)
+	return zwlrOutputManagerV1
+}
+
+// OutputManagerCreateConfigurationSinceVersion : version of OutputManager that introduced CreateConfiguration
+const OutputManagerCreateConfigurationSinceVersion = 1
+
+// CreateConfiguration : create a new output configuration object
+//
+// Create a new output configuration object. This allows to update head
+// properties.
+func (i *OutputManager) CreateConfiguration(serial uint32) (*OutputConfiguration, error) {
+	id := NewOutputConfiguration(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 0
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(serial))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+// OutputManagerStopSinceVersion : version of OutputManager that introduced Stop
+const OutputManagerStopSinceVersion = 1
+
+// Stop : stop sending events
+//
+// Indicates the client no longer wishes to receive events for output
+// configuration changes. However the compositor may emit further events,
+// until the finished event is emitted.
+//
+// The client must not send any more requests after this one.
+func (i *OutputManager) Stop() error {
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+func (i *OutputManager) Destroy() error {
+	i.Context().Unregister(i)
+	return nil
+}
+
+// OutputManagerHeadEvent : introduce a new head
+//
+// This event introduces a new head. This happens whenever a new head
+// appears (e.g. a monitor is plugged in) or after the output manager is
+// bound.
+type OutputManagerHeadEvent struct {
+	Head *OutputHead
+}
+
+// OutputManagerHeadSinceVersion : version of OutputManager that introduced OutputManagerHeadEvent
+const OutputManagerHeadSinceVersion = 1
+
+type OutputManagerHeadHandlerFunc func(OutputManagerHeadEvent)
+
+// SetHeadHandler : sets handler for OutputManagerHeadEvent
+func (i *OutputManager) SetHeadHandler(f OutputManagerHeadHandlerFunc) {
+	i.headHandler = f
+}
+
+// OutputManagerDoneEvent : sent all information about current configuration
+//
+// This event is sent after all information has been sent after binding to
+// the output manager object and after any subsequent changes. This applies
+// to child head and mode objects as well. In other words, this event is
+// sent whenever a head or mode is created or destroyed and whenever one of
+// their properties has been changed. Not all state is re-sent each time
+// the current configuration changes: only the actual changes are sent.
+//
+// This allows changes to the output configuration to be seen as atomic,
+// even if they happen via multiple events.
+//
+// A serial is sent to be used in a future create_configuration request.
+type OutputManagerDoneEvent struct {
+	Serial uint32
+}
+
+// OutputManagerDoneSinceVersion : version of OutputManager that introduced OutputManagerDoneEvent
+const OutputManagerDoneSinceVersion = 1
+
+type OutputManagerDoneHandlerFunc func(OutputManagerDoneEvent)
+
+// SetDoneHandler : sets handler for OutputManagerDoneEvent
+func (i *OutputManager) SetDoneHandler(f OutputManagerDoneHandlerFunc) {
+	i.doneHandler = f
+}
+
+// OutputManagerFinishedEvent : the compositor has finished with the manager
+//
+// This event indicates that the compositor is done sending manager events.
+// The compositor will destroy the object immediately after sending this
+// event, so it will become invalid and the client should release any
+// resources associated with it.
+type OutputManagerFinishedEvent struct{}
+
+// OutputManagerFinishedSinceVersion : version of OutputManager that introduced OutputManagerFinishedEvent
+const OutputManagerFinishedSinceVersion = 1
+
+type OutputManagerFinishedHandlerFunc func(OutputManagerFinishedEvent)
+
+// SetFinishedHandler : sets handler for OutputManagerFinishedEvent
+func (i *OutputManager) SetFinishedHandler(f OutputManagerFinishedHandlerFunc) {
+	i.finishedHandler = f
+}
+
+func (i *OutputManager) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		var e OutputManagerHeadEvent
+		l := 0
+		head := &OutputHead{}
+		i.Context().SetProxy(client.Uint32(data[l:l+4]), head)
+		head.SetVersion(i.Version())
+		e.Head = head
+		l += 4
+		if i.headHandler == nil {
+			return
+		}
+
+		i.headHandler(e)
+	case 1:
+		if i.doneHandler == nil {
+			return
+		}
+		var e OutputManagerDoneEvent
+		l := 0
+		e.Serial = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.doneHandler(e)
+	case 2:
+		if i.finishedHandler == nil {
+			return
+		}
+		var e OutputManagerFinishedEvent
+
+		i.finishedHandler(e)
+	}
+}
+
+// OutputHeadName : output device
+const OutputHeadName = "zwlr_output_head_v1"
+
+// OutputHeadInterface : metadata of the zwlr_output_head_v1 interface
+var OutputHeadInterface = &client.Interface{
+	Name:    OutputHeadName,
+	Version: 4,
+	New:     func(ctx *client.Context) client.Proxy { return NewOutputHead(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "release",
+			Since: 3,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "name",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "name", Type: client.ArgTypeString},
+			},
+		},
+		{
+			Name:  "description",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "description", Type: client.ArgTypeString},
+			},
+		},
+		{
+			Name:  "physical_size",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "width", Type: client.ArgTypeInt},
+				{Name: "height", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "mode",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "mode", Type: client.ArgTypeNewID, Interface: "zwlr_output_mode_v1"},
+			},
+		},
+		{
+			Name:  "enabled",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "enabled", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "current_mode",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "mode", Type: client.ArgTypeObject, Interface: "zwlr_output_mode_v1"},
+			},
+		},
+		{
+			Name:  "position",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "x", Type: client.ArgTypeInt},
+				{Name: "y", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "transform",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "transform", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "scale",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "scale", Type: client.ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "finished",
+			Since: 1,
+		},
+		{
+			Name:  "make",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "make", Type: client.ArgTypeString},
+			},
+		},
+		{
+			Name:  "model",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "model", Type: client.ArgTypeString},
+			},
+		},
+		{
+			Name:  "serial_number",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "serial_number", Type: client.ArgTypeString},
+			},
+		},
+		{
+			Name:  "adaptive_sync",
+			Since: 4,
+			Args: []client.Arg{
+				{Name: "state", Type: client.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns OutputHeadInterface
+func (i *OutputHead) Interface() *client.Interface {
+	return OutputHeadInterface
+}
+
+// OutputHead : output device
+//
+// A head is an output device. The difference between a wl_output object and
+// a head is that heads are advertised even if they are turned off. A head
+// object only advertises properties and cannot be used directly to change
+// them.
+//
+// A head has some read-only properties: modes, name, description and
+// physical_size. These cannot be changed by clients.
+//
+// Other properties can be updated via a wlr_output_configuration object.
+//
+// Properties sent via this interface are applied atomically via the
+// wlr_output_manager.done event. No guarantees are made regarding the order
+// in which properties are sent.
+type OutputHead struct {
+	client.BaseProxy
+	nameHandler         OutputHeadNameHandlerFunc
+	descriptionHandler  OutputHeadDescriptionHandlerFunc
+	physicalSizeHandler OutputHeadPhysicalSizeHandlerFunc
+	modeHandler         OutputHeadModeHandlerFunc
+	enabledHandler      OutputHeadEnabledHandlerFunc
+	currentModeHandler  OutputHeadCurrentModeHandlerFunc
+	positionHandler     OutputHeadPositionHandlerFunc
+	transformHandler    OutputHeadTransformHandlerFunc
+	scaleHandler        OutputHeadScaleHandlerFunc
+	finishedHandler     OutputHeadFinishedHandlerFunc
+	makeHandler         OutputHeadMakeHandlerFunc
+	modelHandler        OutputHeadModelHandlerFunc
+	serialNumberHandler OutputHeadSerialNumberHandlerFunc
+	adaptiveSyncHandler OutputHeadAdaptiveSyncHandlerFunc
+}
+
+// NewOutputHead : output device
+//
+// A head is an output device. The difference between a wl_output object and
+// a head is that heads are advertised even if they are turned off. A head
+// object only advertises properties and cannot be used directly to change
+// them.
+//
+// A head has some read-only properties: modes, name, description and
+// physical_size. These cannot be changed by clients.
+//
+// Other properties can be updated via a wlr_output_configuration object.
+//
+// Properties sent via this interface are applied atomically via the
+// wlr_output_manager.done event. No guarantees are made regarding the order
+// in which properties are sent.
+func NewOutputHead(ctx *client.Context) *OutputHead {
+	zwlrOutputHeadV1 := &OutputHead{}
+	ctx.Register(zwlrOutputHeadV1)
+	return zwlrOutputHeadV1
+}
+
+// OutputHeadReleaseSinceVersion : version of OutputHead that introduced Release
+const OutputHeadReleaseSinceVersion = 3
+
+// Release : destroy the head object
+//
+// This request indicates that the client will no longer use this head
+// object.
+func (i *OutputHead) Release() error {
+	if v := i.Version(); v < OutputHeadReleaseSinceVersion {
+		return &client.VersionError{Interface: OutputHeadName, Request: "release", Since: OutputHeadReleaseSinceVersion, Version: v}
+	}
+	defer i.Context().Unregister(i)
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type OutputHeadAdaptiveSyncState uint32
+
+// OutputHeadAdaptiveSyncState :
+const (
+	// OutputHeadAdaptiveSyncStateDisabled : adaptive sync is disabled
+	OutputHeadAdaptiveSyncStateDisabled OutputHeadAdaptiveSyncState = 0
+	// OutputHeadAdaptiveSyncStateEnabled : adaptive sync is enabled
+	OutputHeadAdaptiveSyncStateEnabled OutputHeadAdaptiveSyncState = 1
+)
+
+func (e OutputHeadAdaptiveSyncState) Name() string {
+	switch e {
+	case OutputHeadAdaptiveSyncStateDisabled:
+		return "disabled"
+	case OutputHeadAdaptiveSyncStateEnabled:
+		return "enabled"
+	default:
+		return ""
+	}
+}
+
+func (e OutputHeadAdaptiveSyncState) Value() string {
+	switch e {
+	case OutputHeadAdaptiveSyncStateDisabled:
+		return "0"
+	case OutputHeadAdaptiveSyncStateEnabled:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+func (e OutputHeadAdaptiveSyncState) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// OutputHeadNameEvent : head name
+//
+// This event describes the head name.
+//
+// The naming convention is compositor defined, but limited to alphanumeric
+// characters and dashes (-). Each name is unique among all wlr_output_head
+// objects, but if a wlr_output_head object is destroyed the same name may
+// be reused later. The names will also remain consistent across sessions
+// with the same hardware and software configuration.
+//
+// If the compositor implements the xdg-output protocol and this head is
+// enabled, the xdg_output.name event must report the same name.
+//
+// The name event is sent after a wlr_output_head object is created. This
+// event is only sent once per object, and the name does not change over
+// the lifetime of the wlr_output_head object.
+type OutputHeadNameEvent struct {
+	Name string
+}
+
+// OutputHeadNameSinceVersion : version of OutputHead that introduced OutputHeadNameEvent
+const OutputHeadNameSinceVersion = 1
+
+type OutputHeadNameHandlerFunc func(OutputHeadNameEvent)
+
+// SetNameHandler : sets handler for OutputHeadNameEvent
+func (i *OutputHead) SetNameHandler(f OutputHeadNameHandlerFunc) {
+	i.nameHandler = f
+}
+
+// OutputHeadDescriptionEvent : head description
+//
+// This event describes a human-readable description of the head.
+//
+// The description is a UTF-8 string with no convention defined for its
+// contents. Examples might include 'Foocorp 11" Display' or 'Virtual X11
+// output via :1'. However, do not assume that the name is a reflection of
+// the make, model, serial of the underlying DRM connector or the display
+// name of the underlying X11 connection, etc.
+//
+// The description event is sent after a wlr_output_head object is created.
+// This event is only sent once per object, and the description does not
+// change over the lifetime of the wlr_output_head object.
+type OutputHeadDescriptionEvent struct {
+	Description string
+}
+
+// OutputHeadDescriptionSinceVersion : version of OutputHead that introduced OutputHeadDescriptionEvent
+const OutputHeadDescriptionSinceVersion = 1
+
+type OutputHeadDescriptionHandlerFunc func(OutputHeadDescriptionEvent)
+
+// SetDescriptionHandler : sets handler for OutputHeadDescriptionEvent
+func (i *OutputHead) SetDescriptionHandler(f OutputHeadDescriptionHandlerFunc) {
+	i.descriptionHandler = f
+}
+
+// OutputHeadPhysicalSizeEvent : head physical size
+//
+// This event describes the physical size of the head. This event is only
+// sent if the head has a physical size (e.g. is not a projector or a
+// virtual device).
+//
+// The physical size event is sent after a wlr_output_head object is created.
+// This event is only sent once per object, and the physical size does not
+// change over the lifetime of the wlr_output_head object.
+type OutputHeadPhysicalSizeEvent struct {
+	Width  int32
+	Height int32
+}
+
+// OutputHeadPhysicalSizeSinceVersion : version of OutputHead that introduced OutputHeadPhysicalSizeEvent
+const OutputHeadPhysicalSizeSinceVersion = 1
+
+type OutputHeadPhysicalSizeHandlerFunc func(OutputHeadPhysicalSizeEvent)
+
+// SetPhysicalSizeHandler : sets handler for OutputHeadPhysicalSizeEvent
+func (i *OutputHead) SetPhysicalSizeHandler(f OutputHeadPhysicalSizeHandlerFunc) {
+	i.physicalSizeHandler = f
+}
+
+// OutputHeadModeEvent : introduce a mode
+//
+// This event introduces a mode for this head. It is sent once per
+// supported mode.
+type OutputHeadModeEvent struct {
+	Mode *OutputMode
+}
+
+// OutputHeadModeSinceVersion : version of OutputHead that introduced OutputHeadModeEvent
+const OutputHeadModeSinceVersion = 1
+
+type OutputHeadModeHandlerFunc func(OutputHeadModeEvent)
+
+// SetModeHandler : sets handler for OutputHeadModeEvent
+func (i *OutputHead) SetModeHandler(f OutputHeadModeHandlerFunc) {
+	i.modeHandler = f
+}
+
+// OutputHeadEnabledEvent : head is enabled or disabled
+//
+// This event describes whether the head is enabled. A disabled head is not
+// mapped to a region of the global compositor space.
+//
+// When a head is disabled, some properties (current_mode, position,
+// transform and scale) are irrelevant.
+type OutputHeadEnabledEvent struct {
+	Enabled int32
+}
+
+// OutputHeadEnabledSinceVersion : version of OutputHead that introduced OutputHeadEnabledEvent
+const OutputHeadEnabledSinceVersion = 1
+
+type OutputHeadEnabledHandlerFunc func(OutputHeadEnabledEvent)
+
+// SetEnabledHandler : sets handler for OutputHeadEnabledEvent
+func (i *OutputHead) SetEnabledHandler(f OutputHeadEnabledHandlerFunc) {
+	i.enabledHandler = f
+}
+
+// OutputHeadCurrentModeEvent : current mode
+//
+// This event describes the mode currently in use for this head. It is only
+// sent if the output is enabled.
+type OutputHeadCurrentModeEvent struct {
+	// Mode is nil if the client destroyed the object
+	Mode *OutputMode
+}
+
+// OutputHeadCurrentModeSinceVersion : version of OutputHead that introduced OutputHeadCurrentModeEvent
+const OutputHeadCurrentModeSinceVersion = 1
+
+type OutputHeadCurrentModeHandlerFunc func(OutputHeadCurrentModeEvent)
+
+// SetCurrentModeHandler : sets handler for OutputHeadCurrentModeEvent
+func (i *OutputHead) SetCurrentModeHandler(f OutputHeadCurrentModeHandlerFunc) {
+	i.currentModeHandler = f
+}
+
+// OutputHeadPositionEvent : current position
+//
+// This events describes the position of the head in the global compositor
+// space. It is only sent if the output is enabled.
+type OutputHeadPositionEvent struct {
+	X int32
+	Y int32
+}
+
+// OutputHeadPositionSinceVersion : version of OutputHead that introduced OutputHeadPositionEvent
+const OutputHeadPositionSinceVersion = 1
+
+type OutputHeadPositionHandlerFunc func(OutputHeadPositionEvent)
+
+// SetPositionHandler : sets handler for OutputHeadPositionEvent
+func (i *OutputHead) SetPositionHandler(f OutputHeadPositionHandlerFunc) {
+	i.positionHandler = f
+}
+
+// OutputHeadTransformEvent : current transformation
+//
+// This event describes the transformation currently applied to the head.
+// It is only sent if the output is enabled.
+type OutputHeadTransformEvent struct {
+	Transform client.OutputTransform
+}
+
+// OutputHeadTransformSinceVersion : version of OutputHead that introduced OutputHeadTransformEvent
+const OutputHeadTransformSinceVersion = 1
+
+type OutputHeadTransformHandlerFunc func(OutputHeadTransformEvent)
+
+// SetTransformHandler : sets handler for OutputHeadTransformEvent
+func (i *OutputHead) SetTransformHandler(f OutputHeadTransformHandlerFunc) {
+	i.transformHandler = f
+}
+
+// OutputHeadScaleEvent : current scale
+//
+// This events describes the scale of the head in the global compositor
+// space. It is only sent if the output is enabled.
+type OutputHeadScaleEvent struct {
+	Scale float64
+}
+
+// OutputHeadScaleSinceVersion : version of OutputHead that introduced OutputHeadScaleEvent
+const OutputHeadScaleSinceVersion = 1
+
+type OutputHeadScaleHandlerFunc func(OutputHeadScaleEvent)
+
+// SetScaleHandler : sets handler for OutputHeadScaleEvent
+func (i *OutputHead) SetScaleHandler(f OutputHeadScaleHandlerFunc) {
+	i.scaleHandler = f
+}
+
+// OutputHeadFinishedEvent : the head has disappeared
+//
+// This event indicates that the head is no longer available. The head
+// object becomes inert. Clients should send a destroy request and release
+// any resources associated with it.
+type OutputHeadFinishedEvent struct{}
+
+// OutputHeadFinishedSinceVersion : version of OutputHead that introduced OutputHeadFinishedEvent
+const OutputHeadFinishedSinceVersion = 1
+
+type OutputHeadFinishedHandlerFunc func(OutputHeadFinishedEvent)
+
+// SetFinishedHandler : sets handler for OutputHeadFinishedEvent
+func (i *OutputHead) SetFinishedHandler(f OutputHeadFinishedHandlerFunc) {
+	i.finishedHandler = f
+}
+
+// OutputHeadMakeEvent : head manufacturer
+//
+// This event describes the manufacturer of the head.
+//
+// This must report the same make as the wl_output interface does in its
+// geometry event.
+//
+// The make event is sent after a wlr_output_head object is created and
+// only sent once per object. The make does not change over the lifetime
+// of the wlr_output_head object.
+//
+// It is not guaranteed this event will be ever sent. A reason for that
+// can be that the compositor does not have information about the make of
+// the head or the definition of the make is not sensible in the current
+// setup.
+type OutputHeadMakeEvent struct {
+	Make string
+}
+
+// OutputHeadMakeSinceVersion : version of OutputHead that introduced OutputHeadMakeEvent
+const OutputHeadMakeSinceVersion = 2
+
+type OutputHeadMakeHandlerFunc func(OutputHeadMakeEvent)
+
+// SetMakeHandler : sets handler for OutputHeadMakeEvent
+func (i *OutputHead) SetMakeHandler(f OutputHeadMakeHandlerFunc) {
+	i.makeHandler = f
+}
+
+// OutputHeadModelEvent : head model
+//
+// This event describes the model of the head.
+//
+// This must report the same model as the wl_output interface does in its
+// geometry event.
+//
+// The model event is sent after a wlr_output_head object is created and
+// only sent once per object. The model does not change over the lifetime
+// of the wlr_output_head object.
+//
+// It is not guaranteed this event will be ever sent.
+type OutputHeadModelEvent struct {
+	Model string
+}
+
+// OutputHeadModelSinceVersion : version of OutputHead that introduced OutputHeadModelEvent
+const OutputHeadModelSinceVersion = 2
+
+type OutputHeadModelHandlerFunc func(OutputHeadModelEvent)
+
+// SetModelHandler : sets handler for OutputHeadModelEvent
+func (i *OutputHead) SetModelHandler(f OutputHeadModelHandlerFunc) {
+	i.modelHandler = f
+}
+
+// OutputHeadSerialNumberEvent : head serial number
+//
+// This event describes the serial number of the head.
+//
+// Together with the make and model events the purpose is to allow clients
+// to recognize heads from previous sessions and for example load head-
+// specific configurations back.
+//
+// It is not guaranteed this event will be ever sent.
+type OutputHeadSerialNumberEvent struct {
+	SerialNumber string
+}
+
+// OutputHeadSerialNumberSinceVersion : version of OutputHead that introduced OutputHeadSerialNumberEvent
+const OutputHeadSerialNumberSinceVersion = 2
+
+type OutputHeadSerialNumberHandlerFunc func(OutputHeadSerialNumberEvent)
+
+// SetSerialNumberHandler : sets handler for OutputHeadSerialNumberEvent
+func (i *OutputHead) SetSerialNumberHandler(f OutputHeadSerialNumberHandlerFunc) {
+	i.serialNumberHandler = f
+}
+
+// OutputHeadAdaptiveSyncEvent : current adaptive sync state
+//
+// This event describes whether adaptive sync is currently enabled for
+// the head or not. Adaptive sync is also known as Variable Refresh
+// Rate or VRR.
+type OutputHeadAdaptiveSyncEvent struct {
+	State OutputHeadAdaptiveSyncState
+}
+
+// OutputHeadAdaptiveSyncSinceVersion : version of OutputHead that introduced OutputHeadAdaptiveSyncEvent
+const OutputHeadAdaptiveSyncSinceVersion = 4
+
+type OutputHeadAdaptiveSyncHandlerFunc func(OutputHeadAdaptiveSyncEvent)
+
+// SetAdaptiveSyncHandler : sets handler for OutputHeadAdaptiveSyncEvent
+func (i *OutputHead) SetAdaptiveSyncHandler(f OutputHeadAdaptiveSyncHandlerFunc) {
+	i.adaptiveSyncHandler = f
+}
+
+func (i *OutputHead) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.nameHandler == nil {
+			return
+		}
+		var e OutputHeadNameEvent
+		l := 0
+		nameLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.Name = client.String(data[l : l+nameLen])
+		l += nameLen
+
+		i.nameHandler(e)
+	case 1:
+		if i.descriptionHandler == nil {
+			return
+		}
+		var e OutputHeadDescriptionEvent
+		l := 0
+		descriptionLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.Description = client.String(data[l : l+descriptionLen])
+		l += descriptionLen
+
+		i.descriptionHandler(e)
+	case 2:
+		if i.physicalSizeHandler == nil {
+			return
+		}
+		var e OutputHeadPhysicalSizeEvent
+		l := 0
+		e.Width = int32(client.Uint32(data[l : l+4]))
+		l += 4
+		e.Height = int32(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.physicalSizeHandler(e)
+	case 3:
+		var e OutputHeadModeEvent
+		l := 0
+		mode := &OutputMode{}
+		i.Context().SetProxy(client.Uint32(data[l:l+4]), mode)
+		mode.SetVersion(i.Version())
+		e.Mode = mode
+		l += 4
+		if i.modeHandler == nil {
+			return
+		}
+
+		i.modeHandler(e)
+	case 4:
+		if i.enabledHandler == nil {
+			return
+		}
+		var e OutputHeadEnabledEvent
+		l := 0
+		e.Enabled = int32(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.enabledHandler(e)
+	case 5:
+		if i.currentModeHandler == nil {
+			return
+		}
+		var e OutputHeadCurrentModeEvent
+		l := 0
+		e.Mode, _ = i.Context().GetProxy(client.Uint32(data[l : l+4])).(*OutputMode)
+		l += 4
+
+		i.currentModeHandler(e)
+	case 6:
+		if i.positionHandler == nil {
+			return
+		}
+		var e OutputHeadPositionEvent
+		l := 0
+		e.X = int32(client.Uint32(data[l : l+4]))
+		l += 4
+		e.Y = int32(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.positionHandler(e)
+	case 7:
+		if i.transformHandler == nil {
+			return
+		}
+		var e OutputHeadTransformEvent
+		l := 0
+		e.Transform = client.OutputTransform(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.transformHandler(e)
+	case 8:
+		if i.scaleHandler == nil {
+			return
+		}
+		var e OutputHeadScaleEvent
+		l := 0
+		e.Scale = client.Fixed(data[l : l+4])
+		l += 4
+
+		i.scaleHandler(e)
+	case 9:
+		if i.finishedHandler == nil {
+			return
+		}
+		var e OutputHeadFinishedEvent
+
+		i.finishedHandler(e)
+	case 10:
+		if i.makeHandler == nil {
+			return
+		}
+		var e OutputHeadMakeEvent
+		l := 0
+		makeLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.Make = client.String(data[l : l+makeLen])
+		l += makeLen
+
+		i.makeHandler(e)
+	case 11:
+		if i.modelHandler == nil {
+			return
+		}
+		var e OutputHeadModelEvent
+		l := 0
+		modelLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.Model = client.String(data[l : l+modelLen])
+		l += modelLen
+
+		i.modelHandler(e)
+	case 12:
+		if i.serialNumberHandler == nil {
+			return
+		}
+		var e OutputHeadSerialNumberEvent
+		l := 0
+		serialNumberLen := client.PaddedLen(int(client.Uint32(data[l : l+4])))
+		l += 4
+		e.SerialNumber = client.String(data[l : l+serialNumberLen])
+		l += serialNumberLen
+
+		i.serialNumberHandler(e)
+	case 13:
+		if i.adaptiveSyncHandler == nil {
+			return
+		}
+		var e OutputHeadAdaptiveSyncEvent
+		l := 0
+		e.State = OutputHeadAdaptiveSyncState(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.adaptiveSyncHandler(e)
+	}
+}
+
+// OutputModeName : output mode
+const OutputModeName = "zwlr_output_mode_v1"
+
+// OutputModeInterface : metadata of the zwlr_output_mode_v1 interface
+var OutputModeInterface = &client.Interface{
+	Name:    OutputModeName,
+	Version: 3,
+	New:     func(ctx *client.Context) client.Proxy { return NewOutputMode(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "release",
+			Since: 3,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "size",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "width", Type: client.ArgTypeInt},
+				{Name: "height", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "refresh",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "refresh", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "preferred",
+			Since: 1,
+		},
+		{
+			Name:  "finished",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns OutputModeInterface
+func (i *OutputMode) Interface() *client.Interface {
+	return OutputModeInterface
+}
+
+// OutputMode : output mode
+//
+// This object describes an output mode.
+//
+// Some heads don't support output modes, in which case modes won't be
+// advertised.
+//
+// Properties sent via this interface are applied atomically via the
+// wlr_output_manager.done event. No guarantees are made regarding the order
+// in which properties are sent.
+type OutputMode struct {
+	client.BaseProxy
+	sizeHandler      OutputModeSizeHandlerFunc
+	refreshHandler   OutputModeRefreshHandlerFunc
+	preferredHandler OutputModePreferredHandlerFunc
+	finishedHandler  OutputModeFinishedHandlerFunc
+}
+
+// NewOutputMode : output mode
+//
+// This object describes an output mode.
+//
+// Some heads don't support output modes, in which case modes won't be
+// advertised.
+//
+// Properties sent via this interface are applied atomically via the
+// wlr_output_manager.done event. No guarantees are made regarding the order
+// in which properties are sent.
+func NewOutputMode(ctx *client.Context) *OutputMode {
+	zwlrOutputModeV1 := &OutputMode{}
+	ctx.Register(zwlrOutputModeV1)
+	return zwlrOutputModeV1
+}
+
+// OutputModeReleaseSinceVersion : version of OutputMode that introduced Release
+const OutputModeReleaseSinceVersion = 3
+
+// Release : destroy the mode object
+//
+// This request indicates that the client will no longer use this mode
+// object.
+func (i *OutputMode) Release() error {
+	if v := i.Version(); v < OutputModeReleaseSinceVersion {
+		return &client.VersionError{Interface: OutputModeName, Request: "release", Since: OutputModeReleaseSinceVersion, Version: v}
+	}
+	defer i.Context().Unregister(i)
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputModeSizeEvent : mode size
+//
+// This event describes the mode size. The size is given in physical
+// hardware units of the output device. This is not necessarily the same as
+// the output size in the global compositor space. For instance, the output
+// may be scaled or transformed.
+type OutputModeSizeEvent struct {
+	Width  int32
+	Height int32
+}
+
+// OutputModeSizeSinceVersion : version of OutputMode that introduced OutputModeSizeEvent
+const OutputModeSizeSinceVersion = 1
+
+type OutputModeSizeHandlerFunc func(OutputModeSizeEvent)
+
+// SetSizeHandler : sets handler for OutputModeSizeEvent
+func (i *OutputMode) SetSizeHandler(f OutputModeSizeHandlerFunc) {
+	i.sizeHandler = f
+}
+
+// OutputModeRefreshEvent : mode refresh rate
+//
+// This event describes the mode's fixed vertical refresh rate. It is only
+// sent if the mode has a fixed refresh rate.
+type OutputModeRefreshEvent struct {
+	Refresh int32
+}
+
+// OutputModeRefreshSinceVersion : version of OutputMode that introduced OutputModeRefreshEvent
+const OutputModeRefreshSinceVersion = 1
+
+type OutputModeRefreshHandlerFunc func(OutputModeRefreshEvent)
+
+// SetRefreshHandler : sets handler for OutputModeRefreshEvent
+func (i *OutputMode) SetRefreshHandler(f OutputModeRefreshHandlerFunc) {
+	i.refreshHandler = f
+}
+
+// OutputModePreferredEvent : mode is preferred
+//
+// This event advertises this mode as preferred.
+type OutputModePreferredEvent struct{}
+
+// OutputModePreferredSinceVersion : version of OutputMode that introduced OutputModePreferredEvent
+const OutputModePreferredSinceVersion = 1
+
+type OutputModePreferredHandlerFunc func(OutputModePreferredEvent)
+
+// SetPreferredHandler : sets handler for OutputModePreferredEvent
+func (i *OutputMode) SetPreferredHandler(f OutputModePreferredHandlerFunc) {
+	i.preferredHandler = f
+}
+
+// OutputModeFinishedEvent : the mode has disappeared
+//
+// This event indicates that the mode is no longer available. The mode
+// object becomes inert. Clients should send a destroy request and release
+// any resources associated with it.
+type OutputModeFinishedEvent struct{}
+
+// OutputModeFinishedSinceVersion : version of OutputMode that introduced OutputModeFinishedEvent
+const OutputModeFinishedSinceVersion = 1
+
+type OutputModeFinishedHandlerFunc func(OutputModeFinishedEvent)
+
+// SetFinishedHandler : sets handler for OutputModeFinishedEvent
+func (i *OutputMode) SetFinishedHandler(f OutputModeFinishedHandlerFunc) {
+	i.finishedHandler = f
+}
+
+func (i *OutputMode) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.sizeHandler == nil {
+			return
+		}
+		var e OutputModeSizeEvent
+		l := 0
+		e.Width = int32(client.Uint32(data[l : l+4]))
+		l += 4
+		e.Height = int32(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.sizeHandler(e)
+	case 1:
+		if i.refreshHandler == nil {
+			return
+		}
+		var e OutputModeRefreshEvent
+		l := 0
+		e.Refresh = int32(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.refreshHandler(e)
+	case 2:
+		if i.preferredHandler == nil {
+			return
+		}
+		var e OutputModePreferredEvent
+
+		i.preferredHandler(e)
+	case 3:
+		if i.finishedHandler == nil {
+			return
+		}
+		var e OutputModeFinishedEvent
+
+		i.finishedHandler(e)
+	}
+}
+
+// OutputConfigurationName : output configuration
+const OutputConfigurationName = "zwlr_output_configuration_v1"
+
+// OutputConfigurationInterface : metadata of the zwlr_output_configuration_v1 interface
+var OutputConfigurationInterface = &client.Interface{
+	Name:    OutputConfigurationName,
+	Version: 4,
+	New:     func(ctx *client.Context) client.Proxy { return NewOutputConfiguration(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "enable_head",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwlr_output_configuration_head_v1"},
+				{Name: "head", Type: client.ArgTypeObject, Interface: "zwlr_output_head_v1"},
+			},
+		},
+		{
+			Name:  "disable_head",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "head", Type: client.ArgTypeObject, Interface: "zwlr_output_head_v1"},
+			},
+		},
+		{
+			Name:  "apply",
+			Since: 1,
+		},
+		{
+			Name:  "test",
+			Since: 1,
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "succeeded",
+			Since: 1,
+		},
+		{
+			Name:  "failed",
+			Since: 1,
+		},
+		{
+			Name:  "cancelled",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns OutputConfigurationInterface
+func (i *OutputConfiguration) Interface() *client.Interface {
+	return OutputConfigurationInterface
+}
+
+// OutputConfiguration : output configuration
+//
+// This object is used by the client to describe a full output configuration.
+//
+// First, the client needs to setup the output configuration. Each head can
+// be either enabled (and configured) or disabled. It is a protocol error to
+// send two enable_head or disable_head requests with the same head. It is a
+// protocol error to omit a head in a configuration.
+//
+// Then, the client can apply or test the configuration. The compositor will
+// then reply with a succeeded, failed or cancelled event. Finally the client
+// should destroy the configuration object.
+type OutputConfiguration struct {
+	client.BaseProxy
+	succeededHandler OutputConfigurationSucceededHandlerFunc
+	failedHandler    OutputConfigurationFailedHandlerFunc
+	cancelledHandler OutputConfigurationCancelledHandlerFunc
+}
+
+// NewOutputConfiguration : output configuration
+//
+// This object is used by the client to describe a full output configuration.
+//
+// First, the client needs to setup the output configuration. Each head can
+// be either enabled (and configured) or disabled. It is a protocol error to
+// send two enable_head or disable_head requests with the same head. It is a
+// protocol error to omit a head in a configuration.
+//
+// Then, the client can apply or test the configuration. The compositor will
+// then reply with a succeeded, failed or cancelled event. Finally the client
+// should destroy the configuration object.
+func NewOutputConfiguration(ctx *client.Context) *OutputConfiguration {
+	zwlrOutputConfigurationV1 := &OutputConfiguration{}
+	ctx.Register(zwlrOutputConfigurationV1)
+	return zwlrOutputConfigurationV1
+}
+
+// OutputConfigurationEnableHeadSinceVersion : version of OutputConfiguration that introduced EnableHead
+const OutputConfigurationEnableHeadSinceVersion = 1
+
+// EnableHead : enable and configure a head
+//
+// Enable a head. This request creates a head configuration object that can
+// be used to change the head's properties.
+//
+//	head: the head to be enabled
+func (i *OutputConfiguration) EnableHead(head *OutputHead) (*OutputConfigurationHead, error) {
+	id := NewOutputConfigurationHead(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 0
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], head.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+// OutputConfigurationDisableHeadSinceVersion : version of OutputConfiguration that introduced DisableHead
+const OutputConfigurationDisableHeadSinceVersion = 1
+
+// DisableHead : disable a head
+//
+// Disable a head.
+//
+//	head: the head to be disabled
+func (i *OutputConfiguration) DisableHead(head *OutputHead) error {
+	const opcode = 1
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], head.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputConfigurationApplySinceVersion : version of OutputConfiguration that introduced Apply
+const OutputConfigurationApplySinceVersion = 1
+
+// Apply : apply the configuration
+//
+// Apply the new output configuration.
+//
+// In case the configuration is successfully applied, there is no guarantee
+// that the new output state matches completely the requested
+// configuration. For instance, a compositor might round the scale if it
+// doesn't support fractional scaling.
+//
+// After this request has been sent, the compositor must respond with an
+// succeeded, failed or cancelled event. Sending a request that isn't the
+// destructor is a protocol error.
+func (i *OutputConfiguration) Apply() error {
+	const opcode = 2
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputConfigurationTestSinceVersion : version of OutputConfiguration that introduced Test
+const OutputConfigurationTestSinceVersion = 1
+
+// Test : test the configuration
+//
+// Test the new output configuration. The configuration won't be applied,
+// but will only be validated.
+//
+// Even if the compositor succeeds to test a configuration, applying it may
+// fail.
+//
+// After this request has been sent, the compositor must respond with an
+// succeeded, failed or cancelled event. Sending a request that isn't the
+// destructor is a protocol error.
+func (i *OutputConfiguration) Test() error {
+	const opcode = 3
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputConfigurationDestroySinceVersion : version of OutputConfiguration that introduced Destroy
+const OutputConfigurationDestroySinceVersion = 1
+
+// Destroy : destroy the output configuration
+//
+// Using this request a client can tell the compositor that it is not going
+// to use the configuration object anymore. Any changes to the outputs
+// that have not been applied will be discarded.
+//
+// This request also destroys wlr_output_configuration_head objects created
+// via this object.
+func (i *OutputConfiguration) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 4
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type OutputConfigurationError uint32
+
+// OutputConfigurationError :
+const (
+	// OutputConfigurationErrorAlreadyConfiguredHead : head has been configured twice
+	OutputConfigurationErrorAlreadyConfiguredHead OutputConfigurationError = 1
+	// OutputConfigurationErrorUnconfiguredHead : head has not been configured
+	OutputConfigurationErrorUnconfiguredHead OutputConfigurationError = 2
+	// OutputConfigurationErrorAlreadyUsed : request sent after configuration has been applied or tested
+	OutputConfigurationErrorAlreadyUsed OutputConfigurationError = 3
+)
+
+func (e OutputConfigurationError) Name() string {
+	switch e {
+	case OutputConfigurationErrorAlreadyConfiguredHead:
+		return "already_configured_head"
+	case OutputConfigurationErrorUnconfiguredHead:
+		return "unconfigured_head"
+	case OutputConfigurationErrorAlreadyUsed:
+		return "already_used"
+	default:
+		return ""
+	}
+}
+
+func (e OutputConfigurationError) Value() string {
+	switch e {
+	case OutputConfigurationErrorAlreadyConfiguredHead:
+		return "1"
+	case OutputConfigurationErrorUnconfiguredHead:
+		return "2"
+	case OutputConfigurationErrorAlreadyUsed:
+		return "3"
+	default:
+		return ""
+	}
+}
+
+func (e OutputConfigurationError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// OutputConfigurationSucceededEvent : configuration changes succeeded
+//
+// Sent after the compositor has successfully applied the changes or
+// tested them.
+//
+// Upon receiving this event, the client should destroy this object.
+//
+// If the current configuration has changed, events to describe the changes
+// will be sent followed by a wlr_output_manager.done event.
+type OutputConfigurationSucceededEvent struct{}
+
+// OutputConfigurationSucceededSinceVersion : version of OutputConfiguration that introduced OutputConfigurationSucceededEvent
+const OutputConfigurationSucceededSinceVersion = 1
+
+type OutputConfigurationSucceededHandlerFunc func(OutputConfigurationSucceededEvent)
+
+// SetSucceededHandler : sets handler for OutputConfigurationSucceededEvent
+func (i *OutputConfiguration) SetSucceededHandler(f OutputConfigurationSucceededHandlerFunc) {
+	i.succeededHandler = f
+}
+
+// OutputConfigurationFailedEvent : configuration changes failed
+//
+// Sent if the compositor rejects the changes or failed to apply them. The
+// compositor should revert any changes made by the apply request that
+// triggered this event.
+//
+// Upon receiving this event, the client should destroy this object.
+type OutputConfigurationFailedEvent struct{}
+
+// OutputConfigurationFailedSinceVersion : version of OutputConfiguration that introduced OutputConfigurationFailedEvent
+const OutputConfigurationFailedSinceVersion = 1
+
+type OutputConfigurationFailedHandlerFunc func(OutputConfigurationFailedEvent)
+
+// SetFailedHandler : sets handler for OutputConfigurationFailedEvent
+func (i *OutputConfiguration) SetFailedHandler(f OutputConfigurationFailedHandlerFunc) {
+	i.failedHandler = f
+}
+
+// OutputConfigurationCancelledEvent : configuration has been cancelled
+//
+// Sent if the compositor cancels the configuration because the state of an
+// output changed and the client has outdated information (e.g. after an
+// output has been hotplugged).
+//
+// The client can create a new configuration with a newer serial and try
+// again.
+//
+// Upon receiving this event, the client should destroy this object.
+type OutputConfigurationCancelledEvent struct{}
+
+// OutputConfigurationCancelledSinceVersion : version of OutputConfiguration that introduced OutputConfigurationCancelledEvent
+const OutputConfigurationCancelledSinceVersion = 1
+
+type OutputConfigurationCancelledHandlerFunc func(OutputConfigurationCancelledEvent)
+
+// SetCancelledHandler : sets handler for OutputConfigurationCancelledEvent
+func (i *OutputConfiguration) SetCancelledHandler(f OutputConfigurationCancelledHandlerFunc) {
+	i.cancelledHandler = f
+}
+
+func (i *OutputConfiguration) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.succeededHandler == nil {
+			return
+		}
+		var e OutputConfigurationSucceededEvent
+
+		i.succeededHandler(e)
+	case 1:
+		if i.failedHandler == nil {
+			return
+		}
+		var e OutputConfigurationFailedEvent
+
+		i.failedHandler(e)
+	case 2:
+		if i.cancelledHandler == nil {
+			return
+		}
+		var e OutputConfigurationCancelledEvent
+
+		i.cancelledHandler(e)
+	}
+}
+
+// OutputConfigurationHeadName : head configuration
+const OutputConfigurationHeadName = "zwlr_output_configuration_head_v1"
+
+// OutputConfigurationHeadInterface : metadata of the zwlr_output_configuration_head_v1 interface
+var OutputConfigurationHeadInterface = &client.Interface{
+	Name:    OutputConfigurationHeadName,
+	Version: 4,
+	New:     func(ctx *client.Context) client.Proxy { return NewOutputConfigurationHead(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "set_mode",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "mode", Type: client.ArgTypeObject, Interface: "zwlr_output_mode_v1"},
+			},
+		},
+		{
+			Name:  "set_custom_mode",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "width", Type: client.ArgTypeInt},
+				{Name: "height", Type: client.ArgTypeInt},
+				{Name: "refresh", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_position",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "x", Type: client.ArgTypeInt},
+				{Name: "y", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_transform",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "transform", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_scale",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "scale", Type: client.ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "set_adaptive_sync",
+			Since: 4,
+			Args: []client.Arg{
+				{Name: "state", Type: client.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns OutputConfigurationHeadInterface
+func (i *OutputConfigurationHead) Interface() *client.Interface {
+	return OutputConfigurationHeadInterface
+}
+
+// OutputConfigurationHead : head configuration
+//
+// This object is used by the client to update a single head's configuration.
+//
+// It is a protocol error to set the same property twice.
+type OutputConfigurationHead struct {
+	client.BaseProxy
+}
+
+// NewOutputConfigurationHead : head configuration
+//
+// This object is used by the client to update a single head's configuration.
+//
+// It is a protocol error to set the same property twice.
+func NewOutputConfigurationHead(ctx *client.Context) *OutputConfigurationHead {
+	zwlrOutputConfigurationHeadV1 := &OutputConfigurationHead{}
+	ctx.Register(zwlrOutputConfigurationHeadV1)
+	return zwlrOutputConfigurationHeadV1
+}
+
+// OutputConfigurationHeadSetModeSinceVersion : version of OutputConfigurationHead that introduced SetMode
+const OutputConfigurationHeadSetModeSinceVersion = 1
+
+// SetMode : set the mode
+//
+// This request sets the head's mode.
+func (i *OutputConfigurationHead) SetMode(mode *OutputMode) error {
+	const opcode = 0
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], mode.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputConfigurationHeadSetCustomModeSinceVersion : version of OutputConfigurationHead that introduced SetCustomMode
+const OutputConfigurationHeadSetCustomModeSinceVersion = 1
+
+// SetCustomMode : set a custom mode
+//
+// This request assigns a custom mode to the head. The size is given in
+// physical hardware units of the output device. If set to zero, the
+// refresh rate is unspecified.
+//
+// It is a protocol error to set both a mode and a custom mode.
+//
+//	width: width of the mode in hardware units
+//	height: height of the mode in hardware units
+//	refresh: vertical refresh rate in mHz or zero
+func (i *OutputConfigurationHead) SetCustomMode(width, height, refresh int32) error {
+	const opcode = 1
+	const _reqBufLen = 8 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(width))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(height))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(refresh))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputConfigurationHeadSetPositionSinceVersion : version of OutputConfigurationHead that introduced SetPosition
+const OutputConfigurationHeadSetPositionSinceVersion = 1
+
+// SetPosition : set the position
+//
+// This request sets the head's position in the global compositor space.
+//
+//	x: x position in the global compositor space
+//	y: y position in the global compositor space
+func (i *OutputConfigurationHead) SetPosition(x, y int32) error {
+	const opcode = 2
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(x))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(y))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputConfigurationHeadSetTransformSinceVersion : version of OutputConfigurationHead that introduced SetTransform
+const OutputConfigurationHeadSetTransformSinceVersion = 1
+
+// SetTransform : set the transform
+//
+// This request sets the head's transform.
+func (i *OutputConfigurationHead) SetTransform(transform client.OutputTransform) error {
+	const opcode = 3
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(transform))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputConfigurationHeadSetScaleSinceVersion : version of OutputConfigurationHead that introduced SetScale
+const OutputConfigurationHeadSetScaleSinceVersion = 1
+
+// SetScale : set the scale
+//
+// This request sets the head's scale.
+func (i *OutputConfigurationHead) SetScale(scale float64) error {
+	const opcode = 4
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutFixed(_reqBuf[l:l+4], scale)
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// OutputConfigurationHeadSetAdaptiveSyncSinceVersion : version of OutputConfigurationHead that introduced SetAdaptiveSync
+const OutputConfigurationHeadSetAdaptiveSyncSinceVersion = 4
+
+// SetAdaptiveSync : enable/disable adaptive sync
+//
+// This request enables/disables adaptive sync. Adaptive sync is also
+// known as Variable Refresh Rate or VRR.
+func (i *OutputConfigurationHead) SetAdaptiveSync(state OutputHeadAdaptiveSyncState) error {
+	if v := i.Version(); v < OutputConfigurationHeadSetAdaptiveSyncSinceVersion {
+		return &client.VersionError{Interface: OutputConfigurationHeadName, Request: "set_adaptive_sync", Since: OutputConfigurationHeadSetAdaptiveSyncSinceVersion, Version: v}
+	}
+	const opcode = 5
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(state))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+func (i *OutputConfigurationHead) Destroy() error {
+	i.Context().Unregister(i)
+	return nil
+}
+
+type OutputConfigurationHeadError uint32
+
+// OutputConfigurationHeadError :
+const (
+	// OutputConfigurationHeadErrorAlreadySet : property has already been set
+	OutputConfigurationHeadErrorAlreadySet OutputConfigurationHeadError = 1
+	// OutputConfigurationHeadErrorInvalidMode : mode doesn't belong to head
+	OutputConfigurationHeadErrorInvalidMode OutputConfigurationHeadError = 2
+	// OutputConfigurationHeadErrorInvalidCustomMode : mode is invalid
+	OutputConfigurationHeadErrorInvalidCustomMode OutputConfigurationHeadError = 3
+	// OutputConfigurationHeadErrorInvalidTransform : transform value outside enum
+	OutputConfigurationHeadErrorInvalidTransform OutputConfigurationHeadError = 4
+	// OutputConfigurationHeadErrorInvalidScale : scale negative or zero
+	OutputConfigurationHeadErrorInvalidScale OutputConfigurationHeadError = 5
+	// OutputConfigurationHeadErrorInvalidAdaptiveSyncState : invalid enum value used in the set_adaptive_sync request
+	OutputConfigurationHeadErrorInvalidAdaptiveSyncState OutputConfigurationHeadError = 6
+)
+
+func (e OutputConfigurationHeadError) Name() string {
+	switch e {
+	case OutputConfigurationHeadErrorAlreadySet:
+		return "already_set"
+	case OutputConfigurationHeadErrorInvalidMode:
+		return "invalid_mode"
+	case OutputConfigurationHeadErrorInvalidCustomMode:
+		return "invalid_custom_mode"
+	case OutputConfigurationHeadErrorInvalidTransform:
+		return "invalid_transform"
+	case OutputConfigurationHeadErrorInvalidScale:
+		return "invalid_scale"
+	case OutputConfigurationHeadErrorInvalidAdaptiveSyncState:
+		return "invalid_adaptive_sync_state"
+	default:
+		return ""
+	}
+}
+
+func (e OutputConfigurationHeadError) Value() string {
+	switch e {
+	case OutputConfigurationHeadErrorAlreadySet:
+		return "1"
+	case OutputConfigurationHeadErrorInvalidMode:
+		return "2"
+	case OutputConfigurationHeadErrorInvalidCustomMode:
+		return "3"
+	case OutputConfigurationHeadErrorInvalidTransform:
+		return "4"
+	case OutputConfigurationHeadErrorInvalidScale:
+		return "5"
+	case OutputConfigurationHeadErrorInvalidAdaptiveSyncState:
+		return "6"
+	default:
+		return ""
+	}
+}
+
+func (e OutputConfigurationHeadError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+func init() {
+	client.RegisterInterface(OutputManagerInterface)
+	client.RegisterInterface(OutputHeadInterface)
+	client.RegisterInterface(OutputModeInterface)
+	client.RegisterInterface(OutputConfigurationInterface)
+	client.RegisterInterface(OutputConfigurationHeadInterface)
+}
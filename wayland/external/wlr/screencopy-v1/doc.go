@@ -0,0 +1,7 @@
+// Package screencopy is Go binding of the wlr-screencopy-unstable-v1
+// protocol, used to copy output contents into client buffers.
+//
+// Stability: unstable. wlr-protocols are maintained outside of
+// wayland-protocols and only implemented by wlroots based compositors,
+// backward incompatible changes bump the version in the interface names.
+package screencopy
@@ -0,0 +1,746 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : wlr/screencopy/wlr-screencopy-unstable-v1.xml
+// XML sha256 : c64c40ff6569b87462c347589ca85b4abb904cb6db9f70040e95b68a1176986c
+//
+// wlr_screencopy_unstable_v1 Protocol Copyright:
+//
+// Copyright © 2018 Simon Ser
+// Copyright © 2019 Andri Yngvason
+//
+// Permission is hereby granted, free of charge, to any person obtaining a
+// copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice (including the next
+// paragraph) shall be included in all copies or substantial portions of the
+// Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.  IN NO EVENT SHALL
+// THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package screencopy
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+)
+
+// ScreencopyManagerName : manager to inform clients and begin capturing
+const ScreencopyManagerName = "zwlr_screencopy_manager_v1"
+
+// ScreencopyManagerInterface : metadata of the zwlr_screencopy_manager_v1 interface
+var ScreencopyManagerInterface = &client.Interface{
+	Name:    ScreencopyManagerName,
+	Version: 3,
+	New:     func(ctx *client.Context) client.Proxy { return NewScreencopyManager(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "capture_output",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "frame", Type: client.ArgTypeNewID, Interface: "zwlr_screencopy_frame_v1"},
+				{Name: "overlay_cursor", Type: client.ArgTypeInt},
+				{Name: "output", Type: client.ArgTypeObject, Interface: "wl_output"},
+			},
+		},
+		{
+			Name:  "capture_output_region",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "frame", Type: client.ArgTypeNewID, Interface: "zwlr_screencopy_frame_v1"},
+				{Name: "overlay_cursor", Type: client.ArgTypeInt},
+				{Name: "output", Type: client.ArgTypeObject, Interface: "wl_output"},
+				{Name: "x", Type: client.ArgTypeInt},
+				{Name: "y", Type: client.ArgTypeInt},
+				{Name: "width", Type: client.ArgTypeInt},
+				{Name: "height", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns ScreencopyManagerInterface
+func (i *ScreencopyManager) Interface() *client.Interface {
+	return ScreencopyManagerInterface
+}
+
+// ScreencopyManager : manager to inform clients and begin capturing
+//
+// This object is a manager which offers requests to start capturing from a
+// source.
+type ScreencopyManager struct {
+	client.BaseProxy
+}
+
+// NewScreencopyManager : manager to inform clients and begin capturing
+//
+// This object is a manager which offers requests to start capturing from a
+// source.
+func NewScreencopyManager(ctx *client.Context) *ScreencopyManager {
+	zwlrScreencopyManagerV1 := &ScreencopyManager{}
+	ctx.Register(zwlrScreencopyManagerV1)
+	return zwlrScreencopyManagerV1
+}
+
+// ScreencopyManagerCaptureOutputSinceVersion : version of ScreencopyManager that introduced CaptureOutput
+const ScreencopyManagerCaptureOutputSinceVersion = 1
+
+// CaptureOutput : capture an output
+//
+// Capture the next frame of an entire output.
+//
+//	overlayCursor: composite cursor onto the frame
+func (i *ScreencopyManager) CaptureOutput(overlayCursor int32, output *client.Output) (*ScreencopyFrame, error) {
+	frame := NewScreencopyFrame(i.Context())
+	frame.SetVersion(i.Version())
+	const opcode = 0
+	const _reqBufLen = 8 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], frame.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(overlayCursor))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], output.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return frame, err
+}
+
+// ScreencopyManagerCaptureOutputRegionSinceVersion : version of ScreencopyManager that introduced CaptureOutputRegion
+const ScreencopyManagerCaptureOutputRegionSinceVersion = 1
+
+// CaptureOutputRegion : capture an output's region
+//
+// Capture the next frame of an output's region.
+//
+// The region is given in output logical coordinates, see
+// xdg_output.logical_size. The region will be clipped to the output's
+// extents.
+//
+//	overlayCursor: composite cursor onto the frame
+func (i *ScreencopyManager) CaptureOutputRegion(overlayCursor int32, output *client.Output, x, y, width, height int32) (*ScreencopyFrame, error) {
+	frame := NewScreencopyFrame(i.Context())
+	frame.SetVersion(i.Version())
+	const opcode = 1
+	const _reqBufLen = 8 + 4 + 4 + 4 + 4 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], frame.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(overlayCursor))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], output.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(x))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(y))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(width))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(height))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return frame, err
+}
+
+// ScreencopyManagerDestroySinceVersion : version of ScreencopyManager that introduced Destroy
+const ScreencopyManagerDestroySinceVersion = 1
+
+// Destroy : destroy the manager
+//
+// All objects created by the manager will still remain valid, until their
+// appropriate destroy request has been called.
+func (i *ScreencopyManager) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 2
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ScreencopyFrameName : a frame ready for copy
+const ScreencopyFrameName = "zwlr_screencopy_frame_v1"
+
+// ScreencopyFrameInterface : metadata of the zwlr_screencopy_frame_v1 interface
+var ScreencopyFrameInterface = &client.Interface{
+	Name:    ScreencopyFrameName,
+	Version: 3,
+	New:     func(ctx *client.Context) client.Proxy { return NewScreencopyFrame(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "copy",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "buffer", Type: client.ArgTypeObject, Interface: "wl_buffer"},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "copy_with_damage",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "buffer", Type: client.ArgTypeObject, Interface: "wl_buffer"},
+			},
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "buffer",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "format", Type: client.ArgTypeUint},
+				{Name: "width", Type: client.ArgTypeUint},
+				{Name: "height", Type: client.ArgTypeUint},
+				{Name: "stride", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "flags",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "flags", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "ready",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "tv_sec_hi", Type: client.ArgTypeUint},
+				{Name: "tv_sec_lo", Type: client.ArgTypeUint},
+				{Name: "tv_nsec", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "failed",
+			Since: 1,
+		},
+		{
+			Name:  "damage",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "x", Type: client.ArgTypeUint},
+				{Name: "y", Type: client.ArgTypeUint},
+				{Name: "width", Type: client.ArgTypeUint},
+				{Name: "height", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "linux_dmabuf",
+			Since: 3,
+			Args: []client.Arg{
+				{Name: "format", Type: client.ArgTypeUint},
+				{Name: "width", Type: client.ArgTypeUint},
+				{Name: "height", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "buffer_done",
+			Since: 3,
+		},
+	},
+}
+
+// Interface : returns ScreencopyFrameInterface
+func (i *ScreencopyFrame) Interface() *client.Interface {
+	return ScreencopyFrameInterface
+}
+
+// ScreencopyFrame : a frame ready for copy
+//
+// This object represents a single frame.
+//
+// When created, a series of buffer events will be sent, each representing a
+// supported buffer type. The "buffer_done" event is sent afterwards to
+// indicate that all supported buffer types have been enumerated. The client
+// will then be able to send a "copy" request. If the capture is successful,
+// the compositor will send a "flags" event followed by a "ready" event.
+//
+// For objects version 2 or lower, wl_shm buffers are always supported, ie.
+// the "buffer" event is guaranteed to be sent.
+//
+// If the capture failed, the "failed" event is sent. This can happen anytime
+// before the "ready" event.
+//
+// Once either a "ready" or a "failed" event is received, the client should
+// destroy the frame.
+type ScreencopyFrame struct {
+	client.BaseProxy
+	bufferHandler      ScreencopyFrameBufferHandlerFunc
+	flagsHandler       ScreencopyFrameFlagsHandlerFunc
+	readyHandler       ScreencopyFrameReadyHandlerFunc
+	failedHandler      ScreencopyFrameFailedHandlerFunc
+	damageHandler      ScreencopyFrameDamageHandlerFunc
+	linuxDmabufHandler ScreencopyFrameLinuxDmabufHandlerFunc
+	bufferDoneHandler  ScreencopyFrameBufferDoneHandlerFunc
+}
+
+// NewScreencopyFrame : a frame ready for copy
+//
+// This object represents a single frame.
+//
+// When created, a series of buffer events will be sent, each representing a
+// supported buffer type. The "buffer_done" event is sent afterwards to
+// indicate that all supported buffer types have been enumerated. The client
+// will then be able to send a "copy" request. If the capture is successful,
+// the compositor will send a "flags" event followed by a "ready" event.
+//
+// For objects version 2 or lower, wl_shm buffers are always supported, ie.
+// the "buffer" event is guaranteed to be sent.
+//
+// If the capture failed, the "failed" event is sent. This can happen anytime
+// before the "ready" event.
+//
+// Once either a "ready" or a "failed" event is received, the client should
+// destroy the frame.
+func NewScreencopyFrame(ctx *client.Context) *ScreencopyFrame {
+	zwlrScreencopyFrameV1 := &ScreencopyFrame{}
+	ctx.Register(zwlrScreencopyFrameV1)
+	return zwlrScreencopyFrameV1
+}
+
+// ScreencopyFrameCopySinceVersion : version of ScreencopyFrame that introduced Copy
+const ScreencopyFrameCopySinceVersion = 1
+
+// Copy : copy the frame
+//
+// Copy the frame to the supplied buffer. The buffer must have the
+// correct size, see zwlr_screencopy_frame_v1.buffer and
+// zwlr_screencopy_frame_v1.linux_dmabuf. The buffer needs to have a
+// supported format.
+//
+// If the frame is successfully copied, "flags" and "ready" events are
+// sent. Otherwise, a "failed" event is sent.
+func (i *ScreencopyFrame) Copy(buffer *client.Buffer) error {
+	const opcode = 0
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], buffer.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ScreencopyFrameDestroySinceVersion : version of ScreencopyFrame that introduced Destroy
+const ScreencopyFrameDestroySinceVersion = 1
+
+// Destroy : delete this object, used or not
+//
+// Destroys the frame. This request can be sent at any time by the client.
+func (i *ScreencopyFrame) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ScreencopyFrameCopyWithDamageSinceVersion : version of ScreencopyFrame that introduced CopyWithDamage
+const ScreencopyFrameCopyWithDamageSinceVersion = 2
+
+// CopyWithDamage : copy the frame when it's damaged
+//
+// Same as copy, except it waits until there is damage to copy.
+func (i *ScreencopyFrame) CopyWithDamage(buffer *client.Buffer) error {
+	if v := i.Version(); v < ScreencopyFrameCopyWithDamageSinceVersion {
+		return &client.VersionError{Interface: ScreencopyFrameName, Request: "copy_with_damage", Since: ScreencopyFrameCopyWithDamageSinceVersion, Version: v}
+	}
+	const opcode = 2
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], buffer.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type ScreencopyFrameError uint32
+
+// ScreencopyFrameError :
+const (
+	// ScreencopyFrameErrorAlreadyUsed : the object has already been used to copy a wl_buffer
+	ScreencopyFrameErrorAlreadyUsed ScreencopyFrameError = 0
+	// ScreencopyFrameErrorInvalidBuffer : buffer attributes are invalid
+	ScreencopyFrameErrorInvalidBuffer ScreencopyFrameError = 1
+)
+
+func (e ScreencopyFrameError) Name() string {
+	switch e {
+	case ScreencopyFrameErrorAlreadyUsed:
+		return "already_used"
+	case ScreencopyFrameErrorInvalidBuffer:
+		return "invalid_buffer"
+	default:
+		return ""
+	}
+}
+
+func (e ScreencopyFrameError) Value() string {
+	switch e {
+	case ScreencopyFrameErrorAlreadyUsed:
+		return "0"
+	case ScreencopyFrameErrorInvalidBuffer:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+func (e ScreencopyFrameError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type ScreencopyFrameFlags uint32
+
+// ScreencopyFrameFlags :
+const (
+	// ScreencopyFrameFlagsYInvert : contents are y-inverted
+	ScreencopyFrameFlagsYInvert ScreencopyFrameFlags = 1
+)
+
+func (e ScreencopyFrameFlags) Name() string {
+	switch e {
+	case ScreencopyFrameFlagsYInvert:
+		return "y_invert"
+	default:
+		return ""
+	}
+}
+
+func (e ScreencopyFrameFlags) Value() string {
+	switch e {
+	case ScreencopyFrameFlagsYInvert:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e ScreencopyFrameFlags) Has(f ScreencopyFrameFlags) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e ScreencopyFrameFlags) Set(f ScreencopyFrameFlags) ScreencopyFrameFlags {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e ScreencopyFrameFlags) Clear(f ScreencopyFrameFlags) ScreencopyFrameFlags {
+	return e &^ f
+}
+
+func (e ScreencopyFrameFlags) String() string {
+	if e == 0 {
+		return "0"
+	}
+	var flags []string
+	if e.Has(ScreencopyFrameFlagsYInvert) {
+		flags = append(flags, "y_invert")
+		e = e.Clear(ScreencopyFrameFlagsYInvert)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
+}
+
+// ScreencopyFrameBufferEvent : wl_shm buffer information
+//
+// Provides information about wl_shm buffer parameters that need to be
+// used for this frame. This event is sent once after the frame is created
+// if wl_shm buffers are supported.
+type ScreencopyFrameBufferEvent struct {
+	Format client.ShmFormat
+	Width  uint32
+	Height uint32
+	Stride uint32
+}
+
+// ScreencopyFrameBufferSinceVersion : version of ScreencopyFrame that introduced ScreencopyFrameBufferEvent
+const ScreencopyFrameBufferSinceVersion = 1
+
+type ScreencopyFrameBufferHandlerFunc func(ScreencopyFrameBufferEvent)
+
+// SetBufferHandler : sets handler for ScreencopyFrameBufferEvent
+func (i *ScreencopyFrame) SetBufferHandler(f ScreencopyFrameBufferHandlerFunc) {
+	i.bufferHandler = f
+}
+
+// ScreencopyFrameFlagsEvent : frame flags
+//
+// Provides flags about the frame. This event is sent once before the
+// "ready" event.
+type ScreencopyFrameFlagsEvent struct {
+	Flags ScreencopyFrameFlags
+}
+
+// ScreencopyFrameFlagsSinceVersion : version of ScreencopyFrame that introduced ScreencopyFrameFlagsEvent
+const ScreencopyFrameFlagsSinceVersion = 1
+
+type ScreencopyFrameFlagsHandlerFunc func(ScreencopyFrameFlagsEvent)
+
+// SetFlagsHandler : sets handler for ScreencopyFrameFlagsEvent
+func (i *ScreencopyFrame) SetFlagsHandler(f ScreencopyFrameFlagsHandlerFunc) {
+	i.flagsHandler = f
+}
+
+// ScreencopyFrameReadyEvent : indicates frame is available for reading
+//
+// Called as soon as the frame is copied, indicating it is available
+// for reading. This event includes the time at which presentation happened
+// at.
+//
+// The timestamp is expressed as tv_sec_hi, tv_sec_lo, tv_nsec triples,
+// each component being an unsigned 32-bit value. Whole seconds are in
+// tv_sec which is a 64-bit value combined from tv_sec_hi and tv_sec_lo,
+// and the additional fractional part in tv_nsec as nanoseconds. Hence,
+// for valid timestamps tv_nsec must be in [0, 999999999]. The seconds part
+// may have an arbitrary offset at start.
+//
+// After receiving this event, the client should destroy the object.
+type ScreencopyFrameReadyEvent struct {
+	TvSecHi uint32
+	TvSecLo uint32
+	TvNsec  uint32
+}
+
+// ScreencopyFrameReadySinceVersion : version of ScreencopyFrame that introduced ScreencopyFrameReadyEvent
+const ScreencopyFrameReadySinceVersion = 1
+
+type ScreencopyFrameReadyHandlerFunc func(ScreencopyFrameReadyEvent)
+
+// SetReadyHandler : sets handler for ScreencopyFrameReadyEvent
+func (i *ScreencopyFrame) SetReadyHandler(f ScreencopyFrameReadyHandlerFunc) {
+	i.readyHandler = f
+}
+
+// ScreencopyFrameFailedEvent : frame copy failed
+//
+// This event indicates that the attempted frame copy has failed.
+//
+// After receiving this event, the client should destroy the object.
+type ScreencopyFrameFailedEvent struct{}
+
+// ScreencopyFrameFailedSinceVersion : version of ScreencopyFrame that introduced ScreencopyFrameFailedEvent
+const ScreencopyFrameFailedSinceVersion = 1
+
+type ScreencopyFrameFailedHandlerFunc func(ScreencopyFrameFailedEvent)
+
+// SetFailedHandler : sets handler for ScreencopyFrameFailedEvent
+func (i *ScreencopyFrame) SetFailedHandler(f ScreencopyFrameFailedHandlerFunc) {
+	i.failedHandler = f
+}
+
+// ScreencopyFrameDamageEvent : carries the coordinates of the damaged region
+//
+// This event is sent right before the ready event when copy_with_damage is
+// requested. It may be generated multiple times for each copy_with_damage
+// request.
+//
+// The arguments describe a box around an area that has changed since the
+// last copy request that was derived from the current screencopy manager
+// instance.
+//
+// The union of all regions received between the call to copy_with_damage
+// and a ready event is the total damage since the prior ready event.
+type ScreencopyFrameDamageEvent struct {
+	X      uint32
+	Y      uint32
+	Width  uint32
+	Height uint32
+}
+
+// ScreencopyFrameDamageSinceVersion : version of ScreencopyFrame that introduced ScreencopyFrameDamageEvent
+const ScreencopyFrameDamageSinceVersion = 2
+
+type ScreencopyFrameDamageHandlerFunc func(ScreencopyFrameDamageEvent)
+
+// SetDamageHandler : sets handler for ScreencopyFrameDamageEvent
+func (i *ScreencopyFrame) SetDamageHandler(f ScreencopyFrameDamageHandlerFunc) {
+	i.damageHandler = f
+}
+
+// ScreencopyFrameLinuxDmabufEvent : linux-dmabuf buffer information
+//
+// Provides information about linux-dmabuf buffer parameters that need to
+// be used for this frame. This event is sent once after the frame is
+// created if linux-dmabuf buffers are supported.
+type ScreencopyFrameLinuxDmabufEvent struct {
+	Format uint32
+	Width  uint32
+	Height uint32
+}
+
+// ScreencopyFrameLinuxDmabufSinceVersion : version of ScreencopyFrame that introduced ScreencopyFrameLinuxDmabufEvent
+const ScreencopyFrameLinuxDmabufSinceVersion = 3
+
+type ScreencopyFrameLinuxDmabufHandlerFunc func(ScreencopyFrameLinuxDmabufEvent)
+
+// SetLinuxDmabufHandler : sets handler for ScreencopyFrameLinuxDmabufEvent
+func (i *ScreencopyFrame) SetLinuxDmabufHandler(f ScreencopyFrameLinuxDmabufHandlerFunc) {
+	i.linuxDmabufHandler = f
+}
+
+// ScreencopyFrameBufferDoneEvent : all buffer types reported
+//
+// This event is sent once after all buffer events have been sent.
+//
+// The client should proceed to create a buffer of one of the supported
+// types, and send a "copy" request.
+type ScreencopyFrameBufferDoneEvent struct{}
+
+// ScreencopyFrameBufferDoneSinceVersion : version of ScreencopyFrame that introduced ScreencopyFrameBufferDoneEvent
+const ScreencopyFrameBufferDoneSinceVersion = 3
+
+type ScreencopyFrameBufferDoneHandlerFunc func(ScreencopyFrameBufferDoneEvent)
+
+// SetBufferDoneHandler : sets handler for ScreencopyFrameBufferDoneEvent
+func (i *ScreencopyFrame) SetBufferDoneHandler(f ScreencopyFrameBufferDoneHandlerFunc) {
+	i.bufferDoneHandler = f
+}
+
+func (i *ScreencopyFrame) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.bufferHandler == nil {
+			return
+		}
+		var e ScreencopyFrameBufferEvent
+		l := 0
+		e.Format = client.ShmFormat(client.Uint32(data[l : l+4]))
+		l += 4
+		e.Width = client.Uint32(data[l : l+4])
+		l += 4
+		e.Height = client.Uint32(data[l : l+4])
+		l += 4
+		e.Stride = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.bufferHandler(e)
+	case 1:
+		if i.flagsHandler == nil {
+			return
+		}
+		var e ScreencopyFrameFlagsEvent
+		l := 0
+		e.Flags = ScreencopyFrameFlags(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.flagsHandler(e)
+	case 2:
+		if i.readyHandler == nil {
+			return
+		}
+		var e ScreencopyFrameReadyEvent
+		l := 0
+		e.TvSecHi = client.Uint32(data[l : l+4])
+		l += 4
+		e.TvSecLo = client.Uint32(data[l : l+4])
+		l += 4
+		e.TvNsec = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.readyHandler(e)
+	case 3:
+		if i.failedHandler == nil {
+			return
+		}
+		var e ScreencopyFrameFailedEvent
+
+		i.failedHandler(e)
+	case 4:
+		if i.damageHandler == nil {
+			return
+		}
+		var e ScreencopyFrameDamageEvent
+		l := 0
+		e.X = client.Uint32(data[l : l+4])
+		l += 4
+		e.Y = client.Uint32(data[l : l+4])
+		l += 4
+		e.Width = client.Uint32(data[l : l+4])
+		l += 4
+		e.Height = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.damageHandler(e)
+	case 5:
+		if i.linuxDmabufHandler == nil {
+			return
+		}
+		var e ScreencopyFrameLinuxDmabufEvent
+		l := 0
+		e.Format = client.Uint32(data[l : l+4])
+		l += 4
+		e.Width = client.Uint32(data[l : l+4])
+		l += 4
+		e.Height = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.linuxDmabufHandler(e)
+	case 6:
+		if i.bufferDoneHandler == nil {
+			return
+		}
+		var e ScreencopyFrameBufferDoneEvent
+
+		i.bufferDoneHandler(e)
+	}
+}
+
+func init() {
+	client.RegisterInterface(ScreencopyManagerInterface)
+	client.RegisterInterface(ScreencopyFrameInterface)
+}
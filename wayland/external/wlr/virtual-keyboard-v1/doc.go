@@ -0,0 +1,7 @@
+// Package virtual_keyboard is Go binding of the virtual-keyboard-unstable-v1
+// protocol shipped with wlr-protocols, used to emulate a physical keyboard.
+//
+// Stability: unstable. wlr-protocols are maintained outside of
+// wayland-protocols and only implemented by wlroots based compositors,
+// backward incompatible changes bump the version in the interface names.
+package virtual_keyboard
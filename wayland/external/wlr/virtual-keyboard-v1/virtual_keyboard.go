@@ -0,0 +1,376 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : wlr/virtual-keyboard/virtual-keyboard-unstable-v1.xml
+// XML sha256 : 7ad7870003ecd592cae47dc19d277a609b7f18fd7b7be012623cf3225a7294f5
+//
+// virtual_keyboard_unstable_v1 Protocol Copyright:
+//
+// Copyright © 2008-2011  Kristian Høgsberg
+// Copyright © 2010-2013  Intel Corporation
+// Copyright © 2012-2013  Collabora, Ltd.
+// Copyright © 2018       Purism SPC
+//
+// Permission is hereby granted, free of charge, to any person obtaining a
+// copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice (including the next
+// paragraph) shall be included in all copies or substantial portions of the
+// Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.  IN NO EVENT SHALL
+// THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package virtual_keyboard
+
+import (
+	"github.com/hempflower/go-wayland/wayland/client"
+	"golang.org/x/sys/unix"
+)
+
+// VirtualKeyboardName : virtual keyboard
+const VirtualKeyboardName = "zwp_virtual_keyboard_v1"
+
+// VirtualKeyboardInterface : metadata of the zwp_virtual_keyboard_v1 interface
+var VirtualKeyboardInterface = &client.Interface{
+	Name:    VirtualKeyboardName,
+	Version: 1,
+	New:     func(ctx *client.Context) client.Proxy { return NewVirtualKeyboard(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "keymap",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "format", Type: client.ArgTypeUint},
+				{Name: "fd", Type: client.ArgTypeFd},
+				{Name: "size", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "key",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "time", Type: client.ArgTypeUint},
+				{Name: "key", Type: client.ArgTypeUint},
+				{Name: "state", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "modifiers",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "mods_depressed", Type: client.ArgTypeUint},
+				{Name: "mods_latched", Type: client.ArgTypeUint},
+				{Name: "mods_locked", Type: client.ArgTypeUint},
+				{Name: "group", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns VirtualKeyboardInterface
+func (i *VirtualKeyboard) Interface() *client.Interface {
+	return VirtualKeyboardInterface
+}
+
+// VirtualKeyboard : virtual keyboard
+//
+// The virtual keyboard provides an application with requests which emulate
+// the behaviour of a physical keyboard.
+//
+// This interface can be used by clients on its own to provide raw input
+// events, or it can accompany the input method protocol.
+type VirtualKeyboard struct {
+	client.BaseProxy
+}
+
+// NewVirtualKeyboard : virtual keyboard
+//
+// The virtual keyboard provides an application with requests which emulate
+// the behaviour of a physical keyboard.
+//
+// This interface can be used by clients on its own to provide raw input
+// events, or it can accompany the input method protocol.
+func NewVirtualKeyboard(ctx *client.Context) *VirtualKeyboard {
+	zwpVirtualKeyboardV1 := &VirtualKeyboard{}
+	ctx.Register(zwpVirtualKeyboardV1)
+	return zwpVirtualKeyboardV1
+}
+
+// VirtualKeyboardKeymapSinceVersion : version of VirtualKeyboard that introduced Keymap
+const VirtualKeyboardKeymapSinceVersion = 1
+
+// Keymap : keyboard mapping
+//
+// Provide a file descriptor to the compositor which can be
+// memory-mapped to provide a keyboard mapping description.
+//
+// Format carries a value from the keymap_format enumeration.
+//
+//	format: keymap format
+//	fd: keymap file descriptor
+//	size: keymap size, in bytes
+func (i *VirtualKeyboard) Keymap(format uint32, fd int, size uint32) error {
+	const opcode = 0
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(format))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(size))
+	l += 4
+	oob := unix.UnixRights(int(fd))
+	err := i.Context().WriteMsg(_reqBuf[:], oob)
+	return err
+}
+
+// VirtualKeyboardKeySinceVersion : version of VirtualKeyboard that introduced Key
+const VirtualKeyboardKeySinceVersion = 1
+
+// Key : key event
+//
+// A key was pressed or released.
+// The time argument is a timestamp with millisecond granularity, with an
+// undefined base. All requests regarding a single object must share the
+// same clock.
+//
+// Keymap must be set before issuing this request.
+//
+// State carries a value from the key_state enumeration.
+//
+//	time: timestamp with millisecond granularity
+//	key: key that produced the event
+//	state: physical state of the key
+func (i *VirtualKeyboard) Key(time, key, state uint32) error {
+	const opcode = 1
+	const _reqBufLen = 8 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(time))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(key))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(state))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualKeyboardModifiersSinceVersion : version of VirtualKeyboard that introduced Modifiers
+const VirtualKeyboardModifiersSinceVersion = 1
+
+// Modifiers : modifier and group state
+//
+// Notifies the compositor that the modifier and/or group state has
+// changed, and it should update state.
+//
+// The client should use wl_keyboard.modifiers event to synchronize its
+// internal state with seat state.
+//
+// Keymap must be set before issuing this request.
+//
+//	modsDepressed: depressed modifiers
+//	modsLatched: latched modifiers
+//	modsLocked: locked modifiers
+//	group: keyboard layout
+func (i *VirtualKeyboard) Modifiers(modsDepressed, modsLatched, modsLocked, group uint32) error {
+	const opcode = 2
+	const _reqBufLen = 8 + 4 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(modsDepressed))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(modsLatched))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(modsLocked))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(group))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualKeyboardDestroySinceVersion : version of VirtualKeyboard that introduced Destroy
+const VirtualKeyboardDestroySinceVersion = 1
+
+// Destroy : destroy the virtual keyboard keyboard object
+func (i *VirtualKeyboard) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 3
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type VirtualKeyboardError uint32
+
+// VirtualKeyboardError :
+const (
+	// VirtualKeyboardErrorNoKeymap : No keymap was set
+	VirtualKeyboardErrorNoKeymap VirtualKeyboardError = 0
+)
+
+func (e VirtualKeyboardError) Name() string {
+	switch e {
+	case VirtualKeyboardErrorNoKeymap:
+		return "no_keymap"
+	default:
+		return ""
+	}
+}
+
+func (e VirtualKeyboardError) Value() string {
+	switch e {
+	case VirtualKeyboardErrorNoKeymap:
+		return "0"
+	default:
+		return ""
+	}
+}
+
+func (e VirtualKeyboardError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// VirtualKeyboardManagerName : virtual keyboard manager
+const VirtualKeyboardManagerName = "zwp_virtual_keyboard_manager_v1"
+
+// VirtualKeyboardManagerInterface : metadata of the zwp_virtual_keyboard_manager_v1 interface
+var VirtualKeyboardManagerInterface = &client.Interface{
+	Name:    VirtualKeyboardManagerName,
+	Version: 1,
+	New:     func(ctx *client.Context) client.Proxy { return NewVirtualKeyboardManager(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "create_virtual_keyboard",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "seat", Type: client.ArgTypeObject, Interface: "wl_seat"},
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwp_virtual_keyboard_v1"},
+			},
+		},
+	},
+}
+
+// Interface : returns VirtualKeyboardManagerInterface
+func (i *VirtualKeyboardManager) Interface() *client.Interface {
+	return VirtualKeyboardManagerInterface
+}
+
+// VirtualKeyboardManager : virtual keyboard manager
+//
+// A virtual keyboard manager allows an application to provide keyboard
+// input events as if they came from a physical keyboard.
+type VirtualKeyboardManager struct {
+	client.BaseProxy
+}
+
+// NewVirtualKeyboardManager : virtual keyboard manager
+//
+// A virtual keyboard manager allows an application to provide keyboard
+// input events as if they came from a physical keyboard.
+func NewVirtualKeyboardManager(ctx *client.Context) *VirtualKeyboardManager {
+	zwpVirtualKeyboardManagerV1 := &VirtualKeyboardManager{}
+	ctx.Register(zwpVirtualKeyboardManagerV1)
+	return zwpVirtualKeyboardManagerV1
+}
+
+// VirtualKeyboardManagerCreateVirtualKeyboardSinceVersion : version of VirtualKeyboardManager that introduced CreateVirtualKeyboard
+const VirtualKeyboardManagerCreateVirtualKeyboardSinceVersion = 1
+
+// CreateVirtualKeyboard : Create a new virtual keyboard
+//
+// Creates a new virtual keyboard associated to a seat.
+//
+// If the compositor enables a keyboard to perform arbitrary actions, it
+// should present an error when an untrusted client requests a new
+// keyboard.
+func (i *VirtualKeyboardManager) CreateVirtualKeyboard(seat *client.Seat) (*VirtualKeyboard, error) {
+	id := NewVirtualKeyboard(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 0
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], seat.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+func (i *VirtualKeyboardManager) Destroy() error {
+	i.Context().Unregister(i)
+	return nil
+}
+
+type VirtualKeyboardManagerError uint32
+
+// VirtualKeyboardManagerError :
+const (
+	// VirtualKeyboardManagerErrorUnauthorized : client not authorized to use the interface
+	VirtualKeyboardManagerErrorUnauthorized VirtualKeyboardManagerError = 0
+)
+
+func (e VirtualKeyboardManagerError) Name() string {
+	switch e {
+	case VirtualKeyboardManagerErrorUnauthorized:
+		return "unauthorized"
+	default:
+		return ""
+	}
+}
+
+func (e VirtualKeyboardManagerError) Value() string {
+	switch e {
+	case VirtualKeyboardManagerErrorUnauthorized:
+		return "0"
+	default:
+		return ""
+	}
+}
+
+func (e VirtualKeyboardManagerError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+func init() {
+	client.RegisterInterface(VirtualKeyboardInterface)
+	client.RegisterInterface(VirtualKeyboardManagerInterface)
+}
@@ -0,0 +1,7 @@
+// Package virtual_pointer is Go binding of the wlr-virtual-pointer-unstable-v1
+// protocol, used to emulate a physical pointer device.
+//
+// Stability: unstable. wlr-protocols are maintained outside of
+// wayland-protocols and only implemented by wlroots based compositors,
+// backward incompatible changes bump the version in the interface names.
+package virtual_pointer
@@ -0,0 +1,573 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : wlr/virtual-pointer/wlr-virtual-pointer-unstable-v1.xml
+// XML sha256 : c2ee7f08241bdc0d9c43dceff3da08be8a5da035c027d689dad46589840e6b67
+//
+// wlr_virtual_pointer_unstable_v1 Protocol Copyright:
+//
+// Copyright © 2019 Josef Gajdusek
+//
+// Permission is hereby granted, free of charge, to any person obtaining a
+// copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice (including the next
+// paragraph) shall be included in all copies or substantial portions of the
+// Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.  IN NO EVENT SHALL
+// THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package virtual_pointer
+
+import "github.com/hempflower/go-wayland/wayland/client"
+
+// VirtualPointerName : virtual pointer
+const VirtualPointerName = "zwlr_virtual_pointer_v1"
+
+// VirtualPointerInterface : metadata of the zwlr_virtual_pointer_v1 interface
+var VirtualPointerInterface = &client.Interface{
+	Name:    VirtualPointerName,
+	Version: 2,
+	New:     func(ctx *client.Context) client.Proxy { return NewVirtualPointer(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "motion",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "time", Type: client.ArgTypeUint},
+				{Name: "dx", Type: client.ArgTypeFixed},
+				{Name: "dy", Type: client.ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "motion_absolute",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "time", Type: client.ArgTypeUint},
+				{Name: "x", Type: client.ArgTypeUint},
+				{Name: "y", Type: client.ArgTypeUint},
+				{Name: "x_extent", Type: client.ArgTypeUint},
+				{Name: "y_extent", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "button",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "time", Type: client.ArgTypeUint},
+				{Name: "button", Type: client.ArgTypeUint},
+				{Name: "state", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "axis",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "time", Type: client.ArgTypeUint},
+				{Name: "axis", Type: client.ArgTypeUint},
+				{Name: "value", Type: client.ArgTypeFixed},
+			},
+		},
+		{
+			Name:  "frame",
+			Since: 1,
+		},
+		{
+			Name:  "axis_source",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "axis_source", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "axis_stop",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "time", Type: client.ArgTypeUint},
+				{Name: "axis", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "axis_discrete",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "time", Type: client.ArgTypeUint},
+				{Name: "axis", Type: client.ArgTypeUint},
+				{Name: "value", Type: client.ArgTypeFixed},
+				{Name: "discrete", Type: client.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns VirtualPointerInterface
+func (i *VirtualPointer) Interface() *client.Interface {
+	return VirtualPointerInterface
+}
+
+// VirtualPointer : virtual pointer
+//
+// This protocol allows clients to emulate a physical pointer device. The
+// requests are mostly mirror opposites of those specified in wl_pointer.
+type VirtualPointer struct {
+	client.BaseProxy
+}
+
+// NewVirtualPointer : virtual pointer
+//
+// This protocol allows clients to emulate a physical pointer device. The
+// requests are mostly mirror opposites of those specified in wl_pointer.
+func NewVirtualPointer(ctx *client.Context) *VirtualPointer {
+	zwlrVirtualPointerV1 := &VirtualPointer{}
+	ctx.Register(zwlrVirtualPointerV1)
+	return zwlrVirtualPointerV1
+}
+
+// VirtualPointerMotionSinceVersion : version of VirtualPointer that introduced Motion
+const VirtualPointerMotionSinceVersion = 1
+
+// Motion : pointer relative motion event
+//
+// The pointer has moved by a relative amount to the previous request.
+//
+// Values are in the global compositor space.
+//
+//	time: timestamp with millisecond granularity
+//	dx: displacement on the x-axis
+//	dy: displacement on the y-axis
+func (i *VirtualPointer) Motion(time uint32, dx, dy float64) error {
+	const opcode = 0
+	const _reqBufLen = 8 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(time))
+	l += 4
+	client.PutFixed(_reqBuf[l:l+4], dx)
+	l += 4
+	client.PutFixed(_reqBuf[l:l+4], dy)
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerMotionAbsoluteSinceVersion : version of VirtualPointer that introduced MotionAbsolute
+const VirtualPointerMotionAbsoluteSinceVersion = 1
+
+// MotionAbsolute : pointer absolute motion event
+//
+// The pointer has moved in an absolute coordinate frame.
+//
+// Value of x can range from 0 to x_extent, value of y can range from 0
+// to y_extent.
+//
+//	time: timestamp with millisecond granularity
+//	x: position on the x-axis
+//	y: position on the y-axis
+//	xExtent: extent of the x-axis
+//	yExtent: extent of the y-axis
+func (i *VirtualPointer) MotionAbsolute(time, x, y, xExtent, yExtent uint32) error {
+	const opcode = 1
+	const _reqBufLen = 8 + 4 + 4 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(time))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(x))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(y))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(xExtent))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(yExtent))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerButtonSinceVersion : version of VirtualPointer that introduced Button
+const VirtualPointerButtonSinceVersion = 1
+
+// Button : button event
+//
+// A button was pressed or released.
+//
+//	time: timestamp with millisecond granularity
+//	button: button that produced the event
+//	state: physical state of the button
+func (i *VirtualPointer) Button(time, button uint32, state client.PointerButtonState) error {
+	const opcode = 2
+	const _reqBufLen = 8 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(time))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(button))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(state))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerAxisSinceVersion : version of VirtualPointer that introduced Axis
+const VirtualPointerAxisSinceVersion = 1
+
+// Axis : axis event
+//
+// Scroll and other axis requests.
+//
+//	time: timestamp with millisecond granularity
+//	axis: axis type
+//	value: length of vector in touchpad coordinates
+func (i *VirtualPointer) Axis(time uint32, axis client.PointerAxis, value float64) error {
+	const opcode = 3
+	const _reqBufLen = 8 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(time))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(axis))
+	l += 4
+	client.PutFixed(_reqBuf[l:l+4], value)
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerFrameSinceVersion : version of VirtualPointer that introduced Frame
+const VirtualPointerFrameSinceVersion = 1
+
+// Frame : end of a pointer event sequence
+//
+// Indicates the set of events that logically belong together.
+func (i *VirtualPointer) Frame() error {
+	const opcode = 4
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerAxisSourceSinceVersion : version of VirtualPointer that introduced AxisSource
+const VirtualPointerAxisSourceSinceVersion = 1
+
+// AxisSource : axis source event
+//
+// Source information for scroll and other axis.
+//
+//	axisSource: source of the axis event
+func (i *VirtualPointer) AxisSource(axisSource client.PointerAxisSource) error {
+	const opcode = 5
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(axisSource))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerAxisStopSinceVersion : version of VirtualPointer that introduced AxisStop
+const VirtualPointerAxisStopSinceVersion = 1
+
+// AxisStop : axis stop event
+//
+// Stop notification for scroll and other axes.
+//
+//	time: timestamp with millisecond granularity
+//	axis: the axis stopped with this event
+func (i *VirtualPointer) AxisStop(time uint32, axis client.PointerAxis) error {
+	const opcode = 6
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(time))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(axis))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerAxisDiscreteSinceVersion : version of VirtualPointer that introduced AxisDiscrete
+const VirtualPointerAxisDiscreteSinceVersion = 1
+
+// AxisDiscrete : axis click event
+//
+// Discrete step information for scroll and other axes.
+//
+// This event allows the client to extend data normally sent using the axis
+// event with discrete value.
+//
+//	time: timestamp with millisecond granularity
+//	axis: axis type
+//	value: length of vector in touchpad coordinates
+//	discrete: number of steps
+func (i *VirtualPointer) AxisDiscrete(time uint32, axis client.PointerAxis, value float64, discrete int32) error {
+	const opcode = 7
+	const _reqBufLen = 8 + 4 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(time))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(axis))
+	l += 4
+	client.PutFixed(_reqBuf[l:l+4], value)
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(discrete))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerDestroySinceVersion : version of VirtualPointer that introduced Destroy
+const VirtualPointerDestroySinceVersion = 1
+
+// Destroy : destroy virtual pointer object
+func (i *VirtualPointer) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 8
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type VirtualPointerError uint32
+
+// VirtualPointerError :
+const (
+	// VirtualPointerErrorInvalidAxis : client sent invalid axis enumeration value
+	VirtualPointerErrorInvalidAxis VirtualPointerError = 0
+	// VirtualPointerErrorInvalidAxisSource : client sent invalid axis source enumeration value
+	VirtualPointerErrorInvalidAxisSource VirtualPointerError = 1
+)
+
+func (e VirtualPointerError) Name() string {
+	switch e {
+	case VirtualPointerErrorInvalidAxis:
+		return "invalid_axis"
+	case VirtualPointerErrorInvalidAxisSource:
+		return "invalid_axis_source"
+	default:
+		return ""
+	}
+}
+
+func (e VirtualPointerError) Value() string {
+	switch e {
+	case VirtualPointerErrorInvalidAxis:
+		return "0"
+	case VirtualPointerErrorInvalidAxisSource:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+func (e VirtualPointerError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// VirtualPointerManagerName : virtual pointer manager
+const VirtualPointerManagerName = "zwlr_virtual_pointer_manager_v1"
+
+// VirtualPointerManagerInterface : metadata of the zwlr_virtual_pointer_manager_v1 interface
+var VirtualPointerManagerInterface = &client.Interface{
+	Name:    VirtualPointerManagerName,
+	Version: 2,
+	New:     func(ctx *client.Context) client.Proxy { return NewVirtualPointerManager(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "create_virtual_pointer",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "seat", Type: client.ArgTypeObject, Interface: "wl_seat", AllowNull: true},
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwlr_virtual_pointer_v1"},
+			},
+		},
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "create_virtual_pointer_with_output",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "seat", Type: client.ArgTypeObject, Interface: "wl_seat", AllowNull: true},
+				{Name: "output", Type: client.ArgTypeObject, Interface: "wl_output", AllowNull: true},
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwlr_virtual_pointer_v1"},
+			},
+		},
+	},
+}
+
+// Interface : returns VirtualPointerManagerInterface
+func (i *VirtualPointerManager) Interface() *client.Interface {
+	return VirtualPointerManagerInterface
+}
+
+// VirtualPointerManager : virtual pointer manager
+//
+// This object allows clients to create individual virtual pointer objects.
+type VirtualPointerManager struct {
+	client.BaseProxy
+}
+
+// NewVirtualPointerManager : virtual pointer manager
+//
+// This object allows clients to create individual virtual pointer objects.
+func NewVirtualPointerManager(ctx *client.Context) *VirtualPointerManager {
+	zwlrVirtualPointerManagerV1 := &VirtualPointerManager{}
+	ctx.Register(zwlrVirtualPointerManagerV1)
+	return zwlrVirtualPointerManagerV1
+}
+
+// VirtualPointerManagerCreateVirtualPointerSinceVersion : version of VirtualPointerManager that introduced CreateVirtualPointer
+const VirtualPointerManagerCreateVirtualPointerSinceVersion = 1
+
+// CreateVirtualPointer : Create a new virtual pointer
+//
+// Creates a new virtual pointer. The optional seat is a suggestion to the
+// compositor.
+func (i *VirtualPointerManager) CreateVirtualPointer(seat *client.Seat) (*VirtualPointer, error) {
+	id := NewVirtualPointer(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 0
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	if seat == nil {
+		client.PutUint32(_reqBuf[l:l+4], 0)
+		l += 4
+	} else {
+		client.PutUint32(_reqBuf[l:l+4], seat.ID())
+		l += 4
+	}
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+// VirtualPointerManagerDestroySinceVersion : version of VirtualPointerManager that introduced Destroy
+const VirtualPointerManagerDestroySinceVersion = 1
+
+// Destroy : destroy the virtual pointer manager
+func (i *VirtualPointerManager) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 1
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// VirtualPointerManagerCreateVirtualPointerWithOutputSinceVersion : version of VirtualPointerManager that introduced CreateVirtualPointerWithOutput
+const VirtualPointerManagerCreateVirtualPointerWithOutputSinceVersion = 2
+
+// CreateVirtualPointerWithOutput : Create a new virtual pointer
+//
+// Creates a new virtual pointer. The seat and the output arguments are
+// optional. If the seat argument is set, the compositor should assign the
+// input device to the requested seat. If the output argument is set, the
+// compositor should map the input device to the requested output.
+func (i *VirtualPointerManager) CreateVirtualPointerWithOutput(seat *client.Seat, output *client.Output) (*VirtualPointer, error) {
+	if v := i.Version(); v < VirtualPointerManagerCreateVirtualPointerWithOutputSinceVersion {
+		return nil, &client.VersionError{Interface: VirtualPointerManagerName, Request: "create_virtual_pointer_with_output", Since: VirtualPointerManagerCreateVirtualPointerWithOutputSinceVersion, Version: v}
+	}
+	id := NewVirtualPointer(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 2
+	const _reqBufLen = 8 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	if seat == nil {
+		client.PutUint32(_reqBuf[l:l+4], 0)
+		l += 4
+	} else {
+		client.PutUint32(_reqBuf[l:l+4], seat.ID())
+		l += 4
+	}
+	if output == nil {
+		client.PutUint32(_reqBuf[l:l+4], 0)
+		l += 4
+	} else {
+		client.PutUint32(_reqBuf[l:l+4], output.ID())
+		l += 4
+	}
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+func init() {
+	client.RegisterInterface(VirtualPointerInterface)
+	client.RegisterInterface(VirtualPointerManagerInterface)
+}
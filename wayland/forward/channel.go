@@ -0,0 +1,601 @@
+package forward
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"github.com/hempflower/go-wayland/wayland/internal/wire"
+	"golang.org/x/sys/unix"
+)
+
+// unforwarded are the globals hidden from the clients by the local end,
+// their fds can't be copied over a stream
+var unforwarded = map[string]bool{
+	"zwp_linux_dmabuf_v1":                   true,
+	"wl_drm":                                true,
+	"wp_linux_drm_syncobj_manager_v1":       true,
+	"zwp_linux_explicit_synchronization_v1": true,
+}
+
+// maxFileSize bounds the files copied through the tunnel, keymaps are
+// much smaller
+const maxFileSize = 8 << 20
+
+// fdKind tells how the end receiving a message recreates one of its
+// fds. The fds of a data frame are described by their kind, an id and a
+// size.
+type fdKind uint32
+
+const (
+	// fdShm is a shm pool mirrored in a memfd, updated by shm frames
+	fdShm fdKind = iota + 1
+	// fdFile is a memfd with a copy of a file, its contents follow the
+	// descriptions of the fds
+	fdFile
+	// fdPipeSink is the write end of a pipe, the data written to it is
+	// relayed to the sender of the message
+	fdPipeSink
+	// fdPipeSource is the read end of a pipe, the sender of the message
+	// relays the data to write to it
+	fdPipeSource
+)
+
+// channel is a client connection forwarded through the tunnel, conn is
+// the client on the remote end and the compositor on the local end
+type channel struct {
+	s    *session
+	id   uint32
+	conn *net.UnixConn
+
+	mu      sync.Mutex
+	objects map[uint32]*client.Interface
+	// mirrors are the memfds of the pools of the other end, by pool id
+	mirrors map[uint32]int
+
+	// pools are the shm pools of conn mirrored on the other end, owned by
+	// serve
+	pools       map[uint32]*shmPool
+	poolObjects map[uint32]*shmPool
+	nextPool    uint32
+	// shmSize is the total size of the pools
+	shmSize int
+	// attached are the buffers attached to the surfaces, by surface id
+	attached map[uint32]uint32
+
+	closeOnce sync.Once
+}
+
+func newChannel(s *session, id uint32, conn *net.UnixConn) *channel {
+	return &channel{
+		s:           s,
+		id:          id,
+		conn:        conn,
+		objects:     map[uint32]*client.Interface{1: client.DisplayInterface},
+		mirrors:     map[uint32]int{},
+		pools:       map[uint32]*shmPool{},
+		poolObjects: map[uint32]*shmPool{},
+		attached:    map[uint32]uint32{},
+	}
+}
+
+// close closes the connection, serve releases the pools once it returns
+func (c *channel) close() {
+	c.closeOnce.Do(func() {
+		c.conn.Close()
+
+		c.mu.Lock()
+		for id, fd := range c.mirrors {
+			unix.Close(fd)
+			delete(c.mirrors, id)
+		}
+		c.mu.Unlock()
+	})
+}
+
+// serve forwards the messages read from conn until it's closed
+func (c *channel) serve() {
+	c.forward()
+
+	for _, p := range c.pools {
+		p.release()
+	}
+	c.pools = nil
+	c.poolObjects = nil
+
+	if c.s.removeChannel(c.id) != nil {
+		c.s.send(frameClose, c.id, nil)
+	}
+	c.close()
+}
+
+func (c *channel) forward() error {
+	buf := make([]byte, 4096)
+	oob := make([]byte, wire.OobSpace)
+
+	var in []byte
+	var fds []int
+	defer func() {
+		closeAll(fds)
+	}()
+
+	for {
+		n, oobn, _, _, err := c.conn.ReadMsgUnix(buf, oob)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+
+		if oobn > 0 {
+			got, err := wire.ParseFds(oob, oobn, "forward")
+			if err != nil {
+				return err
+			}
+			fds = append(fds, got...)
+		}
+
+		in = append(in, buf[:n]...)
+		for len(in) >= 8 {
+			size := int(wire.Uint32(in[4:]) >> 16)
+			if size < 8 {
+				return fmt.Errorf("message of %d bytes", size)
+			}
+			if len(in) < size {
+				break
+			}
+
+			fds, err = c.message(in[:size], fds)
+			if err != nil {
+				return err
+			}
+			in = in[size:]
+		}
+		if len(in) == 0 {
+			in = nil
+		}
+	}
+}
+
+// message forwards a message read from conn and returns the fds left
+// for the next messages
+func (c *channel) message(data []byte, fds []int) ([]int, error) {
+	id := wire.Uint32(data)
+	opcode := wire.Uint32(data[4:]) & 0xffff
+	// The remote end reads requests from the clients, the local end
+	// events from the compositor
+	request := c.s.remote
+
+	c.mu.Lock()
+	iface := c.objects[id]
+	c.mu.Unlock()
+
+	var msg *client.Message
+	if iface != nil {
+		msg = iface.Event(opcode)
+		if request {
+			msg = iface.Request(opcode)
+		}
+	}
+	// The fds of messages of unknown objects are left for the next ones
+	var args []argValue
+	var n int
+	if msg != nil {
+		var err error
+		if args, err = parseArgs(msg, data[8:]); err != nil {
+			return fds, fmt.Errorf("%s.%s: %w", iface.Name, msg.Name, err)
+		}
+		for _, arg := range msg.Args {
+			if arg.Type == client.ArgTypeFd {
+				n++
+			}
+		}
+	}
+	if n > len(fds) {
+		return fds, fmt.Errorf("%s.%s: missing fds", iface.Name, msg.Name)
+	}
+	mfds := fds[:n]
+	fds = fds[n:]
+
+	name := ""
+	if msg != nil {
+		name = iface.Name + "." + msg.Name
+	}
+	switch name {
+	case "wl_registry.global":
+		if !request && unforwarded[args[1].s] {
+			return fds, nil
+		}
+	case "wl_surface.commit":
+		// The contents of the buffers are up to date on the other end
+		// before the compositor reads them
+		if err := c.flush(id); err != nil {
+			closeAll(mfds)
+			return fds, err
+		}
+	case "wl_shm_pool.resize":
+		if request {
+			if err := c.resizePool(id, int32(args[0].u)); err != nil {
+				closeAll(mfds)
+				return fds, err
+			}
+		}
+	}
+
+	p := payload(nil).uint32(uint32(len(mfds)))
+	var files []byte
+	var after []func()
+	for i, fd := range mfds {
+		if name == "wl_shm.create_pool" && request {
+			pool, err := c.newPool(args[0].u, fd, int32(args[2].u))
+			if err != nil {
+				closeAll(mfds[i+1:])
+				return fds, err
+			}
+			p = p.uint32(uint32(fdShm)).uint32(pool.id).uint32(uint32(len(pool.shadow)))
+			continue
+		}
+
+		kind, pipeID, contents, start, err := c.translate(fd)
+		if err != nil {
+			closeAll(mfds[i+1:])
+			return fds, err
+		}
+		p = p.uint32(uint32(kind)).uint32(pipeID).uint32(uint32(len(contents)))
+		files = append(files, contents...)
+		if start != nil {
+			after = append(after, start)
+		}
+	}
+	p = p.bytes(files).bytes(data)
+
+	err := c.s.send(frameData, c.id, p)
+	for _, f := range after {
+		f()
+	}
+	if err != nil {
+		return fds, err
+	}
+
+	c.track(request, iface, msg, id, args)
+	if request {
+		c.trackPools(name, id, args)
+	}
+	return fds, nil
+}
+
+// translate describes an fd which isn't a shm pool, it takes ownership
+// of fd. start is run once the message is sent.
+func (c *channel) translate(fd int) (kind fdKind, id uint32, contents []byte, start func(), err error) {
+	var st unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		unix.Close(fd)
+		return 0, 0, nil, nil, err
+	}
+
+	switch st.Mode & unix.S_IFMT {
+	case unix.S_IFIFO, unix.S_IFSOCK:
+		flags, err := unix.FcntlInt(uintptr(fd), unix.F_GETFL, 0)
+		if err != nil {
+			unix.Close(fd)
+			return 0, 0, nil, nil, err
+		}
+		id := c.s.newPipeID()
+		f := pipeFile(fd, "forward-pipe")
+		if flags&unix.O_ACCMODE == unix.O_RDONLY {
+			// The pipe on the other end isn't registered before the
+			// message is received
+			return fdPipeSource, id, nil, func() { go c.s.relay(id, f) }, nil
+		}
+		c.s.addPipe(id, newPipeWriter(f))
+		return fdPipeSink, id, nil, nil, nil
+	}
+
+	// Anything else, keymaps mostly, is copied
+	defer unix.Close(fd)
+	if st.Size > maxFileSize {
+		return 0, 0, nil, nil, fmt.Errorf("file of %d bytes is too large to forward", st.Size)
+	}
+	contents = make([]byte, st.Size)
+	n := 0
+	for n < len(contents) {
+		m, err := unix.Pread(fd, contents[n:], int64(n))
+		if err != nil && !errors.Is(err, unix.EINTR) {
+			return 0, 0, nil, nil, err
+		}
+		if m == 0 {
+			break
+		}
+		n += m
+	}
+	return fdFile, 0, contents[:n], nil, nil
+}
+
+// handle handles a frame of the other end of the channel
+func (c *channel) handle(f frame) error {
+	r := &reader{b: f.payload}
+
+	switch f.typ {
+	case frameShmUpdate:
+		pool, offset := r.uint32(), r.uint32()
+		if r.err != nil {
+			return fmt.Errorf("%w: %s frame: %v", ErrProtocol, f.typ, r.err)
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if fd, ok := c.mirrors[pool]; ok {
+			return pwriteAll(fd, r.b, int64(offset))
+		}
+		return nil
+
+	case frameShmResize:
+		pool, size := r.uint32(), r.uint32()
+		if r.err != nil {
+			return fmt.Errorf("%w: %s frame: %v", ErrProtocol, f.typ, r.err)
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if fd, ok := c.mirrors[pool]; ok {
+			return unix.Ftruncate(fd, int64(size))
+		}
+		return nil
+
+	case frameShmFree:
+		pool := r.uint32()
+		if r.err != nil {
+			return fmt.Errorf("%w: %s frame: %v", ErrProtocol, f.typ, r.err)
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if fd, ok := c.mirrors[pool]; ok {
+			unix.Close(fd)
+			delete(c.mirrors, pool)
+		}
+		return nil
+	}
+
+	return c.deliver(r)
+}
+
+// deliver writes a message of the other end to conn, with its fds
+// recreated
+func (c *channel) deliver(r *reader) error {
+	type desc struct {
+		kind     fdKind
+		id, size uint32
+	}
+	count := r.uint32()
+	if count > wire.MaxFds {
+		return fmt.Errorf("%w: data frame with %d fds", ErrProtocol, count)
+	}
+	descs := make([]desc, count)
+	for i := range descs {
+		descs[i] = desc{fdKind(r.uint32()), r.uint32(), r.uint32()}
+	}
+	if r.err != nil {
+		return fmt.Errorf("%w: data frame: %v", ErrProtocol, r.err)
+	}
+
+	// fds are passed to conn, then closed unless kept
+	var fds, keep []int
+	defer func() {
+		for _, fd := range fds {
+			kept := false
+			for _, k := range keep {
+				kept = kept || k == fd
+			}
+			if !kept {
+				unix.Close(fd)
+			}
+		}
+	}()
+
+	for _, d := range descs {
+		switch d.kind {
+		case fdShm:
+			fd, err := memfd("forward-shm", nil, d.size)
+			if err != nil {
+				return err
+			}
+			fds = append(fds, fd)
+			keep = append(keep, fd)
+
+			c.mu.Lock()
+			if old, ok := c.mirrors[d.id]; ok {
+				unix.Close(old)
+			}
+			c.mirrors[d.id] = fd
+			c.mu.Unlock()
+
+		case fdFile:
+			contents := r.bytes(d.size)
+			if r.err != nil {
+				return fmt.Errorf("%w: data frame: %v", ErrProtocol, r.err)
+			}
+			fd, err := memfd("forward-file", contents, d.size)
+			if err != nil {
+				return err
+			}
+			fds = append(fds, fd)
+
+		case fdPipeSink, fdPipeSource:
+			var p [2]int
+			if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+				return err
+			}
+			if d.kind == fdPipeSink {
+				// Relay what the peer writes to the holder of the sink
+				fds = append(fds, p[1])
+				go c.s.relay(d.id, pipeFile(p[0], "forward-pipe"))
+			} else {
+				fds = append(fds, p[0])
+				c.s.addPipe(d.id, newPipeWriter(pipeFile(p[1], "forward-pipe")))
+			}
+
+		default:
+			return fmt.Errorf("%w: unknown fd kind %d", ErrProtocol, d.kind)
+		}
+	}
+
+	data := r.b
+	if len(data) < 8 {
+		return fmt.Errorf("%w: data frame without message", ErrProtocol)
+	}
+
+	var oob []byte
+	if len(fds) > 0 {
+		oob = unix.UnixRights(fds...)
+	}
+	if err := writeMsg(c.conn, data, oob); err != nil {
+		return err
+	}
+
+	// Track the objects created by the message
+	id := wire.Uint32(data)
+	opcode := wire.Uint32(data[4:]) & 0xffff
+	request := !c.s.remote
+
+	c.mu.Lock()
+	iface := c.objects[id]
+	c.mu.Unlock()
+	if iface == nil {
+		return nil
+	}
+	msg := iface.Event(opcode)
+	if request {
+		msg = iface.Request(opcode)
+	}
+	if msg == nil {
+		return nil
+	}
+	args, err := parseArgs(msg, data[8:])
+	if err != nil {
+		return nil
+	}
+	c.track(request, iface, msg, id, args)
+
+	return nil
+}
+
+// track updates the objects of the connection after a message
+func (c *channel) track(request bool, iface *client.Interface, msg *client.Message, id uint32, args []argValue) {
+	if msg == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, arg := range msg.Args {
+		if arg.Type != client.ArgTypeNewID {
+			continue
+		}
+		ifaceName := arg.Interface
+		if ifaceName == "" {
+			ifaceName = args[i].s
+		}
+		c.objects[args[i].u] = client.LookupInterface(ifaceName)
+	}
+
+	if !request && id == 1 && msg.Name == "delete_id" {
+		delete(c.objects, args[0].u)
+	}
+}
+
+// argValue is the value of an argument, untyped new_ids have the
+// interface name in s
+type argValue struct {
+	u uint32
+	s string
+}
+
+// parseArgs decodes the arguments of msg, arrays are skipped
+func parseArgs(msg *client.Message, data []byte) ([]argValue, error) {
+	args := make([]argValue, len(msg.Args))
+	r := &reader{b: data}
+	str := func() string {
+		b := r.bytes(uint32(client.PaddedLen(int(r.uint32()))))
+		return strings.TrimRight(string(b), "\x00")
+	}
+
+	for i, arg := range msg.Args {
+		switch {
+		case arg.Type == client.ArgTypeFd:
+		case arg.Type == client.ArgTypeString:
+			args[i].s = str()
+		case arg.Type == client.ArgTypeArray:
+			r.bytes(uint32(client.PaddedLen(int(r.uint32()))))
+		case arg.Type == client.ArgTypeNewID && arg.Interface == "":
+			// Interface name, version and id
+			args[i].s = str()
+			r.uint32()
+			args[i].u = r.uint32()
+		default:
+			args[i].u = r.uint32()
+		}
+	}
+	if r.err != nil {
+		return nil, errors.New("message too short")
+	}
+
+	return args, nil
+}
+
+// writeMsg writes all of b to conn, the fds in oob are sent along with
+// the first chunk
+func writeMsg(conn *net.UnixConn, b, oob []byte) error {
+	for len(b) > 0 {
+		n, oobn, err := conn.WriteMsgUnix(b, oob, nil)
+		if err != nil {
+			return err
+		}
+		if oobn != len(oob) {
+			return fmt.Errorf("incorrect number of oob bytes written (oobn=%d)", oobn)
+		}
+		b = b[n:]
+		oob = nil
+	}
+	return nil
+}
+
+// memfd returns a memfd of size bytes starting with contents
+func memfd(name string, contents []byte, size uint32) (int, error) {
+	fd, err := unix.MemfdCreate(name, unix.MFD_CLOEXEC)
+	if err != nil {
+		return -1, err
+	}
+	if err := unix.Ftruncate(fd, int64(size)); err != nil {
+		unix.Close(fd)
+		return -1, err
+	}
+	if err := pwriteAll(fd, contents, 0); err != nil {
+		unix.Close(fd)
+		return -1, err
+	}
+	return fd, nil
+}
+
+func pwriteAll(fd int, b []byte, offset int64) error {
+	for len(b) > 0 {
+		n, err := unix.Pwrite(fd, b, offset)
+		if errors.Is(err, unix.EINTR) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		b = b[n:]
+		offset += int64(n)
+	}
+	return nil
+}
+
+func closeAll(fds []int) {
+	for _, fd := range fds {
+		unix.Close(fd)
+	}
+}
@@ -0,0 +1,30 @@
+// Package forward runs wayland clients on another machine than the
+// compositor, in the spirit of waypipe: the connections of the clients
+// are multiplexed over a single stream, e.g. a TCP connection or the
+// stdio of ssh.
+//
+// The Remote end listens on a display socket next to the clients, the
+// Local end connects each of them to the compositor:
+//
+//	// On the machine of the clients
+//	sock, err := server.Listen("wayland-forward")
+//	...
+//	err = forward.Remote(conn, sock)
+//
+//	// On the machine of the compositor
+//	err = forward.Local(conn, "/run/user/1000/wayland-0")
+//
+// Messages are decoded with the metadata of the registered interfaces,
+// to translate the file descriptors they carry:
+//
+//   - shm pools are mirrored in memfds on the local end, the changes of
+//     the pool of the buffer attached to a surface are sent on its
+//     wl_surface.commit. The pools of a client are limited to 512 MiB.
+//   - pipes, e.g. of wl_data_offer.receive and wl_data_source.send, are
+//     relayed in both directions
+//   - other files, e.g. keymaps, are copied
+//
+// Globals whose fds can't be copied, linux-dmabuf and wl_drm, are hidden
+// from the clients. The fds of messages of interfaces which aren't
+// registered, by importing their package, can't be forwarded.
+package forward
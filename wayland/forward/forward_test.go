@@ -0,0 +1,481 @@
+package forward
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"github.com/hempflower/go-wayland/wayland/server"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	testKeymap    = "xkb_keymap { ... };"
+	testSelection = "selection contents"
+)
+
+// compositor is a mock compositor reporting the contents of the shm
+// buffers committed to its surfaces
+type compositor struct {
+	commits chan []byte
+	pending *server.Buffer
+}
+
+func (c *compositor) bindCompositor(r server.Resource) {
+	r.(*server.Compositor).SetHandler(c)
+}
+
+func (c *compositor) CreateSurface(r *server.Compositor, id *server.Surface) {
+	id.SetHandler(c)
+}
+
+func (c *compositor) CreateRegion(r *server.Compositor, id *server.Region) {}
+
+func (c *compositor) Destroy(r *server.Surface) {}
+
+func (c *compositor) Attach(r *server.Surface, buffer *server.Buffer, x, y int32) {
+	c.pending = buffer
+}
+
+func (c *compositor) Damage(r *server.Surface, x, y, width, height int32) {}
+
+func (c *compositor) Frame(r *server.Surface, callback *server.Callback) {}
+
+func (c *compositor) SetOpaqueRegion(r *server.Surface, region *server.Region) {}
+
+func (c *compositor) SetInputRegion(r *server.Surface, region *server.Region) {}
+
+func (c *compositor) Commit(r *server.Surface) {
+	b := server.GetShmBuffer(c.pending)
+	if b == nil {
+		r.PostError(0, "shm buffer expected")
+		return
+	}
+
+	var contents []byte
+	if err := b.Access(func(data []byte) {
+		contents = append(contents, data...)
+	}); err != nil {
+		r.PostError(0, "%v", err)
+		return
+	}
+	c.commits <- contents
+}
+
+func (c *compositor) SetBufferTransform(r *server.Surface, transform server.OutputTransform) {}
+
+func (c *compositor) SetBufferScale(r *server.Surface, scale int32) {}
+
+func (c *compositor) DamageBuffer(r *server.Surface, x, y, width, height int32) {}
+
+func (c *compositor) Offset(r *server.Surface, x, y int32) {}
+
+func (c *compositor) bindSeat(r server.Resource) {
+	res := r.(*server.Seat)
+	res.SetHandler(c)
+	res.SendCapabilities(server.SeatCapabilityKeyboard)
+}
+
+func (c *compositor) GetPointer(r *server.Seat, id *server.Pointer) {}
+
+func (c *compositor) GetKeyboard(r *server.Seat, id *server.Keyboard) {
+	fd, err := memfd("keymap", []byte(testKeymap), uint32(len(testKeymap)+1))
+	if err != nil {
+		r.PostError(0, "%v", err)
+		return
+	}
+	id.SendKeymap(server.KeyboardKeymapFormatXkbV1, fd, uint32(len(testKeymap)+1))
+	unix.Close(fd)
+}
+
+func (c *compositor) GetTouch(r *server.Seat, id *server.Touch) {}
+
+func (c *compositor) Release(r *server.Seat) {}
+
+// selection is a mock data device manager, its selection is offered to
+// every data device and its transfers write testSelection to the pipe
+// of the client
+type selection struct{}
+
+func (selection) bind(r server.Resource) {
+	r.(*server.DataDeviceManager).SetHandler(selection{})
+}
+
+func (selection) CreateDataSource(r *server.DataDeviceManager, id *server.DataSource) {}
+
+func (selection) GetDataDevice(r *server.DataDeviceManager, id *server.DataDevice, seat *server.Seat) {
+	offer, err := id.SendDataOffer()
+	if err != nil {
+		return
+	}
+	offer.SetHandler(selection{})
+	offer.SendOffer("text/plain")
+	id.SendSelection(offer)
+}
+
+func (selection) Accept(r *server.DataOffer, serial uint32, mimeType string) {}
+
+func (selection) Receive(r *server.DataOffer, mimeType string, fd int) {
+	// Written in the background like a real source would
+	f := os.NewFile(uintptr(fd), "selection")
+	go func() {
+		defer f.Close()
+		f.Write([]byte(testSelection))
+	}()
+}
+
+func (selection) Destroy(r *server.DataOffer) {}
+
+func (selection) Finish(r *server.DataOffer) {}
+
+func (selection) SetActions(r *server.DataOffer, dndActions, preferredAction server.DataDeviceManagerDndAction) {
+}
+
+// startForward serves a mock compositor and forwards it over a
+// net.Pipe to a new display, it returns the path of the display
+func startForward(t *testing.T, comp *compositor) string {
+	t.Helper()
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+
+	s := server.New()
+	t.Cleanup(func() { s.Close() })
+	if _, err := s.CreateGlobal(server.CompositorInterface, 5, comp.bindCompositor); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.InitShm(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateGlobal(server.SeatInterface, 7, comp.bindSeat); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateGlobal(server.DataDeviceManagerInterface, 3, selection{}.bind); err != nil {
+		t.Fatal(err)
+	}
+
+	compositorSock, err := server.Listen("wayland-compositor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(compositorSock)
+
+	sock, err := server.Listen("wayland-forward")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remote, local := net.Pipe()
+	errs := make(chan error, 2)
+	go func() { errs <- Remote(remote, sock) }()
+	go func() { errs <- Local(local, compositorSock.Path()) }()
+	t.Cleanup(func() {
+		sock.Close()
+		remote.Close()
+		for i := 0; i < 2; i++ {
+			if err := <-errs; err != nil {
+				t.Errorf("forward: %v", err)
+			}
+		}
+	})
+
+	return sock.Path()
+}
+
+func TestForwardShm(t *testing.T) {
+	comp := &compositor{commits: make(chan []byte, 1)}
+	display, err := client.Connect(startForward(t, comp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer display.Context().Close()
+
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compositor, err := client.BindGlobal[*client.Compositor](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shm, err := client.BindGlobal[*client.Shm](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Several diff blocks, the second commit only changes one of them
+	const width, height, stride = 64, 64, 64 * 4
+	contents := make([]byte, stride*height)
+	for i := range contents {
+		contents[i] = byte(i * 7)
+	}
+	fd, err := memfd("pool", contents, uint32(len(contents)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	pool, err := shm.CreatePool(fd, int32(len(contents)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer, err := pool.CreateBuffer(0, width, height, stride, client.ShmFormatArgb8888)
+	if err != nil {
+		t.Fatal(err)
+	}
+	surface, err := compositor.CreateSurface()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commit := func() {
+		t.Helper()
+		if err := surface.Attach(buffer, 0, 0); err != nil {
+			t.Fatal(err)
+		}
+		if err := surface.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		if err := display.Roundtrip(); err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case got := <-comp.commits:
+			if !bytes.Equal(got, contents) {
+				t.Fatal("committed buffer differs from the client buffer")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("no commit")
+		}
+	}
+
+	commit()
+
+	row := bytes.Repeat([]byte{0xff}, stride)
+	copy(contents[10*stride:], row)
+	if _, err := unix.Pwrite(fd, row, 10*stride); err != nil {
+		t.Fatal(err)
+	}
+	commit()
+}
+
+func TestForwardKeymap(t *testing.T) {
+	comp := &compositor{}
+	display, err := client.Connect(startForward(t, comp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer display.Context().Close()
+
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seat, err := client.BindGlobal[*client.Seat](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyboard, err := seat.GetKeyboard()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var keymap []byte
+	keyboard.SetKeymapHandler(func(e client.KeyboardKeymapEvent) {
+		defer unix.Close(e.Fd)
+
+		data, err := unix.Mmap(e.Fd, 0, int(e.Size), unix.PROT_READ, unix.MAP_PRIVATE)
+		if err != nil {
+			t.Errorf("unable to map keymap: %v", err)
+			return
+		}
+		keymap = append(keymap, data...)
+		unix.Munmap(data)
+	})
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := append([]byte(testKeymap), 0); !bytes.Equal(keymap, want) {
+		t.Errorf("got keymap %q, want %q", keymap, want)
+	}
+}
+
+func TestForwardPipe(t *testing.T) {
+	comp := &compositor{}
+	display, err := client.Connect(startForward(t, comp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer display.Context().Close()
+
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seat, err := client.BindGlobal[*client.Seat](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager, err := client.BindGlobal[*client.DataDeviceManager](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	device, err := manager.GetDataDevice(seat)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var offer *client.DataOffer
+	// The offers are only registered along with a handler
+	device.SetDataOfferHandler(func(e client.DataDeviceDataOfferEvent) {})
+	device.SetSelectionHandler(func(e client.DataDeviceSelectionEvent) {
+		offer = e.Id
+	})
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+	if offer == nil {
+		t.Fatal("no selection")
+	}
+
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatal(err)
+	}
+	r := os.NewFile(uintptr(p[0]), "selection")
+	defer r.Close()
+	err = offer.Receive("text/plain", p[1])
+	unix.Close(p[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan []byte, 1)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+	select {
+	case data := <-done:
+		if string(data) != testSelection {
+			t.Errorf("got selection %q, want %q", data, testSelection)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("selection transfer not finished")
+	}
+}
+
+// tunnelBuffer is a tunnel recording the frames sent on it
+type tunnelBuffer struct {
+	bytes.Buffer
+}
+
+func (*tunnelBuffer) Close() error { return nil }
+
+// testPool creates a pool and a buffer using it on c, filled with v
+func testPool(t *testing.T, c *channel, object, buffer uint32, size int, v byte) *shmPool {
+	t.Helper()
+
+	fd, err := memfd("pool", bytes.Repeat([]byte{v}, size), uint32(size))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := c.newPool(object, fd, int32(size))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.trackPools("wl_shm_pool.create_buffer", object, []argValue{{u: buffer}})
+	return p
+}
+
+// TestFlushAttached checks a commit only sends the changes of the pool
+// of the buffer attached to the surface
+func TestFlushAttached(t *testing.T) {
+	tunnel := &tunnelBuffer{}
+	c := newChannel(newSession(tunnel, true), 1, nil)
+	const surface, other = 30, 31
+	a := testPool(t, c, 10, 11, 4096, 1)
+	b := testPool(t, c, 20, 21, 4096, 2)
+	defer a.release()
+	defer b.release()
+
+	flushed := func(surface uint32) map[uint32]int {
+		t.Helper()
+		tunnel.Reset()
+		if err := c.flush(surface); err != nil {
+			t.Fatal(err)
+		}
+		sent := map[uint32]int{}
+		for tunnel.Len() > 0 {
+			f, err := readFrame(tunnel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &reader{b: f.payload}
+			pool := r.uint32()
+			r.uint32()
+			sent[pool] += len(r.b)
+		}
+		return sent
+	}
+
+	c.trackPools("wl_surface.attach", surface, []argValue{{u: 11}, {}, {}})
+	if sent := flushed(surface); len(sent) != 1 || sent[a.id] != 4096 {
+		t.Errorf("commit with pool %d attached sent %v", a.id, sent)
+	}
+	if sent := flushed(other); len(sent) != 0 {
+		t.Errorf("commit without buffer attached sent %v", sent)
+	}
+
+	c.trackPools("wl_surface.attach", other, []argValue{{u: 21}, {}, {}})
+	if sent := flushed(other); len(sent) != 1 || sent[b.id] != 4096 {
+		t.Errorf("commit with pool %d attached sent %v", b.id, sent)
+	}
+
+	// A null buffer detaches the pool
+	c.trackPools("wl_surface.attach", surface, []argValue{{u: 0}, {}, {}})
+	if err := pwriteAll(a.fd, []byte{3}, 0); err != nil {
+		t.Fatal(err)
+	}
+	if sent := flushed(surface); len(sent) != 0 {
+		t.Errorf("commit after attaching a null buffer sent %v", sent)
+	}
+}
+
+func TestShmLimit(t *testing.T) {
+	c := newChannel(newSession(&tunnelBuffer{}, true), 1, nil)
+
+	// The memfds are sparse
+	fd, err := memfd("pool", nil, maxShmSize/2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.newPool(10, fd, maxShmSize/2); err != nil {
+		t.Fatal(err)
+	}
+	c.trackPools("wl_shm_pool.create_buffer", 10, []argValue{{u: 11}})
+
+	if fd, err = memfd("pool", nil, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.newPool(20, fd, maxShmSize/2+1); err == nil {
+		t.Error("pools larger than maxShmSize mirrored")
+	}
+	if err := c.resizePool(10, maxShmSize+1); err == nil {
+		t.Error("pool resized over maxShmSize")
+	}
+
+	// Destroying the pool and its buffer frees its size
+	c.trackPools("wl_buffer.destroy", 11, nil)
+	c.trackPools("wl_shm_pool.destroy", 10, nil)
+	if c.shmSize != 0 {
+		t.Errorf("got %d bytes of pools after destroying them, want 0", c.shmSize)
+	}
+}
@@ -0,0 +1,135 @@
+package forward
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// The tunnel carries frames: a 12 bytes header, the frame type, the id
+// of the channel or pipe and the size of the payload, little endian,
+// followed by the payload.
+type frameType uint32
+
+const (
+	// frameOpen opens a channel, a client connection, from the remote
+	// end
+	frameOpen frameType = iota + 1
+	// frameClose closes a channel, from either end
+	frameClose
+	// frameData is a wayland message: its fds, see fdKind, the contents
+	// of file fds, then the message itself
+	frameData
+	// frameShmUpdate writes to the mirror of a shm pool: pool id, offset
+	// and data
+	frameShmUpdate
+	// frameShmResize grows the mirror of a shm pool: pool id and size
+	frameShmResize
+	// frameShmFree releases the mirror of a shm pool: pool id
+	frameShmFree
+	// framePipeData is data relayed through a pipe, the id is the pipe
+	framePipeData
+	// framePipeClose closes a relayed pipe
+	framePipeClose
+)
+
+func (t frameType) String() string {
+	names := [...]string{"", "open", "close", "data", "shm_update", "shm_resize", "shm_free", "pipe_data", "pipe_close"}
+	if int(t) < len(names) && t != 0 {
+		return names[t]
+	}
+	return fmt.Sprintf("frame(%d)", uint32(t))
+}
+
+// maxPayload bounds the frames read from the tunnel, shm updates and
+// pipe data are split to stay below it
+const maxPayload = 1 << 24
+
+// chunkSize is the maximum size of the data of shm updates and pipe
+// frames
+const chunkSize = 1 << 20
+
+const frameHeaderSize = 12
+
+type frame struct {
+	typ     frameType
+	id      uint32
+	payload []byte
+}
+
+func writeFrame(w io.Writer, typ frameType, id uint32, payload []byte) error {
+	// A single write, the tunnel is shared by the channels
+	b := make([]byte, frameHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(b[0:], uint32(typ))
+	binary.LittleEndian.PutUint32(b[4:], id)
+	binary.LittleEndian.PutUint32(b[8:], uint32(len(payload)))
+	copy(b[frameHeaderSize:], payload)
+
+	_, err := w.Write(b)
+	return err
+}
+
+func readFrame(r io.Reader) (frame, error) {
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return frame{}, err
+	}
+
+	f := frame{
+		typ: frameType(binary.LittleEndian.Uint32(header[0:])),
+		id:  binary.LittleEndian.Uint32(header[4:]),
+	}
+	size := binary.LittleEndian.Uint32(header[8:])
+	if size > maxPayload {
+		return frame{}, fmt.Errorf("%s frame of %d bytes is too large", f.typ, size)
+	}
+	f.payload = make([]byte, size)
+	if _, err := io.ReadFull(r, f.payload); err != nil {
+		return frame{}, err
+	}
+
+	return f, nil
+}
+
+// payload builds frame payloads
+type payload []byte
+
+func (p payload) uint32(v uint32) payload {
+	return binary.LittleEndian.AppendUint32(p, v)
+}
+
+func (p payload) bytes(b []byte) payload {
+	return append(p, b...)
+}
+
+// reader decodes frame payloads, the first error sticks
+type reader struct {
+	b   []byte
+	err error
+}
+
+func (r *reader) uint32() uint32 {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.b) < 4 {
+		r.err = io.ErrUnexpectedEOF
+		return 0
+	}
+	v := binary.LittleEndian.Uint32(r.b)
+	r.b = r.b[4:]
+	return v
+}
+
+func (r *reader) bytes(n uint32) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if uint32(len(r.b)) < n {
+		r.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	b := r.b[:n]
+	r.b = r.b[n:]
+	return b
+}
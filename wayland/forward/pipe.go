@@ -0,0 +1,129 @@
+package forward
+
+import (
+	"os"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// Pipes, and sockets, passed in messages, e.g. by wl_data_offer.receive
+// and wl_data_source.send, are relayed: the end receiving the message
+// creates a pipe and passes one of its ends instead. The data written
+// to the pipe on one side of the tunnel is sent in framePipeData frames
+// and written to the pipe on the other side, until its writer closes
+// it.
+
+// pipeFile returns an *os.File of fd, which can be closed while a read
+// or a write is blocked
+func pipeFile(fd int, name string) *os.File {
+	unix.SetNonblock(fd, true)
+	return os.NewFile(uintptr(fd), name)
+}
+
+// relay sends the data read from f until EOF, then closes the pipe on
+// the other end
+func (s *session) relay(id uint32, f *os.File) {
+	if !s.addReader(f) {
+		f.Close()
+		return
+	}
+	defer s.removeReader(f)
+
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if s.send(framePipeData, id, buf[:n]) != nil {
+				break
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	s.send(framePipeClose, id, nil)
+}
+
+func (s *session) addReader(f *os.File) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+	s.readers[f] = struct{}{}
+	return true
+}
+
+func (s *session) removeReader(f *os.File) {
+	s.mu.Lock()
+	delete(s.readers, f)
+	s.mu.Unlock()
+
+	f.Close()
+}
+
+// pipeWriter writes the data received for a pipe, queued so a slow
+// reader doesn't block the tunnel
+type pipeWriter struct {
+	f *os.File
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  [][]byte
+	closed bool
+}
+
+func newPipeWriter(f *os.File) *pipeWriter {
+	w := &pipeWriter{f: f}
+	w.cond = sync.NewCond(&w.mu)
+	go w.run()
+	return w
+}
+
+func (w *pipeWriter) write(b []byte) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !w.closed {
+		w.queue = append(w.queue, b)
+		w.cond.Signal()
+	}
+}
+
+// close closes the pipe once the queued data is written
+func (w *pipeWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closed = true
+	w.cond.Signal()
+}
+
+func (w *pipeWriter) run() {
+	defer w.f.Close()
+
+	for {
+		w.mu.Lock()
+		for len(w.queue) == 0 && !w.closed {
+			w.cond.Wait()
+		}
+		if len(w.queue) == 0 {
+			w.mu.Unlock()
+			return
+		}
+		b := w.queue[0]
+		w.queue = w.queue[1:]
+		w.mu.Unlock()
+
+		if _, err := w.f.Write(b); err != nil {
+			// The reader went away, drop the rest
+			w.mu.Lock()
+			w.queue = nil
+			w.closed = true
+			w.mu.Unlock()
+			return
+		}
+	}
+}
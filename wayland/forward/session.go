@@ -0,0 +1,287 @@
+package forward
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+// ErrProtocol is returned when the other end of the tunnel sends
+// frames which don't make sense, e.g. a different version of this
+// package.
+var ErrProtocol = errors.New("forward: protocol error")
+
+// remotePipe is set in the ids of the pipes created by the remote end,
+// the ids of both ends don't collide
+const remotePipe = 1 << 31
+
+// session is one end of a tunnel
+type session struct {
+	tunnel io.ReadWriteCloser
+	// remote is set on the end next to the clients, unset on the end
+	// next to the compositor
+	remote bool
+	// display is the socket path of the compositor, on the local end
+	display string
+
+	wmu sync.Mutex
+
+	mu          sync.Mutex
+	channels    map[uint32]*channel
+	nextChannel uint32
+	pipes       map[uint32]*pipeWriter
+	nextPipe    uint32
+	readers     map[*os.File]struct{}
+	closed      bool
+}
+
+func newSession(tunnel io.ReadWriteCloser, remote bool) *session {
+	return &session{
+		tunnel:   tunnel,
+		remote:   remote,
+		channels: map[uint32]*channel{},
+		pipes:    map[uint32]*pipeWriter{},
+		readers:  map[*os.File]struct{}{},
+	}
+}
+
+// Remote is the end of a tunnel next to the clients: it forwards the
+// clients accepted on sock over tunnel, to the Local end connected to
+// the compositor. It returns once the tunnel is closed or fails,
+// closing tunnel and the connections of the clients but not sock.
+func Remote(tunnel io.ReadWriteCloser, sock *server.Socket) error {
+	s := newSession(tunnel, true)
+
+	go func() {
+		for {
+			conn, err := sock.Accept()
+			if err != nil {
+				// The socket was closed, the clients already connected
+				// remain forwarded
+				return
+			}
+			if err := s.open(conn); err != nil {
+				conn.Close()
+				return
+			}
+		}
+	}()
+
+	return s.run()
+}
+
+// Local is the end of a tunnel next to the compositor listening at the
+// socket path display: it connects the clients forwarded by the Remote
+// end of tunnel to the compositor. It returns once the tunnel is
+// closed or fails, closing tunnel and its connections.
+func Local(tunnel io.ReadWriteCloser, display string) error {
+	s := newSession(tunnel, false)
+	s.display = display
+
+	return s.run()
+}
+
+// send writes a frame to the tunnel
+func (s *session) send(typ frameType, id uint32, p []byte) error {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	return writeFrame(s.tunnel, typ, id, p)
+}
+
+// open forwards a new client connection, on the remote end
+func (s *session) open(conn *net.UnixConn) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return net.ErrClosed
+	}
+	s.nextChannel++
+	c := newChannel(s, s.nextChannel, conn)
+	s.channels[c.id] = c
+	s.mu.Unlock()
+
+	if err := s.send(frameOpen, c.id, nil); err != nil {
+		s.removeChannel(c.id)
+		return err
+	}
+	go c.serve()
+
+	return nil
+}
+
+// run handles the frames read from the tunnel until it's closed
+func (s *session) run() error {
+	err := s.loop()
+	if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) || errors.Is(err, io.ErrClosedPipe) {
+		err = nil
+	}
+	s.close()
+
+	return err
+}
+
+func (s *session) loop() error {
+	for {
+		f, err := readFrame(s.tunnel)
+		if err != nil {
+			return err
+		}
+		if err := s.handle(f); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *session) handle(f frame) error {
+	switch f.typ {
+	case frameOpen:
+		if s.remote {
+			return fmt.Errorf("%w: channel opened by the local end", ErrProtocol)
+		}
+		s.connect(f.id)
+		return nil
+
+	case frameClose:
+		if c := s.removeChannel(f.id); c != nil {
+			c.close()
+		}
+		return nil
+
+	case frameData, frameShmUpdate, frameShmResize, frameShmFree:
+		c := s.channel(f.id)
+		if c == nil {
+			// Closed on this end, the other end doesn't know yet, the
+			// fds of data frames are created from the payload and
+			// nothing leaks
+			return nil
+		}
+		if err := c.handle(f); err != nil {
+			if errors.Is(err, ErrProtocol) {
+				return err
+			}
+			// The client or the compositor went away
+			s.removeChannel(c.id)
+			c.close()
+			s.send(frameClose, c.id, nil)
+		}
+		return nil
+
+	case framePipeData, framePipeClose:
+		s.mu.Lock()
+		w := s.pipes[f.id]
+		if f.typ == framePipeClose {
+			delete(s.pipes, f.id)
+		}
+		s.mu.Unlock()
+
+		if w == nil {
+			return nil
+		}
+		if f.typ == framePipeClose {
+			w.close()
+		} else {
+			w.write(f.payload)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: unknown frame type %d", ErrProtocol, f.typ)
+}
+
+// connect connects a channel opened by the remote end to the
+// compositor
+func (s *session) connect(id uint32) {
+	conn, err := net.DialUnix("unix", nil, &net.UnixAddr{Name: s.display, Net: "unix"})
+	if err != nil {
+		s.send(frameClose, id, nil)
+		return
+	}
+
+	c := newChannel(s, id, conn)
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		conn.Close()
+		return
+	}
+	if old := s.channels[id]; old != nil {
+		old.close()
+	}
+	s.channels[id] = c
+	s.mu.Unlock()
+
+	go c.serve()
+}
+
+func (s *session) channel(id uint32) *channel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.channels[id]
+}
+
+// removeChannel removes the channel from the session, it returns nil if
+// it was already removed
+func (s *session) removeChannel(id uint32) *channel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := s.channels[id]
+	delete(s.channels, id)
+	return c
+}
+
+// newPipeID allocates the id of a relayed pipe
+func (s *session) newPipeID() uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextPipe++
+	id := s.nextPipe &^ remotePipe
+	if s.remote {
+		id |= remotePipe
+	}
+	return id
+}
+
+// addPipe registers the writer of the frames of a pipe
+func (s *session) addPipe(id uint32, w *pipeWriter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		w.close()
+		return
+	}
+	s.pipes[id] = w
+}
+
+// close closes the tunnel and everything forwarded through it
+func (s *session) close() {
+	s.mu.Lock()
+	s.closed = true
+	channels := s.channels
+	pipes := s.pipes
+	readers := s.readers
+	s.channels = map[uint32]*channel{}
+	s.pipes = map[uint32]*pipeWriter{}
+	s.readers = map[*os.File]struct{}{}
+	s.mu.Unlock()
+
+	s.tunnel.Close()
+	for _, c := range channels {
+		c.close()
+	}
+	for _, w := range pipes {
+		w.close()
+	}
+	for f := range readers {
+		f.Close()
+	}
+}
@@ -0,0 +1,223 @@
+package forward
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"golang.org/x/sys/unix"
+)
+
+// The shm pools of the clients are mirrored in memfds on the local end.
+// The remote end keeps a copy of the contents last sent, on every
+// wl_surface.commit it compares the pool of the buffer attached to the
+// surface with its copy and sends the blocks which changed.
+
+// errFault is returned by scan when reading the pool faults
+var errFault = errors.New("shm pool truncated")
+
+// diffBlock is the granularity of the comparisons
+const diffBlock = 64
+
+// maxShmSize bounds the total size of the pools of a client, the remote
+// end keeps a copy of each of them
+const maxShmSize = 512 << 20
+
+// shmPool is a pool of a client on the remote end
+type shmPool struct {
+	id uint32
+	fd int
+	// data is the mapping of the file of the client, nil if it can't be
+	// read
+	data []byte
+	// shadow is the contents of the mirror, its length the size of the
+	// pool
+	shadow []byte
+	// refs counts the wl_shm_pool and its wl_buffers
+	refs int
+}
+
+// newPool mirrors the pool created by wl_shm.create_pool, it takes
+// ownership of fd
+func (c *channel) newPool(object uint32, fd int, size int32) (*shmPool, error) {
+	if size < 0 {
+		size = 0
+	}
+	if err := c.growShm(int(size)); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+
+	c.nextPool++
+	p := &shmPool{id: c.nextPool, fd: fd, shadow: make([]byte, size), refs: 1}
+	p.mmap()
+
+	c.pools[p.id] = p
+	c.poolObjects[object] = p
+	return p, nil
+}
+
+// growShm accounts for n more bytes of mirrored pools
+func (c *channel) growShm(n int) error {
+	if n > maxShmSize-c.shmSize {
+		return fmt.Errorf("shm pools of more than %d bytes are too large to forward", maxShmSize)
+	}
+	c.shmSize += n
+	return nil
+}
+
+func (p *shmPool) mmap() {
+	p.data = nil
+	if len(p.shadow) == 0 {
+		return
+	}
+	data, err := unix.Mmap(p.fd, 0, len(p.shadow), unix.PROT_READ, unix.MAP_SHARED)
+	if err == nil {
+		p.data = data
+	}
+}
+
+func (p *shmPool) release() {
+	if p.data != nil {
+		unix.Munmap(p.data)
+		p.data = nil
+	}
+	unix.Close(p.fd)
+}
+
+// resizePool grows the pool of a wl_shm_pool and its mirror
+func (c *channel) resizePool(object uint32, size int32) error {
+	p := c.poolObjects[object]
+	if p == nil || int(size) <= len(p.shadow) {
+		// The compositor raises the error of a shrinking pool
+		return nil
+	}
+	if err := c.growShm(int(size) - len(p.shadow)); err != nil {
+		return err
+	}
+
+	if p.data != nil {
+		unix.Munmap(p.data)
+	}
+	p.shadow = append(p.shadow, make([]byte, int(size)-len(p.shadow))...)
+	p.mmap()
+
+	return c.s.send(frameShmResize, c.id, payload(nil).uint32(p.id).uint32(uint32(size)))
+}
+
+// trackPools follows the objects using the pools and the buffers
+// attached to the surfaces, for the requests read from the clients
+func (c *channel) trackPools(name string, object uint32, args []argValue) {
+	switch name {
+	case "wl_surface.attach":
+		if args[0].u == 0 {
+			delete(c.attached, object)
+		} else {
+			c.attached[object] = args[0].u
+		}
+
+	case "wl_surface.destroy":
+		delete(c.attached, object)
+
+	case "wl_shm_pool.create_buffer":
+		if p := c.poolObjects[object]; p != nil {
+			c.poolObjects[args[0].u] = p
+			p.refs++
+		}
+
+	case "wl_shm_pool.destroy", "wl_buffer.destroy":
+		p := c.poolObjects[object]
+		if p == nil {
+			return
+		}
+		delete(c.poolObjects, object)
+		p.refs--
+		if p.refs == 0 {
+			p.release()
+			c.shmSize -= len(p.shadow)
+			delete(c.pools, p.id)
+			c.s.send(frameShmFree, c.id, payload(nil).uint32(p.id))
+		}
+	}
+}
+
+// flush sends the changes of the pool of the buffer attached to surface
+// since the last flush. The buffers of the other surfaces are flushed
+// when they're committed, their contents can't change until released.
+func (c *channel) flush(surface uint32) error {
+	p := c.poolObjects[c.attached[surface]]
+	if p == nil {
+		return nil
+	}
+	return p.diff(func(offset int, b []byte) error {
+		return c.s.send(frameShmUpdate, c.id, payload(nil).uint32(p.id).uint32(uint32(offset)).bytes(b))
+	})
+}
+
+// diff copies the changed blocks of the pool to the shadow and calls
+// send with each run of them. The mirroring of the pool stops if the
+// client truncated its file.
+func (p *shmPool) diff(send func(offset int, b []byte) error) error {
+	if p.data == nil {
+		return nil
+	}
+
+	start, end, err := p.scan(0)
+	for err == nil && start < end {
+		if err := send(start, p.shadow[start:end]); err != nil {
+			return err
+		}
+		start, end, err = p.scan(end)
+	}
+	if err != nil {
+		// The compositor reports the error to the client
+		unix.Munmap(p.data)
+		p.data = nil
+	}
+	return nil
+}
+
+// scan finds the next run of changed blocks from offset and copies it
+// to the shadow, it returns an empty run once the pool is up to date
+func (p *shmPool) scan(offset int) (start, end int, err error) {
+	old := debug.SetPanicOnFault(true)
+	defer func() {
+		debug.SetPanicOnFault(old)
+		if r := recover(); r != nil {
+			err = errFault
+		}
+	}()
+
+	size := len(p.shadow)
+	if len(p.data) < size {
+		size = len(p.data)
+	}
+
+	start = size
+	for i := offset; i < size; i += diffBlock {
+		j := i + diffBlock
+		if j > size {
+			j = size
+		}
+		if !bytes.Equal(p.data[i:j], p.shadow[i:j]) {
+			start = i
+			break
+		}
+	}
+
+	end = start
+	for end < size && end-start < chunkSize {
+		j := end + diffBlock
+		if j > size {
+			j = size
+		}
+		if bytes.Equal(p.data[end:j], p.shadow[end:j]) {
+			break
+		}
+		end = j
+	}
+	copy(p.shadow[start:end], p.data[start:end])
+
+	return start, end, nil
+}
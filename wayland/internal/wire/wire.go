@@ -0,0 +1,109 @@
+// Package wire implements the encoding of the wayland wire protocol,
+// shared by the client and server packages.
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// MaxFds is the maximum number of fds sent along with a single sendmsg
+// call, as defined by libwayland
+const MaxFds = 28
+
+// OobSpace is the size of the buffer receiving the fds of a message
+var OobSpace = unix.CmsgSpace(4 * MaxFds)
+
+func Uint32(src []byte) uint32 {
+	_ = src[3]
+	return *(*uint32)(unsafe.Pointer(&src[0]))
+}
+
+func PutUint32(dst []byte, v uint32) {
+	_ = dst[3]
+	*(*uint32)(unsafe.Pointer(&dst[0])) = v
+}
+
+// String returns the null terminated string at the start of src, a
+// missing terminator, as sent for null strings, yields an empty string
+func String(src []byte) string {
+	idx := bytes.IndexByte(src, 0)
+	if idx == -1 {
+		return ""
+	}
+	src = src[:idx:idx]
+	return *(*string)(unsafe.Pointer(&src))
+}
+
+// PutString writes v along with its padded length l
+func PutString(dst []byte, v string, l int) {
+	PutUint32(dst[:4], uint32(l))
+	copy(dst[4:], []byte(v))
+}
+
+func PutArray(dst []byte, a []byte) {
+	PutUint32(dst[:4], uint32(len(a)))
+	copy(dst[4:], a)
+}
+
+func Fixed(src []byte) float64 {
+	_ = src[3]
+	fx := *(*int32)(unsafe.Pointer(&src[0]))
+	return FixedToFloat64(fx)
+}
+
+func PutFixed(dst []byte, f float64) {
+	fx := FixedFromFloat64(f)
+	_ = dst[3]
+	*(*int32)(unsafe.Pointer(&dst[0])) = fx
+}
+
+// From wayland/wayland-util.h
+
+func FixedToFloat64(f int32) float64 {
+	u_i := (1023+44)<<52 + (1 << 51) + int64(f)
+	u_d := math.Float64frombits(uint64(u_i))
+	return u_d - (3 << 43)
+}
+
+func FixedFromFloat64(d float64) int32 {
+	u_d := d + (3 << (51 - 8))
+	u_i := int64(math.Float64bits(u_d))
+	return int32(u_i)
+}
+
+// PaddedLen returns l rounded up to a multiple of 4
+func PaddedLen(l int) int {
+	if (l & 0x3) != 0 {
+		return l + (4 - (l & 0x3))
+	}
+	return l
+}
+
+// ParseFds returns the fds passed in the first oobn bytes of oob,
+// source names what was being read in errors
+func ParseFds(oob []byte, oobn int, source string) ([]int, error) {
+	if oobn > len(oob) {
+		return nil, fmt.Errorf("incorrect number of bytes read from %s for oob (oobn=%d)", source, oobn)
+	}
+	scms, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse control message from %s: %w", source, err)
+	}
+
+	var fdsRet []int
+	for _, scm := range scms {
+		fds, err := unix.ParseUnixRights(&scm)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse unix rights from %s: %w", source, err)
+		}
+
+		fdsRet = append(fdsRet, fds...)
+	}
+
+	return fdsRet, nil
+}
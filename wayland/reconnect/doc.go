@@ -0,0 +1,35 @@
+// Package reconnect keeps long-running clients, e.g. kiosks, alive
+// across restarts of the compositor, in the spirit of KDE's compositor
+// restart support.
+//
+// A Client dispatches the events of its connection like
+// client.Context.Dispatch. When the compositor goes away, it notifies
+// the state handler with StateDisconnected, waits for the compositor to
+// listen again, connects, re-binds the globals bound with Bind and
+// notifies StateReconnected: the application then creates its surfaces
+// again, the objects of the previous connection are dead.
+//
+//	// createWindow creates the surfaces with compositor.Get() and
+//	// wmBase.Get()
+//	c, err := reconnect.Connect("", func(e reconnect.StateEvent) {
+//		if e.State == reconnect.StateReconnected {
+//			createWindow()
+//		}
+//	})
+//	...
+//	compositor, err = reconnect.Bind[*client.Compositor](c, 4, 0)
+//	...
+//	wmBase, err = reconnect.Bind[*xdg_shell.WmBase](c, 1, 0)
+//	...
+//	createWindow()
+//	for {
+//		if err := c.Dispatch(); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+// Protocol errors are fatal as usual, only disconnections are
+// recovered from. Clients dispatching from an event loop call Reconnect
+// themselves when reading events fails with client.ErrDisconnected,
+// and add the context of the new connection to the loop.
+package reconnect
@@ -0,0 +1,311 @@
+package reconnect
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+)
+
+// State is a change of the connection of a Client.
+type State int
+
+const (
+	// StateDisconnected is notified once the compositor went away, the
+	// proxies of the connection are unusable.
+	StateDisconnected State = iota + 1
+	// StateReconnected is notified once connected to the new compositor
+	// and the globals are bound again, the application recreates its
+	// objects.
+	StateReconnected
+	// StateFailed is notified when reconnecting fails, Dispatch returns
+	// the error.
+	StateFailed
+)
+
+func (s State) String() string {
+	switch s {
+	case StateDisconnected:
+		return "disconnected"
+	case StateReconnected:
+		return "reconnected"
+	case StateFailed:
+		return "failed"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// StateEvent is passed to the state handler of a Client.
+type StateEvent struct {
+	State State
+	// Err is the cause of the disconnection for StateDisconnected and
+	// why reconnecting failed for StateFailed.
+	Err error
+}
+
+type StateHandlerFunc func(StateEvent)
+
+// DefaultRetryInterval is the time between connection attempts while
+// the compositor restarts.
+const DefaultRetryInterval = 250 * time.Millisecond
+
+// Client is a connection to the compositor which is reestablished when
+// the compositor restarts. A Client is not safe for concurrent use,
+// except for Close which stops a Dispatch or Reconnect running in
+// another goroutine.
+type Client struct {
+	addr     string
+	handler  StateHandlerFunc
+	interval time.Duration
+	timeout  time.Duration
+
+	// mu guards display and globals, which are replaced on
+	// reconnection while Close may run concurrently
+	mu       sync.Mutex
+	display  *client.Display
+	globals  *client.Globals
+	bindings []binding
+
+	closed atomic.Bool
+	// done is closed by Close, interrupting the wait between
+	// connection attempts
+	done chan struct{}
+}
+
+// Connect connects to the compositor like client.Connect, addr is
+// connected to again on restarts. handler, which may be nil, is called
+// for every change of the connection.
+func Connect(addr string, handler StateHandlerFunc) (*Client, error) {
+	c := &Client{addr: addr, handler: handler, interval: DefaultRetryInterval, done: make(chan struct{})}
+
+	display, globals, err := c.connect()
+	if err != nil {
+		return nil, err
+	}
+	c.display = display
+	c.globals = globals
+
+	return c, nil
+}
+
+// SetRetryInterval sets the time between connection attempts while the
+// compositor restarts, DefaultRetryInterval by default.
+func (c *Client) SetRetryInterval(d time.Duration) {
+	c.interval = d
+}
+
+// SetTimeout sets how long reconnecting is attempted before giving up,
+// 0, the default, waits for the compositor forever.
+func (c *Client) SetTimeout(d time.Duration) {
+	c.timeout = d
+}
+
+// Display returns the display of the current connection, it changes
+// on reconnection.
+func (c *Client) Display() *client.Display {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.display
+}
+
+// Context returns the context of the current connection, it changes
+// on reconnection.
+func (c *Client) Context() *client.Context {
+	return c.Display().Context()
+}
+
+// Globals returns the globals of the current connection, they change
+// on reconnection.
+func (c *Client) Globals() *client.Globals {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.globals
+}
+
+// Dispatch dispatches the next event like client.Context.Dispatch. If
+// the compositor went away, it reconnects before returning, see
+// Reconnect.
+func (c *Client) Dispatch() error {
+	err := c.Context().Dispatch()
+	if err == nil || !errors.Is(err, client.ErrDisconnected) {
+		return err
+	}
+
+	return c.Reconnect(err)
+}
+
+// Reconnect closes the current connection, lost because of cause, and
+// connects again, waiting for the compositor to restart. The state
+// handler is notified with StateDisconnected then StateReconnected once
+// the globals are bound again, or StateFailed if the compositor
+// doesn't come back with the globals bound with Bind before the
+// timeout.
+func (c *Client) Reconnect(cause error) error {
+	if c.closed.Load() {
+		return client.ErrClosed
+	}
+
+	c.Context().Close()
+	c.notify(StateEvent{State: StateDisconnected, Err: cause})
+
+	var deadline time.Time
+	if c.timeout > 0 {
+		deadline = time.Now().Add(c.timeout)
+	}
+
+	for {
+		err := c.attempt()
+		if errors.Is(err, client.ErrClosed) {
+			return err
+		}
+		if err == nil {
+			c.notify(StateEvent{State: StateReconnected})
+			return nil
+		}
+
+		wait := c.interval
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return c.fail(err)
+			}
+			if remaining < wait {
+				wait = remaining
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-c.done:
+			timer.Stop()
+			return client.ErrClosed
+		case <-timer.C:
+		}
+	}
+}
+
+// Close closes the connection, Dispatch returns client.ErrClosed from
+// now on. It may be called while Dispatch or Reconnect run in another
+// goroutine, a pending reconnection is abandoned.
+func (c *Client) Close() error {
+	if !c.closed.CompareAndSwap(false, true) {
+		return client.ErrClosed
+	}
+	close(c.done)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Reconnect already closed the lost connection
+	if err := c.display.Context().Close(); err != nil && !errors.Is(err, client.ErrClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) connect() (*client.Display, *client.Globals, error) {
+	display, err := client.Connect(c.addr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		display.Context().Close()
+		return nil, nil, err
+	}
+
+	return display, globals, nil
+}
+
+// attempt connects and binds the globals again, a compositor lacking
+// some of them is retried as it may announce them late
+func (c *Client) attempt() error {
+	display, globals, err := c.connect()
+	if err != nil {
+		return err
+	}
+
+	for _, b := range c.bindings {
+		if err := b.bind(globals); err != nil {
+			display.Context().Close()
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Close ran while connecting, it closed the previous connection
+	if c.closed.Load() {
+		display.Context().Close()
+		return client.ErrClosed
+	}
+	c.display = display
+	c.globals = globals
+
+	return nil
+}
+
+func (c *Client) fail(err error) error {
+	err = fmt.Errorf("reconnect: %w", err)
+	c.notify(StateEvent{State: StateFailed, Err: err})
+	return err
+}
+
+func (c *Client) notify(e StateEvent) {
+	if c.handler != nil {
+		c.handler(e)
+	}
+}
+
+type binding interface {
+	bind(globals *client.Globals) error
+}
+
+// Binding is a global bound again on every connection.
+type Binding[T interface {
+	client.Proxy
+	Interface() *client.Interface
+}] struct {
+	min, max uint32
+	proxy    T
+}
+
+// Bind binds the first global implementing the interface of T like
+// client.BindGlobal, and again after every reconnection, before
+// StateReconnected is notified.
+//
+//	compositor, err := reconnect.Bind[*client.Compositor](c, 4, 0)
+func Bind[T interface {
+	client.Proxy
+	Interface() *client.Interface
+}](c *Client, min, max uint32) (*Binding[T], error) {
+	b := &Binding[T]{min: min, max: max}
+	if err := b.bind(c.Globals()); err != nil {
+		return nil, err
+	}
+	c.bindings = append(c.bindings, b)
+
+	return b, nil
+}
+
+// Get returns the proxy bound on the current connection.
+func (b *Binding[T]) Get() T {
+	return b.proxy
+}
+
+func (b *Binding[T]) bind(globals *client.Globals) error {
+	p, err := client.BindGlobal[T](globals, b.min, b.max)
+	if err != nil {
+		return err
+	}
+	b.proxy = p
+	return nil
+}
@@ -0,0 +1,273 @@
+package reconnect
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+const testDisplay = "wayland-reconnect-test"
+
+// startServer starts a mock compositor on testDisplay, with wl_shm if
+// shm is set
+func startServer(t *testing.T, shm bool) *server.Server {
+	t.Helper()
+
+	s := server.New()
+	if _, err := s.CreateGlobal(server.CompositorInterface, 4, func(server.Resource) {}); err != nil {
+		t.Fatal(err)
+	}
+	if shm {
+		if _, err := s.InitShm(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sock, err := server.Listen(testDisplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(sock)
+
+	return s
+}
+
+// dispatch dispatches the events of c until Dispatch fails
+func dispatch(c *Client) <-chan error {
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			if err := c.Dispatch(); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+	return errs
+}
+
+func expectState(t *testing.T, events <-chan StateEvent, state State) StateEvent {
+	t.Helper()
+
+	select {
+	case e := <-events:
+		if e.State != state {
+			t.Fatalf("got state %v (%v), want %v", e.State, e.Err, state)
+		}
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no %v state", state)
+	}
+	return StateEvent{}
+}
+
+func TestReconnect(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+	t.Setenv("WAYLAND_DISPLAY", testDisplay)
+
+	s := startServer(t, true)
+
+	events := make(chan StateEvent, 4)
+	roundtrips := make(chan error, 1)
+	var c *Client
+	var compositor *Binding[*client.Compositor]
+	c, err := Connect("", func(e StateEvent) {
+		if e.State == StateReconnected {
+			// The application recreates its objects
+			_, err := compositor.Get().CreateSurface()
+			if err == nil {
+				err = c.Display().Roundtrip()
+			}
+			roundtrips <- err
+		}
+		events <- e
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetRetryInterval(20 * time.Millisecond)
+
+	compositor, err = Bind[*client.Compositor](c, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shm, err := Bind[*client.Shm](c, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldCompositor, oldShm := compositor.Get(), shm.Get()
+
+	errs := dispatch(c)
+
+	s.Close()
+	e := expectState(t, events, StateDisconnected)
+	if !errors.Is(e.Err, client.ErrDisconnected) {
+		t.Errorf("disconnected because of %v", e.Err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	s = startServer(t, true)
+	defer s.Close()
+
+	expectState(t, events, StateReconnected)
+	if err := <-roundtrips; err != nil {
+		t.Errorf("roundtrip after reconnection: %v", err)
+	}
+	if compositor.Get() == oldCompositor || shm.Get() == oldShm {
+		t.Error("bindings not bound again")
+	}
+	if compositor.Get().Context() != c.Context() {
+		t.Error("binding not bound on the new connection")
+	}
+
+	c.Close()
+	if err := <-errs; !errors.Is(err, client.ErrClosed) {
+		t.Errorf("Dispatch after Close: %v", err)
+	}
+}
+
+// TestReconnectLateGlobal restarts the compositor without a bound
+// global at first, reconnecting is retried until it's announced
+func TestReconnectLateGlobal(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+	t.Setenv("WAYLAND_DISPLAY", testDisplay)
+
+	s := startServer(t, true)
+
+	events := make(chan StateEvent, 4)
+	c, err := Connect("", func(e StateEvent) { events <- e })
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetRetryInterval(20 * time.Millisecond)
+	shm, err := Bind[*client.Shm](c, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs := dispatch(c)
+
+	s.Close()
+	expectState(t, events, StateDisconnected)
+
+	s = startServer(t, false)
+	defer s.Close()
+	time.Sleep(200 * time.Millisecond)
+	select {
+	case e := <-events:
+		t.Fatalf("got state %v (%v) before wl_shm is announced", e.State, e.Err)
+	default:
+	}
+	if _, err := s.InitShm(); err != nil {
+		t.Fatal(err)
+	}
+
+	expectState(t, events, StateReconnected)
+	if shm.Get().Context() != c.Context() {
+		t.Error("wl_shm not bound on the new connection")
+	}
+
+	c.Close()
+	<-errs
+}
+
+func TestReconnectTimeout(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+	t.Setenv("WAYLAND_DISPLAY", testDisplay)
+
+	s := startServer(t, true)
+
+	events := make(chan StateEvent, 4)
+	c, err := Connect("", func(e StateEvent) { events <- e })
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetRetryInterval(20 * time.Millisecond)
+	c.SetTimeout(200 * time.Millisecond)
+
+	start := time.Now()
+	errs := dispatch(c)
+	s.Close()
+
+	expectState(t, events, StateDisconnected)
+	e := expectState(t, events, StateFailed)
+	err = <-errs
+	if err == nil || err != e.Err {
+		t.Errorf("Dispatch returned %v, notified %v", err, e.Err)
+	}
+	if d := time.Since(start); d < 200*time.Millisecond {
+		t.Errorf("gave up after %v", d)
+	}
+}
+
+// TestCloseWhileReconnecting closes the client while it waits for the
+// compositor to restart, without timeout
+func TestCloseWhileReconnecting(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+	t.Setenv("WAYLAND_DISPLAY", testDisplay)
+
+	s := startServer(t, true)
+
+	events := make(chan StateEvent, 4)
+	c, err := Connect("", func(e StateEvent) { events <- e })
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.SetRetryInterval(time.Hour)
+	errs := dispatch(c)
+
+	s.Close()
+	expectState(t, events, StateDisconnected)
+
+	if err := c.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	select {
+	case err := <-errs:
+		if !errors.Is(err, client.ErrClosed) {
+			t.Errorf("Dispatch after Close: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Dispatch still reconnecting after Close")
+	}
+	select {
+	case e := <-events:
+		t.Errorf("got state %v (%v) after Close", e.State, e.Err)
+	default:
+	}
+
+	if err := c.Close(); !errors.Is(err, client.ErrClosed) {
+		t.Errorf("second Close: %v", err)
+	}
+}
+
+// TestCloseWhileDispatching closes the client from another goroutine
+// than the one blocked in Dispatch
+func TestCloseWhileDispatching(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+	t.Setenv("WAYLAND_DISPLAY", testDisplay)
+
+	s := startServer(t, true)
+	defer s.Close()
+
+	c, err := Connect("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs := dispatch(c)
+
+	time.Sleep(50 * time.Millisecond)
+	if err := c.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	select {
+	case err := <-errs:
+		if !errors.Is(err, client.ErrClosed) {
+			t.Errorf("Dispatch after Close: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Dispatch not stopped by Close")
+	}
+}
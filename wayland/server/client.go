@@ -0,0 +1,385 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/internal/wire"
+	"golang.org/x/sys/unix"
+)
+
+// serverIDStart is the first id of the range of objects created by the
+// server, ids below it are allocated by the client
+const serverIDStart = 0xff000000
+
+// maxOutput is the size of the events queued for a client beyond which
+// it is considered stuck and disconnected, as libwayland does once the
+// output buffer of a client is full
+const maxOutput = 4 << 20
+
+// closeFlushTimeout bounds the time spent sending the events queued for
+// a closed client, e.g. the wl_display.error it was closed for
+const closeFlushTimeout = time.Second
+
+// ErrClosed is returned when writing to a closed client.
+var ErrClosed = errors.New("client closed")
+
+// message is an event queued for a client with the fds passed along
+type message struct {
+	data []byte
+	fds  []int
+}
+
+// Client is a client connected to the server and the resources it
+// holds.
+type Client struct {
+	server  *Server
+	conn    *net.UnixConn
+	display *Display
+
+	mu           sync.Mutex
+	closed       bool
+	err          error
+	objects      map[uint32]Resource
+	nextServerID uint32
+	registries   map[*Registry]struct{}
+	// fds received from the client, not yet consumed by a request
+	fds []int
+	// out holds the events not sent yet and outLen their size. They are
+	// sent by the writer goroutine, a client not reading its socket
+	// mustn't block the dispatch of the other clients.
+	out     []message
+	outLen  int
+	outWake chan struct{}
+
+	destroyHandlers []func()
+}
+
+func newClient(s *Server, conn *net.UnixConn) *Client {
+	c := &Client{
+		server:       s,
+		conn:         conn,
+		objects:      map[uint32]Resource{},
+		nextServerID: serverIDStart,
+		registries:   map[*Registry]struct{}{},
+		outWake:      make(chan struct{}, 1),
+	}
+	go c.write()
+
+	c.display = NewDisplay(c, 1, DisplayInterface.Version)
+	c.display.SetHandler(displayHandler{})
+
+	return c
+}
+
+// Server returns the server the client is connected to.
+func (c *Client) Server() *Server {
+	return c.server
+}
+
+// Display returns the wl_display resource of the client.
+func (c *Client) Display() *Display {
+	return c.display
+}
+
+// Register adds r to the resources of the client with the given id and
+// version, an id of 0 allocates an id from the server range.
+func (c *Client) Register(r Resource, id uint32, version uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if id == 0 {
+		for {
+			id = c.nextServerID
+			c.nextServerID++
+			// ensure we don't overwrite an existing object
+			if _, ok := c.objects[id]; !ok {
+				break
+			}
+		}
+	}
+
+	b := r.base()
+	b.self = r
+	b.client = c
+	b.id = id
+	b.version = version
+	c.objects[id] = r
+}
+
+// Resource returns the resource with the given id, or nil.
+func (c *Client) Resource(id uint32) Resource {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.objects[id]
+}
+
+// unregister removes the resource with the given id. Ids allocated by
+// the client can only be reused once released with wl_display.delete_id.
+func (c *Client) unregister(id uint32) error {
+	c.mu.Lock()
+	delete(c.objects, id)
+	closed := c.closed
+	c.mu.Unlock()
+
+	if id >= serverIDStart || closed {
+		return nil
+	}
+
+	return c.display.SendDeleteId(id)
+}
+
+func (c *Client) addRegistry(r *Registry) {
+	c.mu.Lock()
+	c.registries[r] = struct{}{}
+	c.mu.Unlock()
+
+	r.AddDestroyHandler(func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.registries, r)
+	})
+}
+
+// registryList returns the wl_registry resources of the client
+func (c *Client) registryList() []*Registry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	registries := make([]*Registry, 0, len(c.registries))
+	for r := range c.registries {
+		registries = append(registries, r)
+	}
+	return registries
+}
+
+// WriteMsg queues a message for the client, oob holds the fds passed
+// along with it. They are duplicated, the caller keeps ownership of the
+// fds. A client not reading its socket is disconnected once too many
+// messages are queued for it.
+func (c *Client) WriteMsg(b []byte, oob []byte) error {
+	var fds []int
+	if len(oob) > 0 {
+		var err error
+		fds, err = dupFds(oob)
+		if err != nil {
+			return fmt.Errorf("client.WriteMsg: %w", err)
+		}
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		closeFds(fds)
+		return ErrClosed
+	}
+	if c.outLen+len(b) > maxOutput {
+		c.mu.Unlock()
+		closeFds(fds)
+		c.disconnect()
+		return &disconnectedError{fmt.Errorf("client.WriteMsg: more than %d bytes queued, the client doesn't read its socket", maxOutput)}
+	}
+	c.out = append(c.out, message{data: append([]byte(nil), b...), fds: fds})
+	c.outLen += len(b)
+	c.mu.Unlock()
+
+	c.wakeWriter()
+	return nil
+}
+
+func (c *Client) wakeWriter() {
+	select {
+	case c.outWake <- struct{}{}:
+	default:
+	}
+}
+
+// write sends the queued messages until the client is closed, then
+// closes the connection. Once a write fails the client is disconnected
+// and the remaining messages are dropped.
+func (c *Client) write() {
+	failed := false
+	for {
+		c.mu.Lock()
+		out := c.out
+		closed := c.closed
+		c.out = nil
+		c.mu.Unlock()
+
+		for _, msg := range out {
+			if !failed {
+				if err := c.writeMsg(msg); err != nil {
+					failed = true
+					c.disconnect()
+				}
+			}
+			closeFds(msg.fds)
+
+			c.mu.Lock()
+			c.outLen -= len(msg.data)
+			c.mu.Unlock()
+		}
+
+		switch {
+		case len(out) > 0:
+		case closed:
+			c.conn.Close()
+			return
+		default:
+			<-c.outWake
+		}
+	}
+}
+
+func (c *Client) writeMsg(msg message) error {
+	var oob []byte
+	if len(msg.fds) > 0 {
+		oob = unix.UnixRights(msg.fds...)
+	}
+
+	n, oobn, err := c.conn.WriteMsgUnix(msg.data, oob, nil)
+	if err != nil {
+		return err
+	}
+	if oobn != len(oob) {
+		return fmt.Errorf("client.writeMsg: incorrect number of bytes written for oob (oobn=%d)", oobn)
+	}
+	if n < len(msg.data) {
+		// The fds went along with the first part
+		_, err = c.conn.Write(msg.data[n:])
+	}
+	return err
+}
+
+// disconnect makes the serving goroutine close the client and aborts a
+// pending write
+func (c *Client) disconnect() {
+	c.conn.SetWriteDeadline(time.Now())
+	c.conn.CloseRead()
+}
+
+// dupFds duplicates the fds passed in oob
+func dupFds(oob []byte) ([]int, error) {
+	fds, err := wire.ParseFds(oob, len(oob), "event")
+	if err != nil {
+		return nil, err
+	}
+
+	dups := make([]int, 0, len(fds))
+	for _, fd := range fds {
+		dup, err := unix.FcntlInt(uintptr(fd), unix.F_DUPFD_CLOEXEC, 0)
+		if err != nil {
+			closeFds(dups)
+			return nil, fmt.Errorf("unable to dup fd %d: %w", fd, err)
+		}
+		dups = append(dups, dup)
+	}
+	return dups, nil
+}
+
+func closeFds(fds []int) {
+	for _, fd := range fds {
+		unix.Close(fd)
+	}
+}
+
+// PostError sends a fatal protocol error about object to the client
+// with wl_display.error, the client is disconnected and its requests
+// are no longer dispatched.
+func (c *Client) PostError(object Resource, code uint32, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+
+	c.mu.Lock()
+	if c.err != nil || c.closed {
+		c.mu.Unlock()
+		return
+	}
+	c.err = &ProtocolError{
+		ObjectID:  object.ID(),
+		Interface: object.Interface().Name,
+		Code:      code,
+		Message:   message,
+	}
+	c.mu.Unlock()
+
+	c.display.SendError(object, code, message)
+	// Stop reading, the serving goroutine disconnects the client
+	c.conn.CloseRead()
+}
+
+// Error returns the protocol error posted to the client, if any.
+func (c *Client) Error() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.err
+}
+
+// AddDestroyHandler registers f to be called once the client is
+// disconnected, after its resources are destroyed.
+func (c *Client) AddDestroyHandler(f func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.destroyHandlers = append(c.destroyHandlers, f)
+}
+
+// Close disconnects the client and destroys its resources. The messages
+// already queued, e.g. a wl_display.error, are still sent before the
+// connection is closed.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrClosed
+	}
+	c.closed = true
+	objects := c.objects
+	fds := c.fds
+	handlers := c.destroyHandlers
+	c.objects = map[uint32]Resource{}
+	c.fds = nil
+	c.destroyHandlers = nil
+	c.mu.Unlock()
+
+	// Stop reading and let the writer flush the queue and close the
+	// connection
+	err := c.conn.CloseRead()
+	c.conn.SetWriteDeadline(time.Now().Add(closeFlushTimeout))
+	c.wakeWriter()
+
+	for _, r := range objects {
+		r.Destroy()
+	}
+	closeFds(fds)
+	for _, f := range handlers {
+		f()
+	}
+	c.server.removeClient(c)
+
+	return err
+}
+
+// serve dispatches the requests of the client until it disconnects
+func (c *Client) serve() {
+	for {
+		senderID, opcode, data, err := c.readMsg()
+		if err != nil {
+			break
+		}
+
+		c.server.dispatchMu.Lock()
+		err = c.dispatch(senderID, opcode, data)
+		c.server.dispatchMu.Unlock()
+		if err != nil {
+			break
+		}
+	}
+
+	c.server.Do(func() { c.Close() })
+}
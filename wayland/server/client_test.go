@@ -0,0 +1,278 @@
+package server
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"golang.org/x/sys/unix"
+)
+
+// startServer serves s on a display socket in a temporary
+// XDG_RUNTIME_DIR and connects a client to it
+func startServer(t *testing.T, s *Server) *client.Display {
+	t.Helper()
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+
+	sock, err := Listen("wayland-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(sock)
+	t.Cleanup(func() { s.Close() })
+
+	display, err := client.Connect(sock.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { display.Context().Close() })
+
+	return display
+}
+
+// request encodes a request with uint32 arguments
+func request(id, opcode uint32, args ...uint32) []byte {
+	b := make([]byte, 8+4*len(args))
+	client.PutUint32(b, id)
+	client.PutUint32(b[4:], uint32(len(b))<<16|opcode)
+	for i, arg := range args {
+		client.PutUint32(b[8+4*i:], arg)
+	}
+	return b
+}
+
+// compositorHandler records the regions created and posts an error on
+// surface creation
+type compositorHandler struct {
+	regions []uint32
+}
+
+func (h *compositorHandler) CreateSurface(r *Compositor, id *Surface) {
+	r.PostError(uint32(DisplayErrorImplementation), "no surfaces for %s", "tests")
+}
+
+func (h *compositorHandler) CreateRegion(r *Compositor, id *Region) {
+	h.regions = append(h.regions, id.ID())
+}
+
+// bindCompositor creates a wl_compositor global and binds it
+func bindCompositor(t *testing.T, s *Server, h *compositorHandler) (*client.Display, *client.Compositor) {
+	t.Helper()
+
+	if _, err := s.CreateGlobal(CompositorInterface, 5, func(r Resource) {
+		r.(*Compositor).SetHandler(h)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	display := startServer(t, s)
+
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compositor, err := client.BindGlobal[*client.Compositor](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return display, compositor
+}
+
+func TestDeleteID(t *testing.T) {
+	s := New()
+	h := &compositorHandler{}
+	display, compositor := bindCompositor(t, s, h)
+	ctx := display.Context()
+
+	var deleted []uint32
+	display.SetDeleteIdHandler(func(e client.DisplayDeleteIdEvent) {
+		deleted = append(deleted, e.Id)
+	})
+
+	region, err := compositor.CreateRegion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := region.ID()
+	if err := region.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, d := range deleted {
+		found = found || d == id
+	}
+	if !found {
+		t.Fatalf("no delete_id for region %d, got %v", id, deleted)
+	}
+
+	// The id is free once deleted, create_region is opcode 1
+	if err := ctx.WriteMsg(request(compositor.ID(), 1, id), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := display.Roundtrip(); err != nil {
+		t.Fatalf("reusing a deleted id: %v", err)
+	}
+	var regions []uint32
+	s.Do(func() { regions = append(regions, h.regions...) })
+	if len(regions) != 2 || regions[1] != id {
+		t.Fatalf("got regions %v, want %d twice", regions, id)
+	}
+
+	// An id in use can't be reused
+	if err := ctx.WriteMsg(request(compositor.ID(), 1, id), nil); err != nil {
+		t.Fatal(err)
+	}
+	var protocolErr *client.ProtocolError
+	if err := display.Roundtrip(); !errors.As(err, &protocolErr) || protocolErr.Code != uint32(DisplayErrorInvalidObject) {
+		t.Errorf("got error %v reusing an id in use, want invalid_object", err)
+	}
+}
+
+func TestPostError(t *testing.T) {
+	s := New()
+	clients := make(chan *Client, 1)
+	s.SetClientHandler(func(c *Client) { clients <- c })
+	display, compositor := bindCompositor(t, s, &compositorHandler{})
+
+	if _, err := compositor.CreateSurface(); err != nil {
+		t.Fatal(err)
+	}
+	err := display.Roundtrip()
+
+	var protocolErr *client.ProtocolError
+	if !errors.As(err, &protocolErr) {
+		t.Fatalf("got error %v, want a protocol error", err)
+	}
+	want := client.ProtocolError{
+		ObjectID:  compositor.ID(),
+		Interface: "wl_compositor",
+		Code:      uint32(DisplayErrorImplementation),
+		Message:   "no surfaces for tests",
+	}
+	if *protocolErr != want {
+		t.Errorf("got %+v, want %+v", *protocolErr, want)
+	}
+
+	// The client is disconnected, no further request is dispatched
+	if err := display.Roundtrip(); !errors.Is(err, client.ErrDisconnected) {
+		t.Errorf("got error %v after the protocol error, want ErrDisconnected", err)
+	}
+
+	var posted *ProtocolError
+	if err := (<-clients).Error(); !errors.As(err, &posted) || posted.Message != want.Message {
+		t.Errorf("got server side error %v, want the posted error", err)
+	}
+}
+
+// rawClient connects a client to s over a socketpair, returning the
+// server side client and the connection of the client
+func rawClient(t *testing.T, s *Server) (*Client, *net.UnixConn) {
+	t.Helper()
+
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conns := make([]*net.UnixConn, 2)
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "socketpair")
+		c, err := net.FileConn(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		conns[i] = c.(*net.UnixConn)
+	}
+	t.Cleanup(func() { conns[1].Close() })
+
+	return s.CreateClient(conns[0]), conns[1]
+}
+
+// TestStuckClient floods a client that doesn't read its socket, the
+// other clients are still served and the stuck one is disconnected.
+func TestStuckClient(t *testing.T) {
+	s := New()
+	display := startServer(t, s)
+	stuck, _ := rawClient(t, s)
+
+	event := make([]byte, 4096)
+	var writeErr error
+	s.Do(func() {
+		for i := 0; i < 2*maxOutput/len(event) && writeErr == nil; i++ {
+			writeErr = stuck.WriteMsg(event, nil)
+		}
+	})
+	if !errors.Is(writeErr, ErrDisconnected) {
+		t.Fatalf("got error %v flooding the client, want ErrDisconnected", writeErr)
+	}
+
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var connected bool
+		for _, c := range s.Clients() {
+			connected = connected || c == stuck
+		}
+		if !connected {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("stuck client still connected")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestWriteMsgFds closes an fd right after passing it to WriteMsg, the
+// client must still receive it open.
+func TestWriteMsgFds(t *testing.T) {
+	s := New()
+	defer s.Close()
+	c, conn := rawClient(t, s)
+
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(p[0])
+
+	s.Do(func() {
+		if err := c.WriteMsg(request(1, 1, 0xff0000ff), unix.UnixRights(p[1])); err != nil {
+			t.Error(err)
+		}
+	})
+	unix.Close(p[1])
+
+	b := make([]byte, 12)
+	oob := make([]byte, unix.CmsgSpace(4))
+	_, oobn, _, _, err := conn.ReadMsgUnix(b, oob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scms, err := unix.ParseSocketControlMessage(oob[:oobn])
+	if err != nil || len(scms) != 1 {
+		t.Fatalf("got %d control messages, %v, want one", len(scms), err)
+	}
+	fds, err := unix.ParseUnixRights(&scms[0])
+	if err != nil || len(fds) != 1 {
+		t.Fatalf("got fds %v, %v, want one", fds, err)
+	}
+	defer unix.Close(fds[0])
+
+	if _, err := unix.Write(fds[0], []byte("x")); err != nil {
+		t.Fatalf("received fd unusable: %v", err)
+	}
+	buf := make([]byte, 1)
+	if n, err := unix.Read(p[0], buf); n != 1 || err != nil {
+		t.Errorf("read %d bytes from the pipe, %v, want 1", n, err)
+	}
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"errors"
+	"net"
+	"sync"
+)
+
+// ErrServerClosed is returned by Serve once the server is closed.
+var ErrServerClosed = errors.New("server closed")
+
+// Server accepts clients and advertises its globals to them, it is the
+// counterpart of wl_display in libwayland-server.
+//
+// Each client is served by its own goroutine, requests of all the
+// clients are dispatched one at a time though: handlers don't need to
+// synchronize with each other. Code running outside of handlers uses Do
+// to access the state they share.
+type Server struct {
+	// dispatchMu is held while dispatching requests, see Do
+	dispatchMu sync.Mutex
+
+	mu            sync.Mutex
+	closed        bool
+	sockets       map[*Socket]struct{}
+	clients       map[*Client]struct{}
+	globals       []*Global
+	nextName      uint32
+	serial        uint32
+	globalFilter  func(c *Client, g *Global) bool
+	clientHandler func(c *Client)
+}
+
+func New() *Server {
+	return &Server{
+		sockets: map[*Socket]struct{}{},
+		clients: map[*Client]struct{}{},
+	}
+}
+
+// Serve accepts clients on sock until the server is closed, sock is
+// closed along with the server.
+func (s *Server) Serve(sock *Socket) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		sock.Close()
+		return ErrServerClosed
+	}
+	s.sockets[sock] = struct{}{}
+	s.mu.Unlock()
+
+	for {
+		conn, err := sock.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return ErrServerClosed
+			}
+			return err
+		}
+
+		s.CreateClient(conn)
+	}
+}
+
+// CreateClient serves a client connected through conn, e.g. one end of
+// a socketpair passed to a child process through WAYLAND_SOCKET.
+func (s *Server) CreateClient(conn *net.UnixConn) *Client {
+	c := newClient(s, conn)
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		c.Close()
+		return c
+	}
+	s.clients[c] = struct{}{}
+	handler := s.clientHandler
+	s.mu.Unlock()
+
+	if handler != nil {
+		s.Do(func() { handler(c) })
+	}
+	go c.serve()
+
+	return c
+}
+
+// SetClientHandler sets f to be called for each new client, before its
+// first request is dispatched.
+func (s *Server) SetClientHandler(f func(c *Client)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clientHandler = f
+}
+
+// Clients returns the connected clients.
+func (s *Server) Clients() []*Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clients := make([]*Client, 0, len(s.clients))
+	for c := range s.clients {
+		clients = append(clients, c)
+	}
+	return clients
+}
+
+func (s *Server) removeClient(c *Client) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, c)
+}
+
+// Do calls f while no request is being dispatched, for code running
+// outside of handlers, e.g. on a timer, to access the state handlers
+// use. It must not be called from a handler.
+func (s *Server) Do(f func()) {
+	s.dispatchMu.Lock()
+	defer s.dispatchMu.Unlock()
+
+	f()
+}
+
+// NextSerial returns a new serial, for events that clients reference in
+// later requests.
+func (s *Server) NextSerial() uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.serial++
+	return s.serial
+}
+
+// Close stops serving the sockets and disconnects all the clients, their
+// destroy handlers run while no request is being dispatched. It must not
+// be called from a handler.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrServerClosed
+	}
+	s.closed = true
+	sockets := s.sockets
+	clients := s.clients
+	s.sockets = map[*Socket]struct{}{}
+	s.clients = map[*Client]struct{}{}
+	s.mu.Unlock()
+
+	var err error
+	for sock := range sockets {
+		if e := sock.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	s.Do(func() {
+		for c := range clients {
+			c.Close()
+		}
+	})
+
+	return err
+}
+
+// displayHandler implements wl_display, the object 1 of every client
+type displayHandler struct{}
+
+func (displayHandler) Sync(r *Display, callback *Callback) {
+	callback.SendDone(r.Client().server.NextSerial())
+	callback.Destroy()
+}
+
+func (displayHandler) GetRegistry(r *Display, registry *Registry) {
+	c := r.Client()
+	registry.SetHandler(registryHandler{})
+	c.addRegistry(registry)
+
+	for _, g := range c.server.visibleGlobals(c) {
+		registry.SendGlobal(g.name, g.iface.Name, g.version)
+	}
+}
@@ -0,0 +1,25 @@
+// Package server is Go port of wayland-server library
+// for writing compositors and other wayland servers in pure Go.
+//
+// A Server accepts clients on display sockets created by Listen or
+// ListenAuto and advertises globals to them. Binding a global creates
+// a resource of the generated type of its interface, requests are
+// dispatched to the handler set on it:
+//
+//	s := server.New()
+//	sock, err := server.ListenAuto()
+//	...
+//	s.CreateGlobal(server.CompositorInterface, 5, func(r server.Resource) {
+//		r.(*server.Compositor).SetHandler(compositor)
+//	})
+//	s.Serve(sock)
+//
+// InitShm implements wl_shm, GetShmBuffer gives access to the contents
+// of the buffers clients create with it. Access is guarded against
+// clients truncating the backing file, which would otherwise crash the
+// server with SIGBUS.
+//
+// server.go is generated from the core protocol XML by
+// go-wayland-scanner with -side server, see the go:generate directive
+// of the client package.
+package server
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+)
+
+// VersionError is returned when sending events that are not available
+// in the version the resource was bound with.
+type VersionError struct {
+	Interface string
+	Event     string
+	Since     uint32
+	Version   uint32
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%s.%s: event needs version %d, resource has version %d", e.Interface, e.Event, e.Since, e.Version)
+}
+
+// Is makes VersionError match ErrVersionTooLow.
+func (e *VersionError) Is(target error) bool {
+	return target == ErrVersionTooLow
+}
+
+// ErrVersionTooLow is matched by VersionError.
+var ErrVersionTooLow = errors.New("version too low")
+
+// ObjectError is returned by Dispatch for requests referencing an
+// object the client doesn't have, or of the wrong interface.
+type ObjectError struct {
+	ID     uint32
+	Reason string
+}
+
+func (e *ObjectError) Error() string {
+	return fmt.Sprintf("invalid object %d: %s", e.ID, e.Reason)
+}
+
+// OpcodeError is returned by Dispatch for requests with an opcode the
+// interface doesn't declare.
+type OpcodeError struct {
+	Interface string
+	Opcode    uint32
+}
+
+func (e *OpcodeError) Error() string {
+	return fmt.Sprintf("%s: invalid opcode %d", e.Interface, e.Opcode)
+}
+
+// ProtocolError is the fatal error posted to a client with PostError.
+type ProtocolError struct {
+	ObjectID  uint32
+	Interface string
+	Code      uint32
+	Message   string
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("protocol error %d on %s@%d: %s", e.Code, e.Interface, e.ObjectID, e.Message)
+}
+
+// ErrDisconnected is matched by errors caused by the connection to the
+// client failing or being closed by the client.
+var ErrDisconnected = errors.New("disconnected")
+
+// disconnectedError wraps connection failures so that they match
+// ErrDisconnected while keeping the underlying error.
+type disconnectedError struct {
+	err error
+}
+
+func (e *disconnectedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *disconnectedError) Unwrap() error {
+	return e.err
+}
+
+func (e *disconnectedError) Is(target error) bool {
+	return target == ErrDisconnected
+}
@@ -0,0 +1,201 @@
+package server
+
+import "fmt"
+
+// Global is an object advertised to clients through wl_registry, which
+// they bind to create resources of its interface.
+type Global struct {
+	server  *Server
+	name    uint32
+	iface   *Interface
+	version uint32
+	bind    func(r Resource)
+	removed bool
+}
+
+// CreateGlobal advertises a global of the given interface, clients can
+// bind it up to the given version. bind is called with each resource
+// created by binding it, to set its handler.
+func (s *Server) CreateGlobal(iface *Interface, version uint32, bind func(r Resource)) (*Global, error) {
+	if version == 0 || version > iface.Version {
+		return nil, fmt.Errorf("invalid version %d for global %s, interface has version %d", version, iface.Name, iface.Version)
+	}
+
+	s.mu.Lock()
+	s.nextName++
+	g := &Global{
+		server:  s,
+		name:    s.nextName,
+		iface:   iface,
+		version: version,
+		bind:    bind,
+	}
+	s.globals = append(s.globals, g)
+	s.mu.Unlock()
+
+	for _, c := range s.Clients() {
+		if !s.visible(c, g) {
+			continue
+		}
+		for _, registry := range c.registryList() {
+			registry.SendGlobal(g.name, iface.Name, version)
+		}
+	}
+
+	return g, nil
+}
+
+// Name returns the numeric name clients bind the global with.
+func (g *Global) Name() uint32 {
+	return g.name
+}
+
+func (g *Global) Interface() *Interface {
+	return g.iface
+}
+
+func (g *Global) Version() uint32 {
+	return g.version
+}
+
+// Remove withdraws the global from clients with wl_registry.global_remove.
+// Clients may still bind it until they receive the event: they get an
+// inert resource, bind is no longer called.
+//
+// The server forgets the global once the events are sent.
+func (g *Global) Remove() {
+	s := g.server
+
+	s.mu.Lock()
+	if g.removed {
+		s.mu.Unlock()
+		return
+	}
+	g.removed = true
+	s.mu.Unlock()
+
+	for _, c := range s.Clients() {
+		if !s.visible(c, g) {
+			continue
+		}
+		for _, registry := range c.registryList() {
+			registry.SendGlobalRemove(g.name)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for idx, global := range s.globals {
+		if global == g {
+			s.globals = append(s.globals[:idx], s.globals[idx+1:]...)
+			break
+		}
+	}
+}
+
+// SetGlobalFilter sets f to decide which globals are advertised to
+// which clients, e.g. to reserve privileged interfaces to some of them.
+// Clients can't bind the globals filtered out.
+func (s *Server) SetGlobalFilter(f func(c *Client, g *Global) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.globalFilter = f
+}
+
+// Globals returns the globals not removed.
+func (s *Server) Globals() []*Global {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var globals []*Global
+	for _, g := range s.globals {
+		if !g.removed {
+			globals = append(globals, g)
+		}
+	}
+	return globals
+}
+
+// visible reports whether the global passes the filter for c
+func (s *Server) visible(c *Client, g *Global) bool {
+	s.mu.Lock()
+	filter := s.globalFilter
+	s.mu.Unlock()
+
+	return filter == nil || filter(c, g)
+}
+
+// visibleGlobals returns the globals advertised to c
+func (s *Server) visibleGlobals(c *Client) []*Global {
+	var globals []*Global
+	for _, g := range s.Globals() {
+		if s.visible(c, g) {
+			globals = append(globals, g)
+		}
+	}
+	return globals
+}
+
+// global returns the global with the given name, including ones being
+// removed
+func (s *Server) global(name uint32) *Global {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, g := range s.globals {
+		if g.name == name {
+			return g
+		}
+	}
+	return nil
+}
+
+// removedGlobal reports whether name belonged to a global that was
+// removed and forgotten since, as opposed to a name never advertised
+func (s *Server) removedGlobal(name uint32) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return name != 0 && name <= s.nextName
+}
+
+// registryHandler implements wl_registry
+type registryHandler struct{}
+
+func (registryHandler) Bind(r *Registry, name uint32, iface string, version uint32, id uint32) {
+	c := r.Client()
+	s := c.server
+
+	g := s.global(name)
+	if g == nil && s.removedGlobal(name) {
+		// The client bound the global before receiving global_remove,
+		// it gets an inert resource
+		if gi := LookupInterface(iface); gi != nil && version != 0 && version <= gi.Version {
+			gi.New(c, id, version)
+			return
+		}
+	}
+	if g == nil || !s.visible(c, g) {
+		r.PostError(uint32(DisplayErrorInvalidObject), "invalid global %s (%d)", iface, name)
+		return
+	}
+	if iface != g.iface.Name {
+		r.PostError(uint32(DisplayErrorInvalidObject), "invalid interface for global %d: have %s, wanted %s", name, iface, g.iface.Name)
+		return
+	}
+	if version == 0 || version > g.version {
+		r.PostError(uint32(DisplayErrorInvalidObject), "invalid version for global %s (%d): have %d, wanted %d", iface, name, g.version, version)
+		return
+	}
+
+	res := g.iface.New(c, id, version)
+
+	s.mu.Lock()
+	removed := g.removed
+	s.mu.Unlock()
+	if !removed && g.bind != nil {
+		g.bind(res)
+	}
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+)
+
+func TestBindVersion(t *testing.T) {
+	s := New()
+	versions := make(chan uint32, 1)
+	if _, err := s.CreateGlobal(OutputInterface, 3, func(r Resource) {
+		versions <- r.Version()
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CreateGlobal(OutputInterface, OutputInterface.Version+1, nil); err == nil {
+		t.Error("created a global with a version above the interface")
+	}
+
+	display := startServer(t, s)
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := globals.Lookup(client.OutputName)
+	if len(outputs) != 1 || outputs[0].Version != 3 {
+		t.Fatalf("got outputs %+v, want one of version 3", outputs)
+	}
+
+	// The client supports a higher version, the global's is used
+	output, err := client.BindGlobal[*client.Output](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+	if v := <-versions; v != 3 || output.Version() != 3 {
+		t.Errorf("bound version %d, proxy version %d, want 3", v, output.Version())
+	}
+
+	// Binding above the advertised version is a protocol error
+	p := &client.Output{}
+	display.Context().Register(p)
+	err = globals.Registry().Bind(outputs[0].Name, client.OutputName, 4, p)
+	if err == nil {
+		err = display.Roundtrip()
+	}
+	var protocolErr *client.ProtocolError
+	if !errors.As(err, &protocolErr) || !strings.HasPrefix(protocolErr.Message, "invalid version") {
+		t.Errorf("got error %v binding version 4, want an invalid version error", err)
+	}
+}
+
+func TestGlobalFilter(t *testing.T) {
+	s := New()
+	if _, err := s.CreateGlobal(CompositorInterface, 5, nil); err != nil {
+		t.Fatal(err)
+	}
+	hidden, err := s.CreateGlobal(OutputInterface, 4, func(r Resource) {
+		t.Error("hidden global bound")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SetGlobalFilter(func(c *Client, g *Global) bool {
+		return g != hidden
+	})
+
+	display := startServer(t, s)
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := globals.List()
+	if len(list) != 1 || list[0].Interface != client.CompositorName {
+		t.Fatalf("got globals %+v, want wl_compositor only", list)
+	}
+
+	// Binding it by name anyway fails
+	p := &client.Output{}
+	display.Context().Register(p)
+	err = globals.Registry().Bind(hidden.Name(), client.OutputName, 4, p)
+	if err == nil {
+		err = display.Roundtrip()
+	}
+	var protocolErr *client.ProtocolError
+	if !errors.As(err, &protocolErr) || !strings.HasPrefix(protocolErr.Message, "invalid global") {
+		t.Errorf("got error %v binding a hidden global, want an invalid global error", err)
+	}
+}
+
+// TestGlobalRemove checks removed globals are forgotten, binding one
+// the client didn't see removed yet still succeeds.
+func TestGlobalRemove(t *testing.T) {
+	s := New()
+	g, err := s.CreateGlobal(OutputInterface, 4, func(r Resource) {
+		t.Error("removed global bound")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	display := startServer(t, s)
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := globals.Lookup(client.OutputName)
+	if len(outputs) != 1 {
+		t.Fatalf("got outputs %+v, want one", outputs)
+	}
+
+	g.Remove()
+	s.mu.Lock()
+	n := len(s.globals)
+	s.mu.Unlock()
+	if n != 0 {
+		t.Errorf("%d globals left after removal", n)
+	}
+
+	p := &client.Output{}
+	display.Context().Register(p)
+	if err := globals.Registry().Bind(outputs[0].Name, client.OutputName, 4, p); err != nil {
+		t.Fatal(err)
+	}
+	if err := display.Roundtrip(); err != nil {
+		t.Errorf("got error %v binding a removed global, want an inert resource", err)
+	}
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Interface describes a protocol interface as declared in
+// the protocol XML, it is generated alongside every resource type.
+type Interface struct {
+	Name    string
+	Version uint32
+	// New creates a resource of the generated type for this interface,
+	// see the generated constructors.
+	New      func(c *Client, id uint32, version uint32) Resource
+	Requests []Message
+	Events   []Message
+}
+
+// Message describes a request or an event of an interface.
+// Opcodes are the indexes into Interface.Requests and
+// Interface.Events.
+type Message struct {
+	Name  string
+	Since uint32
+	Args  []Arg
+}
+
+// Arg describes an argument of a message.
+type Arg struct {
+	Name string
+	Type ArgType
+	// Interface of object and new_id arguments, empty if
+	// the argument accepts any interface.
+	Interface string
+	AllowNull bool
+}
+
+type ArgType uint8
+
+const (
+	ArgTypeInt ArgType = iota
+	ArgTypeUint
+	ArgTypeFixed
+	ArgTypeString
+	ArgTypeObject
+	ArgTypeNewID
+	ArgTypeArray
+	ArgTypeFd
+)
+
+var argTypeNames = [...]string{
+	ArgTypeInt:    "int",
+	ArgTypeUint:   "uint",
+	ArgTypeFixed:  "fixed",
+	ArgTypeString: "string",
+	ArgTypeObject: "object",
+	ArgTypeNewID:  "new_id",
+	ArgTypeArray:  "array",
+	ArgTypeFd:     "fd",
+}
+
+// String returns the name of the type as used in protocol XML.
+func (t ArgType) String() string {
+	if int(t) < len(argTypeNames) {
+		return argTypeNames[t]
+	}
+	return fmt.Sprintf("ArgType(%d)", t)
+}
+
+// Request returns the request with the given opcode, or nil.
+func (i *Interface) Request(opcode uint32) *Message {
+	if int(opcode) < len(i.Requests) {
+		return &i.Requests[opcode]
+	}
+	return nil
+}
+
+// Event returns the event with the given opcode, or nil.
+func (i *Interface) Event(opcode uint32) *Message {
+	if int(opcode) < len(i.Events) {
+		return &i.Events[opcode]
+	}
+	return nil
+}
+
+var (
+	interfacesMu sync.RWMutex
+	interfaces   = map[string]*Interface{}
+)
+
+// RegisterInterface makes iface available to LookupInterface,
+// generated packages register all of their interfaces on init.
+func RegisterInterface(iface *Interface) {
+	interfacesMu.Lock()
+	defer interfacesMu.Unlock()
+
+	interfaces[iface.Name] = iface
+}
+
+// LookupInterface returns the registered interface with the given
+// name, or nil if no imported package declares it.
+func LookupInterface(name string) *Interface {
+	interfacesMu.RLock()
+	defer interfacesMu.RUnlock()
+
+	return interfaces[name]
+}
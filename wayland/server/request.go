@@ -0,0 +1,242 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hempflower/go-wayland/wayland/internal/wire"
+	"golang.org/x/sys/unix"
+)
+
+// readMsg reads the next request from the connection. File descriptors
+// received along with it are queued and handed out in order to the
+// requests carrying fd arguments.
+func (c *Client) readMsg() (senderID uint32, opcode uint32, msg []byte, err error) {
+	header := make([]byte, 8)
+	if err := c.readFull(header, "header"); err != nil {
+		return senderID, opcode, msg, fmt.Errorf("client.readMsg: %w", err)
+	}
+
+	senderID = Uint32(header[:4])
+	opcodeAndSize := Uint32(header[4:8])
+	opcode = opcodeAndSize & 0xffff
+	size := opcodeAndSize >> 16
+
+	msgSize := int(size) - 8
+	if msgSize < 0 {
+		return senderID, opcode, msg, fmt.Errorf("client.readMsg: incorrect message size (size=%d)", size)
+	}
+	if msgSize == 0 {
+		return senderID, opcode, nil, nil
+	}
+
+	msg = make([]byte, msgSize)
+	if err := c.readFull(msg, "msg"); err != nil {
+		return senderID, opcode, msg, fmt.Errorf("client.readMsg: %w", err)
+	}
+
+	return senderID, opcode, msg, nil
+}
+
+// readFull fills b from the connection, queueing received fds
+func (c *Client) readFull(b []byte, source string) error {
+	oob := make([]byte, wire.OobSpace)
+
+	for read := 0; read < len(b); {
+		n, oobn, _, _, err := c.conn.ReadMsgUnix(b[read:], oob)
+		if err != nil {
+			return &disconnectedError{err}
+		}
+		if n == 0 {
+			return &disconnectedError{fmt.Errorf("connection closed while reading %s (n=%d, size=%d)", source, read, len(b))}
+		}
+		read += n
+
+		if oobn > 0 {
+			fds, err := wire.ParseFds(oob, oobn, source)
+			if err != nil {
+				return err
+			}
+			c.queueFds(fds)
+		}
+	}
+
+	return nil
+}
+
+// dispatch validates a request and dispatches it to its resource.
+// Invalid requests are fatal to the client, the posted error is
+// returned.
+func (c *Client) dispatch(senderID uint32, opcode uint32, data []byte) error {
+	if err := c.Error(); err != nil {
+		return err
+	}
+
+	r := c.Resource(senderID)
+	if r == nil {
+		c.PostError(c.display, uint32(DisplayErrorInvalidObject), "invalid object %d", senderID)
+		return c.Error()
+	}
+
+	iface := r.Interface()
+	req := iface.Request(opcode)
+	if req == nil || req.Since > r.Version() {
+		c.PostError(r, uint32(DisplayErrorInvalidMethod), "invalid method %d, object %s@%d", opcode, iface.Name, senderID)
+		return c.Error()
+	}
+
+	n := requestFds(req)
+	fds := c.popFds(n)
+	if len(fds) < n {
+		for _, fd := range fds {
+			unix.Close(fd)
+		}
+		c.PostError(r, uint32(DisplayErrorInvalidMethod), "invalid arguments for %s@%d.%s: file descriptor expected", iface.Name, senderID, req.Name)
+		return c.Error()
+	}
+	if err := c.checkRequest(req, data); err != nil {
+		for _, fd := range fds {
+			unix.Close(fd)
+		}
+		code := DisplayErrorInvalidMethod
+		var objErr *ObjectError
+		if errors.As(err, &objErr) {
+			code = DisplayErrorInvalidObject
+		}
+		c.PostError(r, uint32(code), "invalid arguments for %s@%d.%s: %v", iface.Name, senderID, req.Name, err)
+		return c.Error()
+	}
+
+	fd := -1
+	if len(fds) > 0 {
+		fd = fds[0]
+		for _, fd := range fds[1:] {
+			unix.Close(fd)
+		}
+	}
+
+	if err := r.Dispatch(opcode, fd, data); err != nil {
+		c.PostError(r, uint32(DisplayErrorInvalidMethod), "invalid arguments for %s@%d.%s: %v", iface.Name, senderID, req.Name, err)
+	}
+
+	return c.Error()
+}
+
+// checkRequest verifies that data holds exactly the arguments of the
+// request, that objects exist with the expected interface and that new
+// ids are free ids of the client range
+func (c *Client) checkRequest(req *Message, data []byte) error {
+	l := 0
+	for _, arg := range req.Args {
+		if arg.Type == ArgTypeFd {
+			continue
+		}
+		if arg.Type == ArgTypeNewID && arg.Interface == "" {
+			// Untyped new_id, as in wl_registry.bind, is preceded by the
+			// interface name and version
+			if err := checkString(arg, data, &l); err != nil {
+				return err
+			}
+			l += 4
+		}
+
+		switch arg.Type {
+		case ArgTypeString, ArgTypeArray:
+			if err := checkString(arg, data, &l); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if len(data) < l+4 {
+			return fmt.Errorf("message too short for argument %s", arg.Name)
+		}
+		v := Uint32(data[l : l+4])
+		l += 4
+
+		switch arg.Type {
+		case ArgTypeObject:
+			if v == 0 {
+				if !arg.AllowNull {
+					return &ObjectError{ID: v, Reason: fmt.Sprintf("null object for argument %s", arg.Name)}
+				}
+				continue
+			}
+			r := c.Resource(v)
+			if r == nil {
+				return &ObjectError{ID: v, Reason: fmt.Sprintf("unknown object for argument %s", arg.Name)}
+			}
+			if arg.Interface != "" && r.Interface().Name != arg.Interface {
+				return &ObjectError{ID: v, Reason: fmt.Sprintf("object of interface %s for argument %s of interface %s", r.Interface().Name, arg.Name, arg.Interface)}
+			}
+		case ArgTypeNewID:
+			if v == 0 || v >= serverIDStart || c.Resource(v) != nil {
+				return &ObjectError{ID: v, Reason: fmt.Sprintf("invalid new id for argument %s", arg.Name)}
+			}
+		}
+	}
+	if l != len(data) {
+		return fmt.Errorf("message size %d doesn't match arguments size %d", len(data), l)
+	}
+
+	return nil
+}
+
+// checkString verifies the string or array argument at data[*l:] and
+// advances *l past it
+func checkString(arg Arg, data []byte, l *int) error {
+	if len(data) < *l+4 {
+		return fmt.Errorf("message too short for argument %s", arg.Name)
+	}
+	n := int(Uint32(data[*l : *l+4]))
+	*l += 4
+	if n > len(data)-*l {
+		return fmt.Errorf("message too short for argument %s", arg.Name)
+	}
+	if arg.Type != ArgTypeArray && (n == 0 || data[*l+n-1] != 0) {
+		if n != 0 || !arg.AllowNull {
+			return fmt.Errorf("string argument %s is not null terminated", arg.Name)
+		}
+	}
+	*l += PaddedLen(n)
+	if *l > len(data) {
+		return fmt.Errorf("message too short for argument %s", arg.Name)
+	}
+
+	return nil
+}
+
+// requestFds returns the number of fd arguments of a request
+func requestFds(req *Message) int {
+	n := 0
+	for _, arg := range req.Args {
+		if arg.Type == ArgTypeFd {
+			n++
+		}
+	}
+	return n
+}
+
+// popFds removes up to n fds from the head of the queue
+func (c *Client) popFds(n int) []int {
+	if n == 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if n > len(c.fds) {
+		n = len(c.fds)
+	}
+	fds := append([]int(nil), c.fds[:n]...)
+	c.fds = c.fds[n:]
+	return fds
+}
+
+func (c *Client) queueFds(fds []int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fds = append(c.fds, fds...)
+}
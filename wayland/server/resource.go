@@ -0,0 +1,104 @@
+package server
+
+import "fmt"
+
+// Resource is the server side of a protocol object of a client.
+// Generated resource types embed BaseResource.
+type Resource interface {
+	Client() *Client
+	ID() uint32
+	Version() uint32
+	Interface() *Interface
+	// Dispatch decodes a request and calls the handler of the
+	// resource, fd is the fd passed along with it or -1.
+	Dispatch(opcode uint32, fd int, data []byte) error
+	Destroy() error
+	base() *BaseResource
+}
+
+type BaseResource struct {
+	// self is the generated resource embedding BaseResource
+	self      Resource
+	client    *Client
+	id        uint32
+	version   uint32
+	destroyed bool
+
+	destroyHandlers []func()
+}
+
+func (r *BaseResource) Client() *Client {
+	return r.client
+}
+
+func (r *BaseResource) ID() uint32 {
+	return r.id
+}
+
+// Version returns the interface version the resource was bound or
+// created with, resources created through a resource inherit its
+// version.
+func (r *BaseResource) Version() uint32 {
+	return r.version
+}
+
+func (r *BaseResource) base() *BaseResource {
+	return r
+}
+
+// PostError sends a fatal protocol error about the resource to its
+// client, see Client.PostError. Codes are the values of the error enum
+// of the interface, if any.
+func (r *BaseResource) PostError(code uint32, format string, args ...interface{}) {
+	r.client.PostError(r.self, code, format, args...)
+}
+
+// AddDestroyHandler registers f to be called when the resource is
+// destroyed.
+func (r *BaseResource) AddDestroyHandler(f func()) {
+	r.destroyHandlers = append(r.destroyHandlers, f)
+}
+
+// Destroy removes the resource from its client and calls the destroy
+// handlers. Ids allocated by the client are released with
+// wl_display.delete_id.
+func (r *BaseResource) Destroy() error {
+	if r.destroyed {
+		return nil
+	}
+	r.destroyed = true
+
+	err := r.client.unregister(r.id)
+
+	for i := len(r.destroyHandlers) - 1; i >= 0; i-- {
+		r.destroyHandlers[i]()
+	}
+	r.destroyHandlers = nil
+
+	return err
+}
+
+// Object returns the resource of c with the given id, which must be of
+// type T. The id 0 refers to no object, it is only valid if allowNull
+// is set.
+func Object[T Resource](c *Client, id uint32, allowNull bool) (T, error) {
+	var zero T
+
+	if id == 0 {
+		if allowNull {
+			return zero, nil
+		}
+		return zero, &ObjectError{ID: id, Reason: "null object"}
+	}
+
+	r := c.Resource(id)
+	if r == nil {
+		return zero, &ObjectError{ID: id, Reason: "unknown object"}
+	}
+	t, ok := r.(T)
+	if !ok {
+		return zero, &ObjectError{ID: id, Reason: fmt.Sprintf("unexpected interface %s", r.Interface().Name)}
+	}
+
+	return t, nil
+}
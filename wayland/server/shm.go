@@ -0,0 +1,251 @@
+package server
+
+import (
+	"errors"
+	"math"
+	"runtime/debug"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+var (
+	// ErrShmAccess is returned by ShmBuffer.Access when the memory of
+	// the buffer can't be read, because the client truncated the file
+	// backing its pool.
+	ErrShmAccess = errors.New("error accessing shm buffer")
+	// ErrBufferDestroyed is returned by ShmBuffer.Access once the
+	// wl_buffer is destroyed.
+	ErrBufferDestroyed = errors.New("buffer destroyed")
+)
+
+// InitShm creates the wl_shm global. Clients can create buffers of
+// argb8888, xrgb8888 and the given additional formats, their contents
+// are read with GetShmBuffer.
+func (s *Server) InitShm(formats ...ShmFormat) (*Global, error) {
+	h := shmHandler{formats: []ShmFormat{ShmFormatArgb8888, ShmFormatXrgb8888}}
+	for _, f := range formats {
+		if !h.supports(f) {
+			h.formats = append(h.formats, f)
+		}
+	}
+
+	return s.CreateGlobal(ShmInterface, ShmInterface.Version, func(r Resource) {
+		shm := r.(*Shm)
+		shm.SetHandler(h)
+		for _, f := range h.formats {
+			shm.SendFormat(f)
+		}
+	})
+}
+
+// shmHandler implements wl_shm
+type shmHandler struct {
+	formats []ShmFormat
+}
+
+func (h shmHandler) supports(format ShmFormat) bool {
+	for _, f := range h.formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+func (h shmHandler) CreatePool(r *Shm, id *ShmPool, fd int, size int32) {
+	if size <= 0 {
+		unix.Close(fd)
+		r.PostError(uint32(ShmErrorInvalidStride), "invalid size (%d)", size)
+		return
+	}
+
+	data, err := unix.Mmap(fd, 0, int(size), unix.PROT_READ, unix.MAP_SHARED)
+	if err != nil {
+		unix.Close(fd)
+		r.PostError(uint32(ShmErrorInvalidFd), "failed mmap fd %d: %v", fd, err)
+		return
+	}
+
+	p := &shmPool{shm: h, fd: fd, data: data, refs: 1}
+	id.SetHandler(p)
+	id.AddDestroyHandler(p.unref)
+}
+
+// shmPool implements wl_shm_pool. The fd is kept open to remap the pool
+// on resize, the mapping is shared by the pool and its buffers and
+// released with the last of them.
+type shmPool struct {
+	shm  shmHandler
+	fd   int
+	data []byte
+	refs int
+}
+
+func (p *shmPool) unref() {
+	p.refs--
+	if p.refs > 0 {
+		return
+	}
+	unix.Munmap(p.data)
+	unix.Close(p.fd)
+	p.data = nil
+}
+
+func (p *shmPool) Destroy(r *ShmPool) {}
+
+func (p *shmPool) Resize(r *ShmPool, size int32) {
+	if int(size) < len(p.data) {
+		r.PostError(uint32(ShmErrorInvalidStride), "shrinking pool invalid")
+		return
+	}
+
+	data, err := unix.Mmap(p.fd, 0, int(size), unix.PROT_READ, unix.MAP_SHARED)
+	if err != nil {
+		r.PostError(uint32(ShmErrorInvalidFd), "failed mmap fd %d: %v", p.fd, err)
+		return
+	}
+	unix.Munmap(p.data)
+	p.data = data
+}
+
+func (p *shmPool) CreateBuffer(r *ShmPool, id *Buffer, offset, width, height, stride int32, format ShmFormat) {
+	if !p.shm.supports(format) {
+		r.PostError(uint32(ShmErrorInvalidFormat), "invalid format 0x%x", uint32(format))
+		return
+	}
+
+	minStride := int64(width)
+	if bpp := shmBytesPerPixel(format); bpp != 0 {
+		minStride *= int64(bpp)
+	}
+	if offset < 0 || width <= 0 || height <= 0 || int64(stride) < minStride ||
+		int64(stride)*int64(height) > math.MaxInt32 ||
+		int64(offset)+int64(stride)*int64(height) > int64(len(p.data)) {
+		r.PostError(uint32(ShmErrorInvalidStride), "invalid width, height or stride (%dx%d, %d)", width, height, stride)
+		return
+	}
+
+	p.refs++
+	b := &ShmBuffer{
+		res:    id,
+		pool:   p,
+		offset: offset,
+		width:  width,
+		height: height,
+		stride: stride,
+		format: format,
+	}
+	id.SetHandler(b)
+	id.AddDestroyHandler(b.destroy)
+}
+
+// shmBytesPerPixel returns the size of a pixel of the packed formats,
+// 0 if it isn't known
+func shmBytesPerPixel(format ShmFormat) int {
+	switch format {
+	case ShmFormatArgb8888, ShmFormatXrgb8888, ShmFormatAbgr8888, ShmFormatXbgr8888,
+		ShmFormatRgba8888, ShmFormatRgbx8888, ShmFormatBgra8888, ShmFormatBgrx8888,
+		ShmFormatArgb2101010, ShmFormatXrgb2101010, ShmFormatAbgr2101010, ShmFormatXbgr2101010:
+		return 4
+	case ShmFormatRgb888, ShmFormatBgr888:
+		return 3
+	case ShmFormatRgb565, ShmFormatBgr565, ShmFormatArgb4444, ShmFormatXrgb4444,
+		ShmFormatArgb1555, ShmFormatXrgb1555:
+		return 2
+	case ShmFormatC8, ShmFormatR8:
+		return 1
+	}
+	return 0
+}
+
+// ShmBuffer is the handler of wl_buffer resources created from a
+// wl_shm_pool, it gives access to their contents.
+type ShmBuffer struct {
+	res  *Buffer
+	pool *shmPool
+
+	offset, width, height, stride int32
+	format                        ShmFormat
+}
+
+// GetShmBuffer returns the shm buffer of a wl_buffer, nil if it wasn't
+// created by the wl_shm global of InitShm.
+func GetShmBuffer(b *Buffer) *ShmBuffer {
+	sb, _ := b.Handler().(*ShmBuffer)
+	return sb
+}
+
+func (b *ShmBuffer) Destroy(r *Buffer) {}
+
+func (b *ShmBuffer) destroy() {
+	if b.pool != nil {
+		b.pool.unref()
+		b.pool = nil
+	}
+}
+
+// Buffer returns the wl_buffer resource.
+func (b *ShmBuffer) Buffer() *Buffer {
+	return b.res
+}
+
+func (b *ShmBuffer) Width() int32 {
+	return b.width
+}
+
+func (b *ShmBuffer) Height() int32 {
+	return b.height
+}
+
+// Stride returns the number of bytes between the start of two rows.
+func (b *ShmBuffer) Stride() int32 {
+	return b.stride
+}
+
+func (b *ShmBuffer) Format() ShmFormat {
+	return b.format
+}
+
+// Access calls f with the contents of the buffer, stride*height bytes
+// of shared memory the client may modify at any time. data must not be
+// retained after f returns, it is unmapped when the pool is resized or
+// the buffer destroyed, and must only be read by the goroutine calling
+// Access.
+//
+// Reading memory past the end of the file backing the pool, which the
+// client may have truncated, doesn't crash the server: f is aborted,
+// the client gets a wl_shm.invalid_fd error and ErrShmAccess is
+// returned.
+func (b *ShmBuffer) Access(f func(data []byte)) (err error) {
+	if b.pool == nil {
+		return ErrBufferDestroyed
+	}
+
+	data := b.pool.data[b.offset : int64(b.offset)+int64(b.stride)*int64(b.height)]
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if fault, ok := r.(interface{ Addr() uintptr }); ok && b.pool.contains(fault.Addr()) {
+			b.res.PostError(uint32(ShmErrorInvalidFd), "error accessing SHM buffer")
+			err = ErrShmAccess
+			return
+		}
+		panic(r)
+	}()
+
+	f(data)
+	return nil
+}
+
+// contains reports whether addr is in the mapping of the pool
+func (p *shmPool) contains(addr uintptr) bool {
+	if len(p.data) == 0 {
+		return false
+	}
+	start := uintptr(unsafe.Pointer(&p.data[0]))
+	return addr >= start && addr < start+uintptr(len(p.data))
+}
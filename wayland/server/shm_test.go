@@ -0,0 +1,208 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	testWidth  = 16
+	testHeight = 16
+	testStride = testWidth * 4
+	testSize   = testStride * testHeight
+)
+
+// shmClient is a client of the wl_shm global of InitShm with a pool of
+// testSize bytes backed by a memfd
+type shmClient struct {
+	s       *Server
+	client  *Client
+	display *client.Display
+	pool    *client.ShmPool
+	fd      int
+}
+
+func newShmClient(t *testing.T) *shmClient {
+	t.Helper()
+
+	s := New()
+	if _, err := s.InitShm(); err != nil {
+		t.Fatal(err)
+	}
+	clients := make(chan *Client, 1)
+	s.SetClientHandler(func(c *Client) { clients <- c })
+	display := startServer(t, s)
+
+	globals, err := client.NewGlobals(display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shm, err := client.BindGlobal[*client.Shm](globals, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fd, err := unix.MemfdCreate("shm-test", unix.MFD_CLOEXEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { unix.Close(fd) })
+	if err := unix.Ftruncate(fd, testSize); err != nil {
+		t.Fatal(err)
+	}
+	pool, err := shm.CreatePool(fd, testSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &shmClient{s: s, client: <-clients, display: display, pool: pool, fd: fd}
+}
+
+// access calls Access on the server side of buffer, after a roundtrip
+func (c *shmClient) access(t *testing.T, buffer *client.Buffer, f func(data []byte)) error {
+	t.Helper()
+
+	if err := c.display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	var err error
+	c.s.Do(func() {
+		res, ok := c.client.Resource(buffer.ID()).(*Buffer)
+		if !ok {
+			t.Fatalf("no wl_buffer@%d", buffer.ID())
+		}
+		b := GetShmBuffer(res)
+		if b == nil {
+			t.Fatal("not a shm buffer")
+		}
+		err = b.Access(f)
+	})
+	return err
+}
+
+func TestShmAccess(t *testing.T) {
+	c := newShmClient(t)
+
+	contents := make([]byte, testSize)
+	for i := range contents {
+		contents[i] = byte(i)
+	}
+	if _, err := unix.Pwrite(c.fd, contents, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// The second half of the pool
+	buffer, err := c.pool.CreateBuffer(testSize/2, testWidth, testHeight/2, testStride, client.ShmFormatXrgb8888)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []byte
+	if err := c.access(t, buffer, func(data []byte) {
+		got = append(got, data...)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, contents[testSize/2:]) {
+		t.Error("buffer contents differ from the pool")
+	}
+}
+
+// TestShmAccessTruncated truncates the file of the pool after creating a
+// buffer, reading it would crash the server with SIGBUS without the
+// guard of Access.
+func TestShmAccessTruncated(t *testing.T) {
+	c := newShmClient(t)
+
+	buffer, err := c.pool.CreateBuffer(0, testWidth, testHeight, testStride, client.ShmFormatArgb8888)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.Ftruncate(c.fd, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	var sum byte
+	if err := c.access(t, buffer, func(data []byte) {
+		for _, b := range data {
+			sum += b
+		}
+	}); !errors.Is(err, ErrShmAccess) {
+		t.Fatalf("got error %v, want ErrShmAccess", err)
+	}
+
+	// The server disconnects the client, the error is already sent
+	for err == nil {
+		err = c.display.Context().Dispatch()
+	}
+	var protocolErr *client.ProtocolError
+	if !errors.As(err, &protocolErr) || protocolErr.Code != uint32(ShmErrorInvalidFd) {
+		t.Errorf("got error %v, want invalid_fd", err)
+	}
+}
+
+func TestShmAccessDestroyed(t *testing.T) {
+	c := newShmClient(t)
+
+	buffer, err := c.pool.CreateBuffer(0, testWidth, testHeight, testStride, client.ShmFormatArgb8888)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	var b *ShmBuffer
+	c.s.Do(func() {
+		b = GetShmBuffer(c.client.Resource(buffer.ID()).(*Buffer))
+	})
+	if err := buffer.Destroy(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.display.Roundtrip(); err != nil {
+		t.Fatal(err)
+	}
+
+	var accessErr error
+	c.s.Do(func() {
+		accessErr = b.Access(func(data []byte) {
+			t.Error("destroyed buffer accessed")
+		})
+	})
+	if !errors.Is(accessErr, ErrBufferDestroyed) {
+		t.Errorf("got error %v, want ErrBufferDestroyed", accessErr)
+	}
+}
+
+func TestShmCreateBufferInvalid(t *testing.T) {
+	for _, tt := range []struct {
+		name                          string
+		offset, width, height, stride int32
+		format                        client.ShmFormat
+		code                          ShmError
+	}{
+		{"negative offset", -4, testWidth, testHeight, testStride, client.ShmFormatArgb8888, ShmErrorInvalidStride},
+		{"offset past the pool", testStride, testWidth, testHeight, testStride, client.ShmFormatArgb8888, ShmErrorInvalidStride},
+		{"zero width", 0, 0, testHeight, testStride, client.ShmFormatArgb8888, ShmErrorInvalidStride},
+		{"zero height", 0, testWidth, 0, testStride, client.ShmFormatArgb8888, ShmErrorInvalidStride},
+		{"stride below width", 0, testWidth, testHeight, testStride - 4, client.ShmFormatArgb8888, ShmErrorInvalidStride},
+		{"unsupported format", 0, testWidth, testHeight, testStride, client.ShmFormatNv12, ShmErrorInvalidFormat},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newShmClient(t)
+
+			if _, err := c.pool.CreateBuffer(tt.offset, tt.width, tt.height, tt.stride, tt.format); err != nil {
+				t.Fatal(err)
+			}
+			var protocolErr *client.ProtocolError
+			err := c.display.Roundtrip()
+			if !errors.As(err, &protocolErr) || protocolErr.Code != uint32(tt.code) || protocolErr.Interface != "wl_shm_pool" {
+				t.Errorf("got error %v, want wl_shm_pool error %d", err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+// ErrSocketInUse is returned by Listen when another server holds the
+// lock of the display socket.
+var ErrSocketInUse = errors.New("socket in use")
+
+// ErrNoRuntimeDir is returned by Listen for display names relative to
+// XDG_RUNTIME_DIR when it isn't set.
+var ErrNoRuntimeDir = errors.New("env XDG_RUNTIME_DIR not set")
+
+// maxAutoDisplays is the number of display names ListenAuto tries,
+// wayland-1 to wayland-32 as libwayland does
+const maxAutoDisplays = 32
+
+// Socket is a display socket clients connect to, guarded by a lock file
+// next to it so that two servers don't use the same display name.
+type Socket struct {
+	listener *net.UnixListener
+	lock     *os.File
+	name     string
+	path     string
+}
+
+// Listen creates the display socket with the given name. Relative names
+// are created in XDG_RUNTIME_DIR, an empty name stands for
+// WAYLAND_DISPLAY or wayland-0 if it is not set, as in client.Connect.
+//
+// A stale socket left by a crashed server is replaced, ErrSocketInUse
+// is returned if a running server holds the name.
+func Listen(name string) (*Socket, error) {
+	if name == "" {
+		name = os.Getenv("WAYLAND_DISPLAY")
+	}
+	if name == "" {
+		name = "wayland-0"
+	}
+
+	path := name
+	if !filepath.IsAbs(path) {
+		runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+		if runtimeDir == "" {
+			return nil, ErrNoRuntimeDir
+		}
+		path = filepath.Join(runtimeDir, name)
+	}
+	// sun_path holds 108 bytes including the terminating null
+	if len(path) >= 108 {
+		return nil, fmt.Errorf("socket path %s is too long", path)
+	}
+
+	lockPath := path + ".lock"
+	lock, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, 0o660)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open lock file: %w", err)
+	}
+	if err := unix.Flock(int(lock.Fd()), unix.LOCK_EX|unix.LOCK_NB); err != nil {
+		lock.Close()
+		return nil, fmt.Errorf("unable to lock %s: %w", lockPath, ErrSocketInUse)
+	}
+
+	// The lock is ours, a socket file left behind is stale
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		os.Remove(path)
+	}
+
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		lock.Close()
+		os.Remove(lockPath)
+		return nil, fmt.Errorf("unable to listen: %w", err)
+	}
+
+	return &Socket{
+		listener: listener,
+		lock:     lock,
+		name:     name,
+		path:     path,
+	}, nil
+}
+
+// ListenAuto creates a display socket in XDG_RUNTIME_DIR with the first
+// free name of wayland-1 to wayland-32.
+func ListenAuto() (*Socket, error) {
+	for i := 1; i <= maxAutoDisplays; i++ {
+		s, err := Listen(fmt.Sprintf("wayland-%d", i))
+		if errors.Is(err, ErrSocketInUse) {
+			continue
+		}
+		return s, err
+	}
+
+	return nil, fmt.Errorf("no free display name: %w", ErrSocketInUse)
+}
+
+// Name returns the display name, the value of WAYLAND_DISPLAY for
+// clients to connect to the socket.
+func (s *Socket) Name() string {
+	return s.name
+}
+
+// Path returns the path of the socket file.
+func (s *Socket) Path() string {
+	return s.path
+}
+
+// Accept waits for the next client connection.
+func (s *Socket) Accept() (*net.UnixConn, error) {
+	return s.listener.AcceptUnix()
+}
+
+// Close stops listening, the socket and lock files are removed.
+func (s *Socket) Close() error {
+	err := s.listener.Close()
+	os.Remove(s.path + ".lock")
+	s.lock.Close()
+
+	return err
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListenLock(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+
+	sock, err := Listen("wayland-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Listen("wayland-test"); !errors.Is(err, ErrSocketInUse) {
+		t.Errorf("got error %v listening twice, want ErrSocketInUse", err)
+	}
+	// The socket of the running server is left alone
+	if _, err := os.Stat(sock.Path()); err != nil {
+		t.Errorf("socket removed: %v", err)
+	}
+
+	sock.Close()
+	if _, err := os.Stat(sock.Path() + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("lock file left after close: %v", err)
+	}
+	sock, err = Listen("wayland-test")
+	if err != nil {
+		t.Fatalf("listening after close: %v", err)
+	}
+	sock.Close()
+}
+
+func TestListenStaleSocket(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", dir)
+
+	// A crashed server leaves its socket file without a lock
+	path := filepath.Join(dir, "wayland-test")
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.SetUnlinkOnClose(false)
+	l.Close()
+
+	sock, err := Listen("wayland-test")
+	if err != nil {
+		t.Fatalf("stale socket not replaced: %v", err)
+	}
+	defer sock.Close()
+
+	conn, err := net.DialUnix("unix", nil, &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+}
+
+func TestListenAuto(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", t.TempDir())
+
+	first, err := ListenAuto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+	second, err := ListenAuto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+
+	if first.Name() != "wayland-1" || second.Name() != "wayland-2" {
+		t.Errorf("got displays %s and %s, want wayland-1 and wayland-2", first.Name(), second.Name())
+	}
+}
+
+func TestListenNoRuntimeDir(t *testing.T) {
+	t.Setenv("XDG_RUNTIME_DIR", "")
+
+	if _, err := Listen("wayland-test"); !errors.Is(err, ErrNoRuntimeDir) {
+		t.Errorf("got error %v, want ErrNoRuntimeDir", err)
+	}
+}
@@ -0,0 +1,4 @@
+// Package xdg_shell is Go binding of the server side of the stable
+// xdg-shell protocol, for compositors implementing desktop-style
+// windows and popups.
+package xdg_shell
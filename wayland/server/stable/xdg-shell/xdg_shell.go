@@ -0,0 +1,2825 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : stable/xdg-shell/xdg-shell.xml
+// XML sha256 : 2cf6d607b5a4408db95f456e4e0c8416e2989c1218af5db8dd2251db2492294d
+//
+// xdg_shell Protocol Copyright:
+//
+// Copyright © 2008-2013 Kristian Høgsberg
+// Copyright © 2013      Rafael Antognolli
+// Copyright © 2013      Jasper St. Pierre
+// Copyright © 2010-2013 Intel Corporation
+// Copyright © 2015-2017 Samsung Electronics Co., Ltd
+// Copyright © 2015-2017 Red Hat Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a
+// copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice (including the next
+// paragraph) shall be included in all copies or substantial portions of the
+// Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.  IN NO EVENT SHALL
+// THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package xdg_shell
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hempflower/go-wayland/wayland/server"
+)
+
+// WmBaseName : create desktop-style surfaces
+const WmBaseName = "xdg_wm_base"
+
+// WmBaseInterface : metadata of the xdg_wm_base interface
+var WmBaseInterface = &server.Interface{
+	Name:    WmBaseName,
+	Version: 6,
+	New:     func(c *server.Client, id, version uint32) server.Resource { return NewWmBase(c, id, version) },
+	Requests: []server.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "create_positioner",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "id", Type: server.ArgTypeNewID, Interface: "xdg_positioner"},
+			},
+		},
+		{
+			Name:  "get_xdg_surface",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "id", Type: server.ArgTypeNewID, Interface: "xdg_surface"},
+				{Name: "surface", Type: server.ArgTypeObject, Interface: "wl_surface"},
+			},
+		},
+		{
+			Name:  "pong",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "serial", Type: server.ArgTypeUint},
+			},
+		},
+	},
+	Events: []server.Message{
+		{
+			Name:  "ping",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "serial", Type: server.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns WmBaseInterface
+func (r *WmBase) Interface() *server.Interface {
+	return WmBaseInterface
+}
+
+// WmBase : create desktop-style surfaces
+//
+// The xdg_wm_base interface is exposed as a global object enabling clients
+// to turn their wl_surfaces into windows in a desktop environment. It
+// defines the basic functionality needed for clients and the compositor to
+// create windows that can be dragged, resized, maximized, etc, as well as
+// creating transient windows such as popup menus.
+type WmBase struct {
+	server.BaseResource
+	handler WmBaseHandler
+}
+
+// WmBaseHandler : handles the requests of WmBase
+type WmBaseHandler interface {
+	// Destroy : destroy xdg_wm_base
+	//
+	// Destroy this xdg_wm_base object.
+	//
+	// Destroying a bound xdg_wm_base object while there are surfaces
+	// still alive created by this xdg_wm_base object instance is illegal
+	// and will result in a defunct_surfaces error.
+	//
+	Destroy(r *WmBase)
+	// CreatePositioner : create a positioner object
+	//
+	// Create a positioner object. A positioner object is used to position
+	// surfaces relative to some parent surface. See the interface description
+	// and xdg_surface.get_popup for details.
+	//
+	CreatePositioner(r *WmBase, id *Positioner)
+	// GetXdgSurface : create a shell surface from a surface
+	//
+	// This creates an xdg_surface for the given surface. While xdg_surface
+	// itself is not a role, the corresponding surface may only be assigned
+	// a role extending xdg_surface, such as xdg_toplevel or xdg_popup. It is
+	// illegal to create an xdg_surface for a wl_surface which already has an
+	// assigned role and this will result in a role error.
+	//
+	// This creates an xdg_surface for the given surface. An xdg_surface is
+	// used as basis to define a role to a given surface, such as xdg_toplevel
+	// or xdg_popup. It also manages functionality shared between xdg_surface
+	// based surface roles.
+	//
+	// See the documentation of xdg_surface for more details about what an
+	// xdg_surface is and how it is used.
+	//
+	GetXdgSurface(r *WmBase, id *Surface, surface *server.Surface)
+	// Pong : respond to a ping event
+	//
+	// A client must respond to a ping event with a pong request or
+	// the client may be deemed unresponsive. See xdg_wm_base.ping
+	// and xdg_wm_base.error.unresponsive.
+	//
+	//  serial: serial of the ping event
+	Pong(r *WmBase, serial uint32)
+}
+
+// NewWmBase : creates the WmBase resource of c with the given id
+// and version, id 0 allocates a server side id
+func NewWmBase(c *server.Client, id, version uint32) *WmBase {
+	r := &WmBase{}
+	c.Register(r, id, version)
+	return r
+}
+
+// SetHandler : sets the handler of the requests of WmBase
+func (r *WmBase) SetHandler(h WmBaseHandler) {
+	r.handler = h
+}
+
+// Handler : returns the handler of the requests of WmBase
+func (r *WmBase) Handler() WmBaseHandler {
+	return r.handler
+}
+
+type WmBaseError uint32
+
+// WmBaseError :
+const (
+	// WmBaseErrorRole : given wl_surface has another role
+	WmBaseErrorRole WmBaseError = 0
+	// WmBaseErrorDefunctSurfaces : xdg_wm_base was destroyed before children
+	WmBaseErrorDefunctSurfaces WmBaseError = 1
+	// WmBaseErrorNotTheTopmostPopup : the client tried to map or destroy a non-topmost popup
+	WmBaseErrorNotTheTopmostPopup WmBaseError = 2
+	// WmBaseErrorInvalidPopupParent : the client specified an invalid popup parent surface
+	WmBaseErrorInvalidPopupParent WmBaseError = 3
+	// WmBaseErrorInvalidSurfaceState : the client provided an invalid surface state
+	WmBaseErrorInvalidSurfaceState WmBaseError = 4
+	// WmBaseErrorInvalidPositioner : the client provided an invalid positioner
+	WmBaseErrorInvalidPositioner WmBaseError = 5
+	// WmBaseErrorUnresponsive : the client didn’t respond to a ping event in time
+	WmBaseErrorUnresponsive WmBaseError = 6
+)
+
+func (e WmBaseError) Name() string {
+	switch e {
+	case WmBaseErrorRole:
+		return "role"
+	case WmBaseErrorDefunctSurfaces:
+		return "defunct_surfaces"
+	case WmBaseErrorNotTheTopmostPopup:
+		return "not_the_topmost_popup"
+	case WmBaseErrorInvalidPopupParent:
+		return "invalid_popup_parent"
+	case WmBaseErrorInvalidSurfaceState:
+		return "invalid_surface_state"
+	case WmBaseErrorInvalidPositioner:
+		return "invalid_positioner"
+	case WmBaseErrorUnresponsive:
+		return "unresponsive"
+	default:
+		return ""
+	}
+}
+
+func (e WmBaseError) Value() string {
+	switch e {
+	case WmBaseErrorRole:
+		return "0"
+	case WmBaseErrorDefunctSurfaces:
+		return "1"
+	case WmBaseErrorNotTheTopmostPopup:
+		return "2"
+	case WmBaseErrorInvalidPopupParent:
+		return "3"
+	case WmBaseErrorInvalidSurfaceState:
+		return "4"
+	case WmBaseErrorInvalidPositioner:
+		return "5"
+	case WmBaseErrorUnresponsive:
+		return "6"
+	default:
+		return ""
+	}
+}
+
+func (e WmBaseError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// WmBasePingSinceVersion : version of WmBase that introduced Ping
+const WmBasePingSinceVersion = 1
+
+// SendPing : check if the client is alive
+//
+// The ping event asks the client if it's still alive. Pass the
+// serial specified in the event back to the compositor by sending
+// a "pong" request back with the specified serial. See xdg_wm_base.pong.
+//
+// Compositors can use this to determine if the client is still
+// alive. It's unspecified what will happen if the client doesn't
+// respond to the ping request, or in what timeframe. Clients should
+// try to respond in a reasonable amount of time. The “unresponsive”
+// error is provided for compositors that wish to disconnect unresponsive
+// clients.
+//
+// A compositor is free to ping in any way it wants, but a client must
+// always respond to any xdg_wm_base object it created.
+//
+//	serial: pass this to the pong request
+func (r *WmBase) SendPing(serial uint32) error {
+	const opcode = 0
+	const _evBufLen = 8 + 4
+	var _evBuf [_evBufLen]byte
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(serial))
+	l += 4
+	err := r.Client().WriteMsg(_evBuf[:], nil)
+	return err
+}
+
+// Dispatch : decodes the request with the given opcode and calls the
+// handler, resources of destructor requests are destroyed afterwards
+func (r *WmBase) Dispatch(opcode uint32, fd int, data []byte) error {
+	switch opcode {
+	case 0:
+		if r.handler == nil {
+			return r.Destroy()
+		}
+		r.handler.Destroy(r)
+		return r.Destroy()
+	case 1:
+		l := 0
+		id := NewPositioner(r.Client(), server.Uint32(data[l:l+4]), r.Version())
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.CreatePositioner(r, id)
+		return nil
+	case 2:
+		l := 0
+		id := NewSurface(r.Client(), server.Uint32(data[l:l+4]), r.Version())
+		l += 4
+		surface, err := server.Object[*server.Surface](r.Client(), server.Uint32(data[l:l+4]), false)
+		if err != nil {
+			return err
+		}
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.GetXdgSurface(r, id, surface)
+		return nil
+	case 3:
+		l := 0
+		serial := server.Uint32(data[l : l+4])
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.Pong(r, serial)
+		return nil
+	default:
+		return &server.OpcodeError{Interface: WmBaseName, Opcode: opcode}
+	}
+}
+
+// PositionerName : child surface positioner
+const PositionerName = "xdg_positioner"
+
+// PositionerInterface : metadata of the xdg_positioner interface
+var PositionerInterface = &server.Interface{
+	Name:    PositionerName,
+	Version: 6,
+	New: func(c *server.Client, id, version uint32) server.Resource {
+		return NewPositioner(c, id, version)
+	},
+	Requests: []server.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "set_size",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "width", Type: server.ArgTypeInt},
+				{Name: "height", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_anchor_rect",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "x", Type: server.ArgTypeInt},
+				{Name: "y", Type: server.ArgTypeInt},
+				{Name: "width", Type: server.ArgTypeInt},
+				{Name: "height", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_anchor",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "anchor", Type: server.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "set_gravity",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "gravity", Type: server.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "set_constraint_adjustment",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "constraint_adjustment", Type: server.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "set_offset",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "x", Type: server.ArgTypeInt},
+				{Name: "y", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_reactive",
+			Since: 3,
+		},
+		{
+			Name:  "set_parent_size",
+			Since: 3,
+			Args: []server.Arg{
+				{Name: "parent_width", Type: server.ArgTypeInt},
+				{Name: "parent_height", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_parent_configure",
+			Since: 3,
+			Args: []server.Arg{
+				{Name: "serial", Type: server.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns PositionerInterface
+func (r *Positioner) Interface() *server.Interface {
+	return PositionerInterface
+}
+
+// Positioner : child surface positioner
+//
+// The xdg_positioner provides a collection of rules for the placement of a
+// child surface relative to a parent surface. Rules can be defined to ensure
+// the child surface remains within the visible area's borders, and to
+// specify how the child surface changes its position, such as sliding along
+// an axis, or flipping around a rectangle. These positioner-created rules are
+// constrained by the requirement that a child surface must intersect with or
+// be at least partially adjacent to its parent surface.
+//
+// See the various requests for details about possible rules.
+//
+// At the time of the request, the compositor makes a copy of the rules
+// specified by the xdg_positioner. Thus, after the request is complete the
+// xdg_positioner object can be destroyed or reused; further changes to the
+// object will have no effect on previous usages.
+//
+// For an xdg_positioner object to be considered complete, it must have a
+// non-zero size set by set_size, and a non-zero anchor rectangle set by
+// set_anchor_rect. Passing an incomplete xdg_positioner object when
+// positioning a surface raises an invalid_positioner error.
+type Positioner struct {
+	server.BaseResource
+	handler PositionerHandler
+}
+
+// PositionerHandler : handles the requests of Positioner
+type PositionerHandler interface {
+	// Destroy : destroy the xdg_positioner object
+	//
+	// Notify the compositor that the xdg_positioner will no longer be used.
+	//
+	Destroy(r *Positioner)
+	// SetSize : set the size of the to-be positioned rectangle
+	//
+	// Set the size of the surface that is to be positioned with the positioner
+	// object. The size is in surface-local coordinates and corresponds to the
+	// window geometry. See xdg_surface.set_window_geometry.
+	//
+	// If a zero or negative size is set the invalid_input error is raised.
+	//
+	//  width: width of positioned rectangle
+	//  height: height of positioned rectangle
+	SetSize(r *Positioner, width, height int32)
+	// SetAnchorRect : set the anchor rectangle within the parent surface
+	//
+	// Specify the anchor rectangle within the parent surface that the child
+	// surface will be placed relative to. The rectangle is relative to the
+	// window geometry as defined by xdg_surface.set_window_geometry of the
+	// parent surface.
+	//
+	// When the xdg_positioner object is used to position a child surface, the
+	// anchor rectangle may not extend outside the window geometry of the
+	// positioned child's parent surface.
+	//
+	// If a negative size is set the invalid_input error is raised.
+	//
+	//  x: x position of anchor rectangle
+	//  y: y position of anchor rectangle
+	//  width: width of anchor rectangle
+	//  height: height of anchor rectangle
+	SetAnchorRect(r *Positioner, x, y, width, height int32)
+	// SetAnchor : set anchor rectangle anchor
+	//
+	// Defines the anchor point for the anchor rectangle. The specified anchor
+	// is used derive an anchor point that the child surface will be
+	// positioned relative to. If a corner anchor is set (e.g. 'top_left' or
+	// 'bottom_right'), the anchor point will be at the specified corner;
+	// otherwise, the derived anchor point will be centered on the specified
+	// edge, or in the center of the anchor rectangle if no edge is specified.
+	//
+	//  anchor: anchor
+	SetAnchor(r *Positioner, anchor PositionerAnchor)
+	// SetGravity : set child surface gravity
+	//
+	// Defines in what direction a surface should be positioned, relative to
+	// the anchor point of the parent surface. If a corner gravity is
+	// specified (e.g. 'bottom_right' or 'top_left'), then the child surface
+	// will be placed towards the specified gravity; otherwise, the child
+	// surface will be centered over the anchor point on any axis that had no
+	// gravity specified. If the gravity is not in the ‘gravity’ enum, an
+	// invalid_input error is raised.
+	//
+	//  gravity: gravity direction
+	SetGravity(r *Positioner, gravity PositionerGravity)
+	// SetConstraintAdjustment : set the adjustment to be done when constrained
+	//
+	// Specify how the window should be positioned if the originally intended
+	// position caused the surface to be constrained, meaning at least
+	// partially outside positioning boundaries set by the compositor. The
+	// adjustment is set by constructing a bitmask describing the adjustment to
+	// be made when the surface is constrained on that axis.
+	//
+	// If no bit for one axis is set, the compositor will assume that the child
+	// surface should not change its position on that axis when constrained.
+	//
+	// If more than one bit for one axis is set, the order of how adjustments
+	// are applied is specified in the corresponding adjustment descriptions.
+	//
+	// The default adjustment is none.
+	//
+	//  constraintAdjustment: bit mask of constraint adjustments
+	SetConstraintAdjustment(r *Positioner, constraintAdjustment PositionerConstraintAdjustment)
+	// SetOffset : set surface position offset
+	//
+	// Specify the surface position offset relative to the position of the
+	// anchor on the anchor rectangle and the anchor on the surface. For
+	// example if the anchor of the anchor rectangle is at (x, y), the surface
+	// has the gravity bottom|right, and the offset is (ox, oy), the calculated
+	// surface position will be (x + ox, y + oy). The offset position of the
+	// surface is the one used for constraint testing. See
+	// set_constraint_adjustment.
+	//
+	//  x: surface position x offset
+	//  y: surface position y offset
+	SetOffset(r *Positioner, x, y int32)
+	// SetReactive : continuously reconstrain the surface
+	//
+	// When set reactive, the surface is reconstrained if the conditions used
+	// for constraining changed, e.g. the parent window moved.
+	//
+	// If the conditions changed and the popup was reconstrained, an
+	// xdg_popup.configure event is sent with updated geometry, followed by an
+	// xdg_surface.configure event.
+	//
+	SetReactive(r *Positioner)
+	// SetParentSize :
+	//
+	// Set the parent window geometry the compositor should use when
+	// positioning the popup. The compositor may use this information to
+	// determine the future state the popup should be constrained using. If
+	// this doesn't match the dimension of the parent the popup is eventually
+	// positioned against, the behavior is undefined.
+	//
+	// The arguments are given in the surface-local coordinate space.
+	//
+	//  parentWidth: future window geometry width of parent
+	//  parentHeight: future window geometry height of parent
+	SetParentSize(r *Positioner, parentWidth, parentHeight int32)
+	// SetParentConfigure : set parent configure this is a response to
+	//
+	// Set the serial of an xdg_surface.configure event this positioner will be
+	// used in response to. The compositor may use this information together
+	// with set_parent_size to determine what future state the popup should be
+	// constrained using.
+	//
+	//  serial: serial of parent configure event
+	SetParentConfigure(r *Positioner, serial uint32)
+}
+
+// NewPositioner : creates the Positioner resource of c with the given id
+// and version, id 0 allocates a server side id
+func NewPositioner(c *server.Client, id, version uint32) *Positioner {
+	r := &Positioner{}
+	c.Register(r, id, version)
+	return r
+}
+
+// SetHandler : sets the handler of the requests of Positioner
+func (r *Positioner) SetHandler(h PositionerHandler) {
+	r.handler = h
+}
+
+// Handler : returns the handler of the requests of Positioner
+func (r *Positioner) Handler() PositionerHandler {
+	return r.handler
+}
+
+type PositionerError uint32
+
+// PositionerError :
+const (
+	// PositionerErrorInvalidInput : invalid input provided
+	PositionerErrorInvalidInput PositionerError = 0
+)
+
+func (e PositionerError) Name() string {
+	switch e {
+	case PositionerErrorInvalidInput:
+		return "invalid_input"
+	default:
+		return ""
+	}
+}
+
+func (e PositionerError) Value() string {
+	switch e {
+	case PositionerErrorInvalidInput:
+		return "0"
+	default:
+		return ""
+	}
+}
+
+func (e PositionerError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type PositionerAnchor uint32
+
+// PositionerAnchor :
+const (
+	PositionerAnchorNone        PositionerAnchor = 0
+	PositionerAnchorTop         PositionerAnchor = 1
+	PositionerAnchorBottom      PositionerAnchor = 2
+	PositionerAnchorLeft        PositionerAnchor = 3
+	PositionerAnchorRight       PositionerAnchor = 4
+	PositionerAnchorTopLeft     PositionerAnchor = 5
+	PositionerAnchorBottomLeft  PositionerAnchor = 6
+	PositionerAnchorTopRight    PositionerAnchor = 7
+	PositionerAnchorBottomRight PositionerAnchor = 8
+)
+
+func (e PositionerAnchor) Name() string {
+	switch e {
+	case PositionerAnchorNone:
+		return "none"
+	case PositionerAnchorTop:
+		return "top"
+	case PositionerAnchorBottom:
+		return "bottom"
+	case PositionerAnchorLeft:
+		return "left"
+	case PositionerAnchorRight:
+		return "right"
+	case PositionerAnchorTopLeft:
+		return "top_left"
+	case PositionerAnchorBottomLeft:
+		return "bottom_left"
+	case PositionerAnchorTopRight:
+		return "top_right"
+	case PositionerAnchorBottomRight:
+		return "bottom_right"
+	default:
+		return ""
+	}
+}
+
+func (e PositionerAnchor) Value() string {
+	switch e {
+	case PositionerAnchorNone:
+		return "0"
+	case PositionerAnchorTop:
+		return "1"
+	case PositionerAnchorBottom:
+		return "2"
+	case PositionerAnchorLeft:
+		return "3"
+	case PositionerAnchorRight:
+		return "4"
+	case PositionerAnchorTopLeft:
+		return "5"
+	case PositionerAnchorBottomLeft:
+		return "6"
+	case PositionerAnchorTopRight:
+		return "7"
+	case PositionerAnchorBottomRight:
+		return "8"
+	default:
+		return ""
+	}
+}
+
+func (e PositionerAnchor) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type PositionerGravity uint32
+
+// PositionerGravity :
+const (
+	PositionerGravityNone        PositionerGravity = 0
+	PositionerGravityTop         PositionerGravity = 1
+	PositionerGravityBottom      PositionerGravity = 2
+	PositionerGravityLeft        PositionerGravity = 3
+	PositionerGravityRight       PositionerGravity = 4
+	PositionerGravityTopLeft     PositionerGravity = 5
+	PositionerGravityBottomLeft  PositionerGravity = 6
+	PositionerGravityTopRight    PositionerGravity = 7
+	PositionerGravityBottomRight PositionerGravity = 8
+)
+
+func (e PositionerGravity) Name() string {
+	switch e {
+	case PositionerGravityNone:
+		return "none"
+	case PositionerGravityTop:
+		return "top"
+	case PositionerGravityBottom:
+		return "bottom"
+	case PositionerGravityLeft:
+		return "left"
+	case PositionerGravityRight:
+		return "right"
+	case PositionerGravityTopLeft:
+		return "top_left"
+	case PositionerGravityBottomLeft:
+		return "bottom_left"
+	case PositionerGravityTopRight:
+		return "top_right"
+	case PositionerGravityBottomRight:
+		return "bottom_right"
+	default:
+		return ""
+	}
+}
+
+func (e PositionerGravity) Value() string {
+	switch e {
+	case PositionerGravityNone:
+		return "0"
+	case PositionerGravityTop:
+		return "1"
+	case PositionerGravityBottom:
+		return "2"
+	case PositionerGravityLeft:
+		return "3"
+	case PositionerGravityRight:
+		return "4"
+	case PositionerGravityTopLeft:
+		return "5"
+	case PositionerGravityBottomLeft:
+		return "6"
+	case PositionerGravityTopRight:
+		return "7"
+	case PositionerGravityBottomRight:
+		return "8"
+	default:
+		return ""
+	}
+}
+
+func (e PositionerGravity) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type PositionerConstraintAdjustment uint32
+
+// PositionerConstraintAdjustment : constraint adjustments
+//
+// The constraint adjustment value define ways the compositor will adjust
+// the position of the surface, if the unadjusted position would result
+// in the surface being partly constrained.
+//
+// Whether a surface is considered 'constrained' is left to the compositor
+// to determine. For example, the surface may be partly outside the
+// compositor's defined 'work area', thus necessitating the child surface's
+// position be adjusted until it is entirely inside the work area.
+//
+// The adjustments can be combined, according to a defined precedence: 1)
+// Flip, 2) Slide, 3) Resize.
+const (
+	PositionerConstraintAdjustmentNone    PositionerConstraintAdjustment = 0
+	PositionerConstraintAdjustmentSlideX  PositionerConstraintAdjustment = 1
+	PositionerConstraintAdjustmentSlideY  PositionerConstraintAdjustment = 2
+	PositionerConstraintAdjustmentFlipX   PositionerConstraintAdjustment = 4
+	PositionerConstraintAdjustmentFlipY   PositionerConstraintAdjustment = 8
+	PositionerConstraintAdjustmentResizeX PositionerConstraintAdjustment = 16
+	PositionerConstraintAdjustmentResizeY PositionerConstraintAdjustment = 32
+)
+
+func (e PositionerConstraintAdjustment) Name() string {
+	switch e {
+	case PositionerConstraintAdjustmentNone:
+		return "none"
+	case PositionerConstraintAdjustmentSlideX:
+		return "slide_x"
+	case PositionerConstraintAdjustmentSlideY:
+		return "slide_y"
+	case PositionerConstraintAdjustmentFlipX:
+		return "flip_x"
+	case PositionerConstraintAdjustmentFlipY:
+		return "flip_y"
+	case PositionerConstraintAdjustmentResizeX:
+		return "resize_x"
+	case PositionerConstraintAdjustmentResizeY:
+		return "resize_y"
+	default:
+		return ""
+	}
+}
+
+func (e PositionerConstraintAdjustment) Value() string {
+	switch e {
+	case PositionerConstraintAdjustmentNone:
+		return "0"
+	case PositionerConstraintAdjustmentSlideX:
+		return "1"
+	case PositionerConstraintAdjustmentSlideY:
+		return "2"
+	case PositionerConstraintAdjustmentFlipX:
+		return "4"
+	case PositionerConstraintAdjustmentFlipY:
+		return "8"
+	case PositionerConstraintAdjustmentResizeX:
+		return "16"
+	case PositionerConstraintAdjustmentResizeY:
+		return "32"
+	default:
+		return ""
+	}
+}
+
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e PositionerConstraintAdjustment) Has(f PositionerConstraintAdjustment) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e PositionerConstraintAdjustment) Set(f PositionerConstraintAdjustment) PositionerConstraintAdjustment {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e PositionerConstraintAdjustment) Clear(f PositionerConstraintAdjustment) PositionerConstraintAdjustment {
+	return e &^ f
+}
+
+func (e PositionerConstraintAdjustment) String() string {
+	if e == 0 {
+		return "none"
+	}
+	var flags []string
+	if e.Has(PositionerConstraintAdjustmentSlideX) {
+		flags = append(flags, "slide_x")
+		e = e.Clear(PositionerConstraintAdjustmentSlideX)
+	}
+	if e.Has(PositionerConstraintAdjustmentSlideY) {
+		flags = append(flags, "slide_y")
+		e = e.Clear(PositionerConstraintAdjustmentSlideY)
+	}
+	if e.Has(PositionerConstraintAdjustmentFlipX) {
+		flags = append(flags, "flip_x")
+		e = e.Clear(PositionerConstraintAdjustmentFlipX)
+	}
+	if e.Has(PositionerConstraintAdjustmentFlipY) {
+		flags = append(flags, "flip_y")
+		e = e.Clear(PositionerConstraintAdjustmentFlipY)
+	}
+	if e.Has(PositionerConstraintAdjustmentResizeX) {
+		flags = append(flags, "resize_x")
+		e = e.Clear(PositionerConstraintAdjustmentResizeX)
+	}
+	if e.Has(PositionerConstraintAdjustmentResizeY) {
+		flags = append(flags, "resize_y")
+		e = e.Clear(PositionerConstraintAdjustmentResizeY)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
+}
+
+// Dispatch : decodes the request with the given opcode and calls the
+// handler, resources of destructor requests are destroyed afterwards
+func (r *Positioner) Dispatch(opcode uint32, fd int, data []byte) error {
+	switch opcode {
+	case 0:
+		if r.handler == nil {
+			return r.Destroy()
+		}
+		r.handler.Destroy(r)
+		return r.Destroy()
+	case 1:
+		l := 0
+		width := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		height := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetSize(r, width, height)
+		return nil
+	case 2:
+		l := 0
+		x := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		y := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		width := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		height := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetAnchorRect(r, x, y, width, height)
+		return nil
+	case 3:
+		l := 0
+		anchor := PositionerAnchor(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetAnchor(r, anchor)
+		return nil
+	case 4:
+		l := 0
+		gravity := PositionerGravity(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetGravity(r, gravity)
+		return nil
+	case 5:
+		l := 0
+		constraintAdjustment := PositionerConstraintAdjustment(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetConstraintAdjustment(r, constraintAdjustment)
+		return nil
+	case 6:
+		l := 0
+		x := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		y := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetOffset(r, x, y)
+		return nil
+	case 7:
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetReactive(r)
+		return nil
+	case 8:
+		l := 0
+		parentWidth := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		parentHeight := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetParentSize(r, parentWidth, parentHeight)
+		return nil
+	case 9:
+		l := 0
+		serial := server.Uint32(data[l : l+4])
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetParentConfigure(r, serial)
+		return nil
+	default:
+		return &server.OpcodeError{Interface: PositionerName, Opcode: opcode}
+	}
+}
+
+// SurfaceName : desktop user interface surface base interface
+const SurfaceName = "xdg_surface"
+
+// SurfaceInterface : metadata of the xdg_surface interface
+var SurfaceInterface = &server.Interface{
+	Name:    SurfaceName,
+	Version: 6,
+	New:     func(c *server.Client, id, version uint32) server.Resource { return NewSurface(c, id, version) },
+	Requests: []server.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "get_toplevel",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "id", Type: server.ArgTypeNewID, Interface: "xdg_toplevel"},
+			},
+		},
+		{
+			Name:  "get_popup",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "id", Type: server.ArgTypeNewID, Interface: "xdg_popup"},
+				{Name: "parent", Type: server.ArgTypeObject, Interface: "xdg_surface", AllowNull: true},
+				{Name: "positioner", Type: server.ArgTypeObject, Interface: "xdg_positioner"},
+			},
+		},
+		{
+			Name:  "set_window_geometry",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "x", Type: server.ArgTypeInt},
+				{Name: "y", Type: server.ArgTypeInt},
+				{Name: "width", Type: server.ArgTypeInt},
+				{Name: "height", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "ack_configure",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "serial", Type: server.ArgTypeUint},
+			},
+		},
+	},
+	Events: []server.Message{
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "serial", Type: server.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns SurfaceInterface
+func (r *Surface) Interface() *server.Interface {
+	return SurfaceInterface
+}
+
+// Surface : desktop user interface surface base interface
+//
+// An interface that may be implemented by a wl_surface, for
+// implementations that provide a desktop-style user interface.
+//
+// It provides a base set of functionality required to construct user
+// interface elements requiring management by the compositor, such as
+// toplevel windows, menus, etc. The types of functionality are split into
+// xdg_surface roles.
+//
+// Creating an xdg_surface does not set the role for a wl_surface. In order
+// to map an xdg_surface, the client must create a role-specific object
+// using, e.g., get_toplevel, get_popup. The wl_surface for any given
+// xdg_surface can have at most one role, and may not be assigned any role
+// not based on xdg_surface.
+//
+// A role must be assigned before any other requests are made to the
+// xdg_surface object.
+//
+// The client must call wl_surface.commit on the corresponding wl_surface
+// for the xdg_surface state to take effect.
+//
+// Creating an xdg_surface from a wl_surface which has a buffer attached or
+// committed is a client error, and any attempts by a client to attach or
+// manipulate a buffer prior to the first xdg_surface.configure call must
+// also be treated as errors.
+//
+// After creating a role-specific object and setting it up (e.g. by sending
+// the title, app ID, size constraints, parent, etc), the client must
+// perform an initial commit without any buffer attached. The compositor
+// will reply with initial wl_surface state such as
+// wl_surface.preferred_buffer_scale followed by an xdg_surface.configure
+// event. The client must acknowledge it and is then allowed to attach a
+// buffer to map the surface.
+//
+// Mapping an xdg_surface-based role surface is defined as making it
+// possible for the surface to be shown by the compositor. Note that
+// a mapped surface is not guaranteed to be visible once it is mapped.
+//
+// For an xdg_surface to be mapped by the compositor, the following
+// conditions must be met:
+// (1) the client has assigned an xdg_surface-based role to the surface
+// (2) the client has set and committed the xdg_surface state and the
+// role-dependent state to the surface
+// (3) the client has committed a buffer to the surface
+//
+// A newly-unmapped surface is considered to have met condition (1) out
+// of the 3 required conditions for mapping a surface if its role surface
+// has not been destroyed, i.e. the client must perform the initial commit
+// again before attaching a buffer.
+type Surface struct {
+	server.BaseResource
+	handler SurfaceHandler
+}
+
+// SurfaceHandler : handles the requests of Surface
+type SurfaceHandler interface {
+	// Destroy : destroy the xdg_surface
+	//
+	// Destroy the xdg_surface object. An xdg_surface must only be destroyed
+	// after its role object has been destroyed, otherwise
+	// a defunct_role_object error is raised.
+	//
+	Destroy(r *Surface)
+	// GetToplevel : assign the xdg_toplevel surface role
+	//
+	// This creates an xdg_toplevel object for the given xdg_surface and gives
+	// the associated wl_surface the xdg_toplevel role.
+	//
+	// See the documentation of xdg_toplevel for more details about what an
+	// xdg_toplevel is and how it is used.
+	//
+	GetToplevel(r *Surface, id *Toplevel)
+	// GetPopup : assign the xdg_popup surface role
+	//
+	// This creates an xdg_popup object for the given xdg_surface and gives
+	// the associated wl_surface the xdg_popup role.
+	//
+	// If null is passed as a parent, a parent surface must be specified using
+	// some other protocol, before committing the initial state.
+	//
+	// See the documentation of xdg_popup for more details about what an
+	// xdg_popup is and how it is used.
+	//
+	GetPopup(r *Surface, id *Popup, parent *Surface, positioner *Positioner)
+	// SetWindowGeometry : set the new window geometry
+	//
+	// The window geometry of a surface is its "visible bounds" from the
+	// user's perspective. Client-side decorations often have invisible
+	// portions like drop-shadows which should be ignored for the
+	// purposes of aligning, placing and constraining windows.
+	//
+	// The window geometry is double-buffered state, see wl_surface.commit.
+	//
+	// When maintaining a position, the compositor should treat the (x, y)
+	// coordinate of the window geometry as the top left corner of the window.
+	// A client changing the (x, y) window geometry coordinate should in
+	// general not alter the position of the window.
+	//
+	// Once the window geometry of the surface is set, it is not possible to
+	// unset it, and it will remain the same until set_window_geometry is
+	// called again, even if a new subsurface or buffer is attached.
+	//
+	// If never set, the value is the full bounds of the surface,
+	// including any subsurfaces. This updates dynamically on every
+	// commit. This unset is meant for extremely simple clients.
+	//
+	// The arguments are given in the surface-local coordinate space of
+	// the wl_surface associated with this xdg_surface, and may extend outside
+	// of the wl_surface itself to mark parts of the subsurface tree as part of
+	// the window geometry.
+	//
+	// When applied, the effective window geometry will be the set window
+	// geometry clamped to the bounding rectangle of the combined
+	// geometry of the surface of the xdg_surface and the associated
+	// subsurfaces.
+	//
+	// The effective geometry will not be recalculated unless a new call to
+	// set_window_geometry is done and the new pending surface state is
+	// subsequently applied.
+	//
+	// The width and height of the effective window geometry must be
+	// greater than zero. Setting an invalid size will raise an
+	// invalid_size error.
+	//
+	SetWindowGeometry(r *Surface, x, y, width, height int32)
+	// AckConfigure : ack a configure event
+	//
+	// When a configure event is received, if a client commits the
+	// surface in response to the configure event, then the client
+	// must make an ack_configure request sometime before the commit
+	// request, passing along the serial of the configure event.
+	//
+	// For instance, for toplevel surfaces the compositor might use this
+	// information to move a surface to the top left only when the client has
+	// drawn itself for the maximized or fullscreen state.
+	//
+	// If the client receives multiple configure events before it
+	// can respond to one, it only has to ack the last configure event.
+	// Acking a configure event that was never sent raises an invalid_serial
+	// error.
+	//
+	// A client is not required to commit immediately after sending
+	// an ack_configure request - it may even ack_configure several times
+	// before its next surface commit.
+	//
+	// A client may send multiple ack_configure requests before committing, but
+	// only the last request sent before a commit indicates which configure
+	// event the client really is responding to.
+	//
+	// Sending an ack_configure request consumes the serial number sent with
+	// the request, as well as serial numbers sent by all configure events
+	// sent on this xdg_surface prior to the configure event referenced by
+	// the committed serial.
+	//
+	// It is an error to issue multiple ack_configure requests referencing a
+	// serial from the same configure event, or to issue an ack_configure
+	// request referencing a serial from a configure event issued before the
+	// event identified by the last ack_configure request for the same
+	// xdg_surface. Doing so will raise an invalid_serial error.
+	//
+	//  serial: the serial from the configure event
+	AckConfigure(r *Surface, serial uint32)
+}
+
+// NewSurface : creates the Surface resource of c with the given id
+// and version, id 0 allocates a server side id
+func NewSurface(c *server.Client, id, version uint32) *Surface {
+	r := &Surface{}
+	c.Register(r, id, version)
+	return r
+}
+
+// SetHandler : sets the handler of the requests of Surface
+func (r *Surface) SetHandler(h SurfaceHandler) {
+	r.handler = h
+}
+
+// Handler : returns the handler of the requests of Surface
+func (r *Surface) Handler() SurfaceHandler {
+	return r.handler
+}
+
+type SurfaceError uint32
+
+// SurfaceError :
+const (
+	// SurfaceErrorNotConstructed : Surface was not fully constructed
+	SurfaceErrorNotConstructed SurfaceError = 1
+	// SurfaceErrorAlreadyConstructed : Surface was already constructed
+	SurfaceErrorAlreadyConstructed SurfaceError = 2
+	// SurfaceErrorUnconfiguredBuffer : Attaching a buffer to an unconfigured surface
+	SurfaceErrorUnconfiguredBuffer SurfaceError = 3
+	// SurfaceErrorInvalidSerial : Invalid serial number when acking a configure event
+	SurfaceErrorInvalidSerial SurfaceError = 4
+	// SurfaceErrorInvalidSize : Width or height was zero or negative
+	SurfaceErrorInvalidSize SurfaceError = 5
+	// SurfaceErrorDefunctRoleObject : Surface was destroyed before its role object
+	SurfaceErrorDefunctRoleObject SurfaceError = 6
+)
+
+func (e SurfaceError) Name() string {
+	switch e {
+	case SurfaceErrorNotConstructed:
+		return "not_constructed"
+	case SurfaceErrorAlreadyConstructed:
+		return "already_constructed"
+	case SurfaceErrorUnconfiguredBuffer:
+		return "unconfigured_buffer"
+	case SurfaceErrorInvalidSerial:
+		return "invalid_serial"
+	case SurfaceErrorInvalidSize:
+		return "invalid_size"
+	case SurfaceErrorDefunctRoleObject:
+		return "defunct_role_object"
+	default:
+		return ""
+	}
+}
+
+func (e SurfaceError) Value() string {
+	switch e {
+	case SurfaceErrorNotConstructed:
+		return "1"
+	case SurfaceErrorAlreadyConstructed:
+		return "2"
+	case SurfaceErrorUnconfiguredBuffer:
+		return "3"
+	case SurfaceErrorInvalidSerial:
+		return "4"
+	case SurfaceErrorInvalidSize:
+		return "5"
+	case SurfaceErrorDefunctRoleObject:
+		return "6"
+	default:
+		return ""
+	}
+}
+
+func (e SurfaceError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// SurfaceConfigureSinceVersion : version of Surface that introduced Configure
+const SurfaceConfigureSinceVersion = 1
+
+// SendConfigure : suggest a surface change
+//
+// The configure event marks the end of a configure sequence. A configure
+// sequence is a set of one or more events configuring the state of the
+// xdg_surface, including the final xdg_surface.configure event.
+//
+// Where applicable, xdg_surface surface roles will during a configure
+// sequence extend this event as a latched state sent as events before the
+// xdg_surface.configure event. Such events should be considered to make up
+// a set of atomically applied configuration states, where the
+// xdg_surface.configure commits the accumulated state.
+//
+// Clients should arrange their surface for the new states, and then send
+// an ack_configure request with the serial sent in this configure event at
+// some point before committing the new surface.
+//
+// If the client receives multiple configure events before it can respond
+// to one, it is free to discard all but the last event it received.
+//
+//	serial: serial of configure event
+func (r *Surface) SendConfigure(serial uint32) error {
+	const opcode = 0
+	const _evBufLen = 8 + 4
+	var _evBuf [_evBufLen]byte
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(serial))
+	l += 4
+	err := r.Client().WriteMsg(_evBuf[:], nil)
+	return err
+}
+
+// Dispatch : decodes the request with the given opcode and calls the
+// handler, resources of destructor requests are destroyed afterwards
+func (r *Surface) Dispatch(opcode uint32, fd int, data []byte) error {
+	switch opcode {
+	case 0:
+		if r.handler == nil {
+			return r.Destroy()
+		}
+		r.handler.Destroy(r)
+		return r.Destroy()
+	case 1:
+		l := 0
+		id := NewToplevel(r.Client(), server.Uint32(data[l:l+4]), r.Version())
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.GetToplevel(r, id)
+		return nil
+	case 2:
+		l := 0
+		id := NewPopup(r.Client(), server.Uint32(data[l:l+4]), r.Version())
+		l += 4
+		parent, err := server.Object[*Surface](r.Client(), server.Uint32(data[l:l+4]), true)
+		if err != nil {
+			return err
+		}
+		l += 4
+		positioner, err := server.Object[*Positioner](r.Client(), server.Uint32(data[l:l+4]), false)
+		if err != nil {
+			return err
+		}
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.GetPopup(r, id, parent, positioner)
+		return nil
+	case 3:
+		l := 0
+		x := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		y := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		width := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		height := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetWindowGeometry(r, x, y, width, height)
+		return nil
+	case 4:
+		l := 0
+		serial := server.Uint32(data[l : l+4])
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.AckConfigure(r, serial)
+		return nil
+	default:
+		return &server.OpcodeError{Interface: SurfaceName, Opcode: opcode}
+	}
+}
+
+// ToplevelName : toplevel surface
+const ToplevelName = "xdg_toplevel"
+
+// ToplevelInterface : metadata of the xdg_toplevel interface
+var ToplevelInterface = &server.Interface{
+	Name:    ToplevelName,
+	Version: 6,
+	New:     func(c *server.Client, id, version uint32) server.Resource { return NewToplevel(c, id, version) },
+	Requests: []server.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "set_parent",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "parent", Type: server.ArgTypeObject, Interface: "xdg_toplevel", AllowNull: true},
+			},
+		},
+		{
+			Name:  "set_title",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "title", Type: server.ArgTypeString},
+			},
+		},
+		{
+			Name:  "set_app_id",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "app_id", Type: server.ArgTypeString},
+			},
+		},
+		{
+			Name:  "show_window_menu",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "seat", Type: server.ArgTypeObject, Interface: "wl_seat"},
+				{Name: "serial", Type: server.ArgTypeUint},
+				{Name: "x", Type: server.ArgTypeInt},
+				{Name: "y", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "move",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "seat", Type: server.ArgTypeObject, Interface: "wl_seat"},
+				{Name: "serial", Type: server.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "resize",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "seat", Type: server.ArgTypeObject, Interface: "wl_seat"},
+				{Name: "serial", Type: server.ArgTypeUint},
+				{Name: "edges", Type: server.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "set_max_size",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "width", Type: server.ArgTypeInt},
+				{Name: "height", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_min_size",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "width", Type: server.ArgTypeInt},
+				{Name: "height", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "set_maximized",
+			Since: 1,
+		},
+		{
+			Name:  "unset_maximized",
+			Since: 1,
+		},
+		{
+			Name:  "set_fullscreen",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "output", Type: server.ArgTypeObject, Interface: "wl_output", AllowNull: true},
+			},
+		},
+		{
+			Name:  "unset_fullscreen",
+			Since: 1,
+		},
+		{
+			Name:  "set_minimized",
+			Since: 1,
+		},
+	},
+	Events: []server.Message{
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "width", Type: server.ArgTypeInt},
+				{Name: "height", Type: server.ArgTypeInt},
+				{Name: "states", Type: server.ArgTypeArray},
+			},
+		},
+		{
+			Name:  "close",
+			Since: 1,
+		},
+		{
+			Name:  "configure_bounds",
+			Since: 4,
+			Args: []server.Arg{
+				{Name: "width", Type: server.ArgTypeInt},
+				{Name: "height", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "wm_capabilities",
+			Since: 5,
+			Args: []server.Arg{
+				{Name: "capabilities", Type: server.ArgTypeArray},
+			},
+		},
+	},
+}
+
+// Interface : returns ToplevelInterface
+func (r *Toplevel) Interface() *server.Interface {
+	return ToplevelInterface
+}
+
+// Toplevel : toplevel surface
+//
+// This interface defines an xdg_surface role which allows a surface to,
+// among other things, set window-like properties such as maximize,
+// fullscreen, and minimize, set application-specific metadata like title and
+// id, and well as trigger user interactive operations such as interactive
+// resize and move.
+//
+// A xdg_toplevel by default is responsible for providing the full intended
+// visual representation of the toplevel, which depending on the window
+// state, may mean things like a title bar, window controls and drop shadow.
+//
+// Unmapping an xdg_toplevel means that the surface cannot be shown
+// by the compositor until it is explicitly mapped again.
+// All active operations (e.g., move, resize) are canceled and all
+// attributes (e.g. title, state, stacking, ...) are discarded for
+// an xdg_toplevel surface when it is unmapped. The xdg_toplevel returns to
+// the state it had right after xdg_surface.get_toplevel. The client
+// can re-map the toplevel by performing a commit without any buffer
+// attached, waiting for a configure event and handling it as usual (see
+// xdg_surface description).
+//
+// Attaching a null buffer to a toplevel unmaps the surface.
+type Toplevel struct {
+	server.BaseResource
+	handler ToplevelHandler
+}
+
+// ToplevelHandler : handles the requests of Toplevel
+type ToplevelHandler interface {
+	// Destroy : destroy the xdg_toplevel
+	//
+	// This request destroys the role surface and unmaps the surface;
+	// see "Unmapping" behavior in interface section for details.
+	//
+	Destroy(r *Toplevel)
+	// SetParent : set the parent of this surface
+	//
+	// Set the "parent" of this surface. This surface should be stacked
+	// above the parent surface and all other ancestor surfaces.
+	//
+	// Parent surfaces should be set on dialogs, toolboxes, or other
+	// "auxiliary" surfaces, so that the parent is raised when the dialog
+	// is raised.
+	//
+	// Setting a null parent for a child surface unsets its parent. Setting
+	// a null parent for a surface which currently has no parent is a no-op.
+	//
+	// Only mapped surfaces can have child surfaces. Setting a parent which
+	// is not mapped is equivalent to setting a null parent. If a surface
+	// becomes unmapped, its children's parent is set to the parent of
+	// the now-unmapped surface. If the now-unmapped surface has no parent,
+	// its children's parent is unset. If the now-unmapped surface becomes
+	// mapped again, its parent-child relationship is not restored.
+	//
+	// The parent toplevel must not be one of the child toplevel's
+	// descendants, and the parent must be different from the child toplevel,
+	// otherwise the invalid_parent protocol error is raised.
+	//
+	SetParent(r, parent *Toplevel)
+	// SetTitle : set surface title
+	//
+	// Set a short title for the surface.
+	//
+	// This string may be used to identify the surface in a task bar,
+	// window list, or other user interface elements provided by the
+	// compositor.
+	//
+	// The string must be encoded in UTF-8.
+	//
+	SetTitle(r *Toplevel, title string)
+	// SetAppId : set application ID
+	//
+	// Set an application identifier for the surface.
+	//
+	// The app ID identifies the general class of applications to which
+	// the surface belongs. The compositor can use this to group multiple
+	// surfaces together, or to determine how to launch a new application.
+	//
+	// For D-Bus activatable applications, the app ID is used as the D-Bus
+	// service name.
+	//
+	// The compositor shell will try to group application surfaces together
+	// by their app ID. As a best practice, it is suggested to select app
+	// ID's that match the basename of the application's .desktop file.
+	// For example, "org.freedesktop.FooViewer" where the .desktop file is
+	// "org.freedesktop.FooViewer.desktop".
+	//
+	// Like other properties, a set_app_id request can be sent after the
+	// xdg_toplevel has been mapped to update the property.
+	//
+	// See the desktop-entry specification [0] for more details on
+	// application identifiers and how they relate to well-known D-Bus
+	// names and .desktop files.
+	//
+	// [0] https://standards.freedesktop.org/desktop-entry-spec/
+	//
+	SetAppId(r *Toplevel, appId string)
+	// ShowWindowMenu : show the window menu
+	//
+	// Clients implementing client-side decorations might want to show
+	// a context menu when right-clicking on the decorations, giving the
+	// user a menu that they can use to maximize or minimize the window.
+	//
+	// This request asks the compositor to pop up such a window menu at
+	// the given position, relative to the local surface coordinates of
+	// the parent surface. There are no guarantees as to what menu items
+	// the window menu contains, or even if a window menu will be drawn
+	// at all.
+	//
+	// This request must be used in response to some sort of user action
+	// like a button press, key press, or touch down event.
+	//
+	//  seat: the wl_seat of the user event
+	//  serial: the serial of the user event
+	//  x: the x position to pop up the window menu at
+	//  y: the y position to pop up the window menu at
+	ShowWindowMenu(r *Toplevel, seat *server.Seat, serial uint32, x, y int32)
+	// Move : start an interactive move
+	//
+	// Start an interactive, user-driven move of the surface.
+	//
+	// This request must be used in response to some sort of user action
+	// like a button press, key press, or touch down event. The passed
+	// serial is used to determine the type of interactive move (touch,
+	// pointer, etc).
+	//
+	// The server may ignore move requests depending on the state of
+	// the surface (e.g. fullscreen or maximized), or if the passed serial
+	// is no longer valid.
+	//
+	// If triggered, the surface will lose the focus of the device
+	// (wl_pointer, wl_touch, etc) used for the move. It is up to the
+	// compositor to visually indicate that the move is taking place, such as
+	// updating a pointer cursor, during the move. There is no guarantee
+	// that the device focus will return when the move is completed.
+	//
+	//  seat: the wl_seat of the user event
+	//  serial: the serial of the user event
+	Move(r *Toplevel, seat *server.Seat, serial uint32)
+	// Resize : start an interactive resize
+	//
+	// Start a user-driven, interactive resize of the surface.
+	//
+	// This request must be used in response to some sort of user action
+	// like a button press, key press, or touch down event. The passed
+	// serial is used to determine the type of interactive resize (touch,
+	// pointer, etc).
+	//
+	// The server may ignore resize requests depending on the state of
+	// the surface (e.g. fullscreen or maximized).
+	//
+	// If triggered, the client will receive configure events with the
+	// "resize" state enum value and the expected sizes. See the "resize"
+	// enum value for more details about what is required. The client
+	// must also acknowledge configure events using "ack_configure". After
+	// the resize is completed, the client will receive another "configure"
+	// event without the resize state.
+	//
+	// If triggered, the surface also will lose the focus of the device
+	// (wl_pointer, wl_touch, etc) used for the resize. It is up to the
+	// compositor to visually indicate that the resize is taking place,
+	// such as updating a pointer cursor, during the resize. There is no
+	// guarantee that the device focus will return when the resize is
+	// completed.
+	//
+	// The edges parameter specifies how the surface should be resized, and
+	// is one of the values of the resize_edge enum. Values not matching
+	// a variant of the enum will cause the invalid_resize_edge protocol error.
+	// The compositor may use this information to update the surface position
+	// for example when dragging the top left corner. The compositor may also
+	// use this information to adapt its behavior, e.g. choose an appropriate
+	// cursor image.
+	//
+	//  seat: the wl_seat of the user event
+	//  serial: the serial of the user event
+	//  edges: which edge or corner is being dragged
+	Resize(r *Toplevel, seat *server.Seat, serial uint32, edges ToplevelResizeEdge)
+	// SetMaxSize : set the maximum size
+	//
+	// Set a maximum size for the window.
+	//
+	// The client can specify a maximum size so that the compositor does
+	// not try to configure the window beyond this size.
+	//
+	// The width and height arguments are in window geometry coordinates.
+	// See xdg_surface.set_window_geometry.
+	//
+	// Values set in this way are double-buffered, see wl_surface.commit.
+	//
+	// The compositor can use this information to allow or disallow
+	// different states like maximize or fullscreen and draw accurate
+	// animations.
+	//
+	// Similarly, a tiling window manager may use this information to
+	// place and resize client windows in a more effective way.
+	//
+	// The client should not rely on the compositor to obey the maximum
+	// size. The compositor may decide to ignore the values set by the
+	// client and request a larger size.
+	//
+	// If never set, or a value of zero in the request, means that the
+	// client has no expected maximum size in the given dimension.
+	// As a result, a client wishing to reset the maximum size
+	// to an unspecified state can use zero for width and height in the
+	// request.
+	//
+	// Requesting a maximum size to be smaller than the minimum size of
+	// a surface is illegal and will result in an invalid_size error.
+	//
+	// The width and height must be greater than or equal to zero. Using
+	// strictly negative values for width or height will result in a
+	// invalid_size error.
+	//
+	SetMaxSize(r *Toplevel, width, height int32)
+	// SetMinSize : set the minimum size
+	//
+	// Set a minimum size for the window.
+	//
+	// The client can specify a minimum size so that the compositor does
+	// not try to configure the window below this size.
+	//
+	// The width and height arguments are in window geometry coordinates.
+	// See xdg_surface.set_window_geometry.
+	//
+	// Values set in this way are double-buffered, see wl_surface.commit.
+	//
+	// The compositor can use this information to allow or disallow
+	// different states like maximize or fullscreen and draw accurate
+	// animations.
+	//
+	// Similarly, a tiling window manager may use this information to
+	// place and resize client windows in a more effective way.
+	//
+	// The client should not rely on the compositor to obey the minimum
+	// size. The compositor may decide to ignore the values set by the
+	// client and request a smaller size.
+	//
+	// If never set, or a value of zero in the request, means that the
+	// client has no expected minimum size in the given dimension.
+	// As a result, a client wishing to reset the minimum size
+	// to an unspecified state can use zero for width and height in the
+	// request.
+	//
+	// Requesting a minimum size to be larger than the maximum size of
+	// a surface is illegal and will result in an invalid_size error.
+	//
+	// The width and height must be greater than or equal to zero. Using
+	// strictly negative values for width and height will result in a
+	// invalid_size error.
+	//
+	SetMinSize(r *Toplevel, width, height int32)
+	// SetMaximized : maximize the window
+	//
+	// Maximize the surface.
+	//
+	// After requesting that the surface should be maximized, the compositor
+	// will respond by emitting a configure event. Whether this configure
+	// actually sets the window maximized is subject to compositor policies.
+	// The client must then update its content, drawing in the configured
+	// state. The client must also acknowledge the configure when committing
+	// the new content (see ack_configure).
+	//
+	// It is up to the compositor to decide how and where to maximize the
+	// surface, for example which output and what region of the screen should
+	// be used.
+	//
+	// If the surface was already maximized, the compositor will still emit
+	// a configure event with the "maximized" state.
+	//
+	// If the surface is in a fullscreen state, this request has no direct
+	// effect. It may alter the state the surface is returned to when
+	// unmaximized unless overridden by the compositor.
+	//
+	SetMaximized(r *Toplevel)
+	// UnsetMaximized : unmaximize the window
+	//
+	// Unmaximize the surface.
+	//
+	// After requesting that the surface should be unmaximized, the compositor
+	// will respond by emitting a configure event. Whether this actually
+	// un-maximizes the window is subject to compositor policies.
+	// If available and applicable, the compositor will include the window
+	// geometry dimensions the window had prior to being maximized in the
+	// configure event. The client must then update its content, drawing it in
+	// the configured state. The client must also acknowledge the configure
+	// when committing the new content (see ack_configure).
+	//
+	// It is up to the compositor to position the surface after it was
+	// unmaximized; usually the position the surface had before maximizing, if
+	// applicable.
+	//
+	// If the surface was already not maximized, the compositor will still
+	// emit a configure event without the "maximized" state.
+	//
+	// If the surface is in a fullscreen state, this request has no direct
+	// effect. It may alter the state the surface is returned to when
+	// unmaximized unless overridden by the compositor.
+	//
+	UnsetMaximized(r *Toplevel)
+	// SetFullscreen : set the window as fullscreen on an output
+	//
+	// Make the surface fullscreen.
+	//
+	// After requesting that the surface should be fullscreened, the
+	// compositor will respond by emitting a configure event. Whether the
+	// client is actually put into a fullscreen state is subject to compositor
+	// policies. The client must also acknowledge the configure when
+	// committing the new content (see ack_configure).
+	//
+	// The output passed by the request indicates the client's preference as
+	// to which display it should be set fullscreen on. If this value is NULL,
+	// it's up to the compositor to choose which display will be used to map
+	// this surface.
+	//
+	// If the surface doesn't cover the whole output, the compositor will
+	// position the surface in the center of the output and compensate with
+	// with border fill covering the rest of the output. The content of the
+	// border fill is undefined, but should be assumed to be in some way that
+	// attempts to blend into the surrounding area (e.g. solid black).
+	//
+	// If the fullscreened surface is not opaque, the compositor must make
+	// sure that other screen content not part of the same surface tree (made
+	// up of subsurfaces, popups or similarly coupled surfaces) are not
+	// visible below the fullscreened surface.
+	//
+	SetFullscreen(r *Toplevel, output *server.Output)
+	// UnsetFullscreen : unset the window as fullscreen
+	//
+	// Make the surface no longer fullscreen.
+	//
+	// After requesting that the surface should be unfullscreened, the
+	// compositor will respond by emitting a configure event.
+	// Whether this actually removes the fullscreen state of the client is
+	// subject to compositor policies.
+	//
+	// Making a surface unfullscreen sets states for the surface based on the following:
+	// * the state(s) it may have had before becoming fullscreen
+	// * any state(s) decided by the compositor
+	// * any state(s) requested by the client while the surface was fullscreen
+	//
+	// The compositor may include the previous window geometry dimensions in
+	// the configure event, if applicable.
+	//
+	// The client must also acknowledge the configure when committing the new
+	// content (see ack_configure).
+	//
+	UnsetFullscreen(r *Toplevel)
+	// SetMinimized : set the window as minimized
+	//
+	// Request that the compositor minimize your surface. There is no
+	// way to know if the surface is currently minimized, nor is there
+	// any way to unset minimization on this surface.
+	//
+	// If you are looking to throttle redrawing when minimized, please
+	// instead use the wl_surface.frame event for this, as this will
+	// also work with live previews on windows in Alt-Tab, Expose or
+	// similar compositor features.
+	//
+	SetMinimized(r *Toplevel)
+}
+
+// NewToplevel : creates the Toplevel resource of c with the given id
+// and version, id 0 allocates a server side id
+func NewToplevel(c *server.Client, id, version uint32) *Toplevel {
+	r := &Toplevel{}
+	c.Register(r, id, version)
+	return r
+}
+
+// SetHandler : sets the handler of the requests of Toplevel
+func (r *Toplevel) SetHandler(h ToplevelHandler) {
+	r.handler = h
+}
+
+// Handler : returns the handler of the requests of Toplevel
+func (r *Toplevel) Handler() ToplevelHandler {
+	return r.handler
+}
+
+type ToplevelError uint32
+
+// ToplevelError :
+const (
+	// ToplevelErrorInvalidResizeEdge : provided value is not a valid variant of the resize_edge enum
+	ToplevelErrorInvalidResizeEdge ToplevelError = 0
+	// ToplevelErrorInvalidParent : invalid parent toplevel
+	ToplevelErrorInvalidParent ToplevelError = 1
+	// ToplevelErrorInvalidSize : client provided an invalid min or max size
+	ToplevelErrorInvalidSize ToplevelError = 2
+)
+
+func (e ToplevelError) Name() string {
+	switch e {
+	case ToplevelErrorInvalidResizeEdge:
+		return "invalid_resize_edge"
+	case ToplevelErrorInvalidParent:
+		return "invalid_parent"
+	case ToplevelErrorInvalidSize:
+		return "invalid_size"
+	default:
+		return ""
+	}
+}
+
+func (e ToplevelError) Value() string {
+	switch e {
+	case ToplevelErrorInvalidResizeEdge:
+		return "0"
+	case ToplevelErrorInvalidParent:
+		return "1"
+	case ToplevelErrorInvalidSize:
+		return "2"
+	default:
+		return ""
+	}
+}
+
+func (e ToplevelError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type ToplevelResizeEdge uint32
+
+// ToplevelResizeEdge : edge values for resizing
+//
+// These values are used to indicate which edge of a surface
+// is being dragged in a resize operation.
+const (
+	ToplevelResizeEdgeNone        ToplevelResizeEdge = 0
+	ToplevelResizeEdgeTop         ToplevelResizeEdge = 1
+	ToplevelResizeEdgeBottom      ToplevelResizeEdge = 2
+	ToplevelResizeEdgeLeft        ToplevelResizeEdge = 4
+	ToplevelResizeEdgeTopLeft     ToplevelResizeEdge = 5
+	ToplevelResizeEdgeBottomLeft  ToplevelResizeEdge = 6
+	ToplevelResizeEdgeRight       ToplevelResizeEdge = 8
+	ToplevelResizeEdgeTopRight    ToplevelResizeEdge = 9
+	ToplevelResizeEdgeBottomRight ToplevelResizeEdge = 10
+)
+
+func (e ToplevelResizeEdge) Name() string {
+	switch e {
+	case ToplevelResizeEdgeNone:
+		return "none"
+	case ToplevelResizeEdgeTop:
+		return "top"
+	case ToplevelResizeEdgeBottom:
+		return "bottom"
+	case ToplevelResizeEdgeLeft:
+		return "left"
+	case ToplevelResizeEdgeTopLeft:
+		return "top_left"
+	case ToplevelResizeEdgeBottomLeft:
+		return "bottom_left"
+	case ToplevelResizeEdgeRight:
+		return "right"
+	case ToplevelResizeEdgeTopRight:
+		return "top_right"
+	case ToplevelResizeEdgeBottomRight:
+		return "bottom_right"
+	default:
+		return ""
+	}
+}
+
+func (e ToplevelResizeEdge) Value() string {
+	switch e {
+	case ToplevelResizeEdgeNone:
+		return "0"
+	case ToplevelResizeEdgeTop:
+		return "1"
+	case ToplevelResizeEdgeBottom:
+		return "2"
+	case ToplevelResizeEdgeLeft:
+		return "4"
+	case ToplevelResizeEdgeTopLeft:
+		return "5"
+	case ToplevelResizeEdgeBottomLeft:
+		return "6"
+	case ToplevelResizeEdgeRight:
+		return "8"
+	case ToplevelResizeEdgeTopRight:
+		return "9"
+	case ToplevelResizeEdgeBottomRight:
+		return "10"
+	default:
+		return ""
+	}
+}
+
+func (e ToplevelResizeEdge) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type ToplevelState uint32
+
+// ToplevelState : types of state on the surface
+//
+// The different state values used on the surface. This is designed for
+// state values like maximized, fullscreen. It is paired with the
+// configure event to ensure that both the client and the compositor
+// setting the state can be synchronized.
+//
+// States set in this way are double-buffered, see wl_surface.commit.
+const (
+	// ToplevelStateMaximized : the surface is maximized
+	ToplevelStateMaximized ToplevelState = 1
+	// ToplevelStateFullscreen : the surface is fullscreen
+	ToplevelStateFullscreen ToplevelState = 2
+	// ToplevelStateResizing : the surface is being resized
+	ToplevelStateResizing ToplevelState = 3
+	// ToplevelStateActivated : the surface is now activated
+	ToplevelStateActivated   ToplevelState = 4
+	ToplevelStateTiledLeft   ToplevelState = 5
+	ToplevelStateTiledRight  ToplevelState = 6
+	ToplevelStateTiledTop    ToplevelState = 7
+	ToplevelStateTiledBottom ToplevelState = 8
+	ToplevelStateSuspended   ToplevelState = 9
+)
+
+func (e ToplevelState) Name() string {
+	switch e {
+	case ToplevelStateMaximized:
+		return "maximized"
+	case ToplevelStateFullscreen:
+		return "fullscreen"
+	case ToplevelStateResizing:
+		return "resizing"
+	case ToplevelStateActivated:
+		return "activated"
+	case ToplevelStateTiledLeft:
+		return "tiled_left"
+	case ToplevelStateTiledRight:
+		return "tiled_right"
+	case ToplevelStateTiledTop:
+		return "tiled_top"
+	case ToplevelStateTiledBottom:
+		return "tiled_bottom"
+	case ToplevelStateSuspended:
+		return "suspended"
+	default:
+		return ""
+	}
+}
+
+func (e ToplevelState) Value() string {
+	switch e {
+	case ToplevelStateMaximized:
+		return "1"
+	case ToplevelStateFullscreen:
+		return "2"
+	case ToplevelStateResizing:
+		return "3"
+	case ToplevelStateActivated:
+		return "4"
+	case ToplevelStateTiledLeft:
+		return "5"
+	case ToplevelStateTiledRight:
+		return "6"
+	case ToplevelStateTiledTop:
+		return "7"
+	case ToplevelStateTiledBottom:
+		return "8"
+	case ToplevelStateSuspended:
+		return "9"
+	default:
+		return ""
+	}
+}
+
+func (e ToplevelState) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type ToplevelWmCapabilities uint32
+
+// ToplevelWmCapabilities :
+const (
+	// ToplevelWmCapabilitiesWindowMenu : show_window_menu is available
+	ToplevelWmCapabilitiesWindowMenu ToplevelWmCapabilities = 1
+	// ToplevelWmCapabilitiesMaximize : set_maximized and unset_maximized are available
+	ToplevelWmCapabilitiesMaximize ToplevelWmCapabilities = 2
+	// ToplevelWmCapabilitiesFullscreen : set_fullscreen and unset_fullscreen are available
+	ToplevelWmCapabilitiesFullscreen ToplevelWmCapabilities = 3
+	// ToplevelWmCapabilitiesMinimize : set_minimized is available
+	ToplevelWmCapabilitiesMinimize ToplevelWmCapabilities = 4
+)
+
+func (e ToplevelWmCapabilities) Name() string {
+	switch e {
+	case ToplevelWmCapabilitiesWindowMenu:
+		return "window_menu"
+	case ToplevelWmCapabilitiesMaximize:
+		return "maximize"
+	case ToplevelWmCapabilitiesFullscreen:
+		return "fullscreen"
+	case ToplevelWmCapabilitiesMinimize:
+		return "minimize"
+	default:
+		return ""
+	}
+}
+
+func (e ToplevelWmCapabilities) Value() string {
+	switch e {
+	case ToplevelWmCapabilitiesWindowMenu:
+		return "1"
+	case ToplevelWmCapabilitiesMaximize:
+		return "2"
+	case ToplevelWmCapabilitiesFullscreen:
+		return "3"
+	case ToplevelWmCapabilitiesMinimize:
+		return "4"
+	default:
+		return ""
+	}
+}
+
+func (e ToplevelWmCapabilities) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// ToplevelConfigureSinceVersion : version of Toplevel that introduced Configure
+const ToplevelConfigureSinceVersion = 1
+
+// SendConfigure : suggest a surface change
+//
+// This configure event asks the client to resize its toplevel surface or
+// to change its state. The configured state should not be applied
+// immediately. See xdg_surface.configure for details.
+//
+// The width and height arguments specify a hint to the window
+// about how its surface should be resized in window geometry
+// coordinates. See set_window_geometry.
+//
+// If the width or height arguments are zero, it means the client
+// should decide its own window dimension. This may happen when the
+// compositor needs to configure the state of the surface but doesn't
+// have any information about any previous or expected dimension.
+//
+// The states listed in the event specify how the width/height
+// arguments should be interpreted, and possibly how it should be
+// drawn.
+//
+// Clients must send an ack_configure in response to this event. See
+// xdg_surface.configure and xdg_surface.ack_configure for details.
+func (r *Toplevel) SendConfigure(width, height int32, states []byte) error {
+	const opcode = 0
+	statesLen := server.PaddedLen(len(states))
+	_evBufLen := 8 + 4 + 4 + (4 + statesLen)
+	_evBuf := make([]byte, _evBufLen)
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(width))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(height))
+	l += 4
+	server.PutArray(_evBuf[l:l+(4+statesLen)], states)
+	l += (4 + statesLen)
+	err := r.Client().WriteMsg(_evBuf, nil)
+	return err
+}
+
+// ToplevelCloseSinceVersion : version of Toplevel that introduced Close
+const ToplevelCloseSinceVersion = 1
+
+// SendClose : surface wants to be closed
+//
+// The close event is sent by the compositor when the user
+// wants the surface to be closed. This should be equivalent to
+// the user clicking the close button in client-side decorations,
+// if your application has any.
+//
+// This is only a request that the user intends to close the
+// window. The client may choose to ignore this request, or show
+// a dialog to ask the user to save their data, etc.
+func (r *Toplevel) SendClose() error {
+	const opcode = 1
+	const _evBufLen = 8
+	var _evBuf [_evBufLen]byte
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := r.Client().WriteMsg(_evBuf[:], nil)
+	return err
+}
+
+// ToplevelConfigureBoundsSinceVersion : version of Toplevel that introduced ConfigureBounds
+const ToplevelConfigureBoundsSinceVersion = 4
+
+// SendConfigureBounds : recommended window geometry bounds
+//
+// The configure_bounds event may be sent prior to a xdg_toplevel.configure
+// event to communicate the bounds a window geometry size is recommended
+// to constrain to.
+//
+// The passed width and height are in surface coordinate space. If width
+// and height are 0, it means bounds is unknown and equivalent to as if no
+// configure_bounds event was ever sent for this surface.
+//
+// The bounds can for example correspond to the size of a monitor excluding
+// any panels or other shell components, so that a surface isn't created in
+// a way that it cannot fit.
+//
+// The bounds may change at any point, and in such a case, a new
+// xdg_toplevel.configure_bounds will be sent, followed by
+// xdg_toplevel.configure and xdg_surface.configure.
+func (r *Toplevel) SendConfigureBounds(width, height int32) error {
+	if v := r.Version(); v < ToplevelConfigureBoundsSinceVersion {
+		return &server.VersionError{Interface: ToplevelName, Event: "configure_bounds", Since: ToplevelConfigureBoundsSinceVersion, Version: v}
+	}
+	const opcode = 2
+	const _evBufLen = 8 + 4 + 4
+	var _evBuf [_evBufLen]byte
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(width))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(height))
+	l += 4
+	err := r.Client().WriteMsg(_evBuf[:], nil)
+	return err
+}
+
+// ToplevelWmCapabilitiesSinceVersion : version of Toplevel that introduced WmCapabilities
+const ToplevelWmCapabilitiesSinceVersion = 5
+
+// SendWmCapabilities : compositor capabilities
+//
+// This event advertises the capabilities supported by the compositor. If
+// a capability isn't supported, clients should hide or disable the UI
+// elements that expose this functionality. For instance, if the
+// compositor doesn't advertise support for minimized toplevels, a button
+// triggering the set_minimized request should not be displayed.
+//
+// The compositor will ignore requests it doesn't support. For instance,
+// a compositor which doesn't advertise support for minimized will ignore
+// set_minimized requests.
+//
+// Compositors must send this event once before the first
+// xdg_surface.configure event. When the capabilities change, compositors
+// must send this event again and then send an xdg_surface.configure
+// event.
+//
+// The configured state should not be applied immediately. See
+// xdg_surface.configure for details.
+//
+// The capabilities are sent as an array of 32-bit unsigned integers in
+// native endianness.
+//
+//	capabilities: array of 32-bit capabilities
+func (r *Toplevel) SendWmCapabilities(capabilities []byte) error {
+	if v := r.Version(); v < ToplevelWmCapabilitiesSinceVersion {
+		return &server.VersionError{Interface: ToplevelName, Event: "wm_capabilities", Since: ToplevelWmCapabilitiesSinceVersion, Version: v}
+	}
+	const opcode = 3
+	capabilitiesLen := server.PaddedLen(len(capabilities))
+	_evBufLen := 8 + (4 + capabilitiesLen)
+	_evBuf := make([]byte, _evBufLen)
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	server.PutArray(_evBuf[l:l+(4+capabilitiesLen)], capabilities)
+	l += (4 + capabilitiesLen)
+	err := r.Client().WriteMsg(_evBuf, nil)
+	return err
+}
+
+// Dispatch : decodes the request with the given opcode and calls the
+// handler, resources of destructor requests are destroyed afterwards
+func (r *Toplevel) Dispatch(opcode uint32, fd int, data []byte) error {
+	switch opcode {
+	case 0:
+		if r.handler == nil {
+			return r.Destroy()
+		}
+		r.handler.Destroy(r)
+		return r.Destroy()
+	case 1:
+		l := 0
+		parent, err := server.Object[*Toplevel](r.Client(), server.Uint32(data[l:l+4]), true)
+		if err != nil {
+			return err
+		}
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetParent(r, parent)
+		return nil
+	case 2:
+		l := 0
+		titleLen := server.PaddedLen(int(server.Uint32(data[l : l+4])))
+		l += 4
+		title := server.String(data[l : l+titleLen])
+		l += titleLen
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetTitle(r, title)
+		return nil
+	case 3:
+		l := 0
+		appIdLen := server.PaddedLen(int(server.Uint32(data[l : l+4])))
+		l += 4
+		appId := server.String(data[l : l+appIdLen])
+		l += appIdLen
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetAppId(r, appId)
+		return nil
+	case 4:
+		l := 0
+		seat, err := server.Object[*server.Seat](r.Client(), server.Uint32(data[l:l+4]), false)
+		if err != nil {
+			return err
+		}
+		l += 4
+		serial := server.Uint32(data[l : l+4])
+		l += 4
+		x := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		y := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.ShowWindowMenu(r, seat, serial, x, y)
+		return nil
+	case 5:
+		l := 0
+		seat, err := server.Object[*server.Seat](r.Client(), server.Uint32(data[l:l+4]), false)
+		if err != nil {
+			return err
+		}
+		l += 4
+		serial := server.Uint32(data[l : l+4])
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.Move(r, seat, serial)
+		return nil
+	case 6:
+		l := 0
+		seat, err := server.Object[*server.Seat](r.Client(), server.Uint32(data[l:l+4]), false)
+		if err != nil {
+			return err
+		}
+		l += 4
+		serial := server.Uint32(data[l : l+4])
+		l += 4
+		edges := ToplevelResizeEdge(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.Resize(r, seat, serial, edges)
+		return nil
+	case 7:
+		l := 0
+		width := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		height := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetMaxSize(r, width, height)
+		return nil
+	case 8:
+		l := 0
+		width := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		height := int32(server.Uint32(data[l : l+4]))
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetMinSize(r, width, height)
+		return nil
+	case 9:
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetMaximized(r)
+		return nil
+	case 10:
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.UnsetMaximized(r)
+		return nil
+	case 11:
+		l := 0
+		output, err := server.Object[*server.Output](r.Client(), server.Uint32(data[l:l+4]), true)
+		if err != nil {
+			return err
+		}
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetFullscreen(r, output)
+		return nil
+	case 12:
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.UnsetFullscreen(r)
+		return nil
+	case 13:
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.SetMinimized(r)
+		return nil
+	default:
+		return &server.OpcodeError{Interface: ToplevelName, Opcode: opcode}
+	}
+}
+
+// PopupName : short-lived, popup surfaces for menus
+const PopupName = "xdg_popup"
+
+// PopupInterface : metadata of the xdg_popup interface
+var PopupInterface = &server.Interface{
+	Name:    PopupName,
+	Version: 6,
+	New:     func(c *server.Client, id, version uint32) server.Resource { return NewPopup(c, id, version) },
+	Requests: []server.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "grab",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "seat", Type: server.ArgTypeObject, Interface: "wl_seat"},
+				{Name: "serial", Type: server.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "reposition",
+			Since: 3,
+			Args: []server.Arg{
+				{Name: "positioner", Type: server.ArgTypeObject, Interface: "xdg_positioner"},
+				{Name: "token", Type: server.ArgTypeUint},
+			},
+		},
+	},
+	Events: []server.Message{
+		{
+			Name:  "configure",
+			Since: 1,
+			Args: []server.Arg{
+				{Name: "x", Type: server.ArgTypeInt},
+				{Name: "y", Type: server.ArgTypeInt},
+				{Name: "width", Type: server.ArgTypeInt},
+				{Name: "height", Type: server.ArgTypeInt},
+			},
+		},
+		{
+			Name:  "popup_done",
+			Since: 1,
+		},
+		{
+			Name:  "repositioned",
+			Since: 3,
+			Args: []server.Arg{
+				{Name: "token", Type: server.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns PopupInterface
+func (r *Popup) Interface() *server.Interface {
+	return PopupInterface
+}
+
+// Popup : short-lived, popup surfaces for menus
+//
+// A popup surface is a short-lived, temporary surface. It can be used to
+// implement for example menus, popovers, tooltips and other similar user
+// interface concepts.
+//
+// A popup can be made to take an explicit grab. See xdg_popup.grab for
+// details.
+//
+// When the popup is dismissed, a popup_done event will be sent out, and at
+// the same time the surface will be unmapped. See the xdg_popup.popup_done
+// event for details.
+//
+// Explicitly destroying the xdg_popup object will also dismiss the popup and
+// unmap the surface. Clients that want to dismiss the popup when another
+// surface of their own is clicked should dismiss the popup using the destroy
+// request.
+//
+// A newly created xdg_popup will be stacked on top of all previously created
+// xdg_popup surfaces associated with the same xdg_toplevel.
+//
+// The parent of an xdg_popup must be mapped (see the xdg_surface
+// description) before the xdg_popup itself.
+//
+// The client must call wl_surface.commit on the corresponding wl_surface
+// for the xdg_popup state to take effect.
+type Popup struct {
+	server.BaseResource
+	handler PopupHandler
+}
+
+// PopupHandler : handles the requests of Popup
+type PopupHandler interface {
+	// Destroy : remove xdg_popup interface
+	//
+	// This destroys the popup. Explicitly destroying the xdg_popup
+	// object will also dismiss the popup, and unmap the surface.
+	//
+	// If this xdg_popup is not the "topmost" popup, the
+	// xdg_wm_base.not_the_topmost_popup protocol error will be sent.
+	//
+	Destroy(r *Popup)
+	// Grab : make the popup take an explicit grab
+	//
+	// This request makes the created popup take an explicit grab. An explicit
+	// grab will be dismissed when the user dismisses the popup, or when the
+	// client destroys the xdg_popup. This can be done by the user clicking
+	// outside the surface, using the keyboard, or even locking the screen
+	// through closing the lid or a timeout.
+	//
+	// If the compositor denies the grab, the popup will be immediately
+	// dismissed.
+	//
+	// This request must be used in response to some sort of user action like a
+	// button press, key press, or touch down event. The serial number of the
+	// event should be passed as 'serial'.
+	//
+	// The parent of a grabbing popup must either be an xdg_toplevel surface or
+	// another xdg_popup with an explicit grab. If the parent is another
+	// xdg_popup it means that the popups are nested, with this popup now being
+	// the topmost popup.
+	//
+	// Nested popups must be destroyed in the reverse order they were created
+	// in, e.g. the only popup you are allowed to destroy at all times is the
+	// topmost one.
+	//
+	// When compositors choose to dismiss a popup, they may dismiss every
+	// nested grabbing popup as well. When a compositor dismisses popups, it
+	// will follow the same dismissing order as required from the client.
+	//
+	// If the topmost grabbing popup is destroyed, the grab will be returned to
+	// the parent of the popup, if that parent previously had an explicit grab.
+	//
+	// If the parent is a grabbing popup which has already been dismissed, this
+	// popup will be immediately dismissed. If the parent is a popup that did
+	// not take an explicit grab, an error will be raised.
+	//
+	// During a popup grab, the client owning the grab will receive pointer
+	// and touch events for all their surfaces as normal (similar to an
+	// "owner-events" grab in X11 parlance), while the top most grabbing popup
+	// will always have keyboard focus.
+	//
+	//  seat: the wl_seat of the user event
+	//  serial: the serial of the user event
+	Grab(r *Popup, seat *server.Seat, serial uint32)
+	// Reposition : recalculate the popup's location
+	//
+	// Reposition an already-mapped popup. The popup will be placed given the
+	// details in the passed xdg_positioner object, and a
+	// xdg_popup.repositioned followed by xdg_popup.configure and
+	// xdg_surface.configure will be emitted in response. Any parameters set
+	// by the previous positioner will be discarded.
+	//
+	// The passed token will be sent in the corresponding
+	// xdg_popup.repositioned event. The new popup position will not take
+	// effect until the corresponding configure event is acknowledged by the
+	// client. See xdg_popup.repositioned for details. The token itself is
+	// opaque, and has no other special meaning.
+	//
+	// If multiple reposition requests are sent, the compositor may skip all
+	// but the last one.
+	//
+	// If the popup is repositioned in response to a configure event for its
+	// parent, the client should send an xdg_positioner.set_parent_configure
+	// and possibly an xdg_positioner.set_parent_size request to allow the
+	// compositor to properly constrain the popup.
+	//
+	// If the popup is repositioned together with a parent that is being
+	// resized, but not in response to a configure event, the client should
+	// send an xdg_positioner.set_parent_size request.
+	//
+	//  token: reposition request token
+	Reposition(r *Popup, positioner *Positioner, token uint32)
+}
+
+// NewPopup : creates the Popup resource of c with the given id
+// and version, id 0 allocates a server side id
+func NewPopup(c *server.Client, id, version uint32) *Popup {
+	r := &Popup{}
+	c.Register(r, id, version)
+	return r
+}
+
+// SetHandler : sets the handler of the requests of Popup
+func (r *Popup) SetHandler(h PopupHandler) {
+	r.handler = h
+}
+
+// Handler : returns the handler of the requests of Popup
+func (r *Popup) Handler() PopupHandler {
+	return r.handler
+}
+
+type PopupError uint32
+
+// PopupError :
+const (
+	// PopupErrorInvalidGrab : tried to grab after being mapped
+	PopupErrorInvalidGrab PopupError = 0
+)
+
+func (e PopupError) Name() string {
+	switch e {
+	case PopupErrorInvalidGrab:
+		return "invalid_grab"
+	default:
+		return ""
+	}
+}
+
+func (e PopupError) Value() string {
+	switch e {
+	case PopupErrorInvalidGrab:
+		return "0"
+	default:
+		return ""
+	}
+}
+
+func (e PopupError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+// PopupConfigureSinceVersion : version of Popup that introduced Configure
+const PopupConfigureSinceVersion = 1
+
+// SendConfigure : configure the popup surface
+//
+// This event asks the popup surface to configure itself given the
+// configuration. The configured state should not be applied immediately.
+// See xdg_surface.configure for details.
+//
+// The x and y arguments represent the position the popup was placed at
+// given the xdg_positioner rule, relative to the upper left corner of the
+// window geometry of the parent surface.
+//
+// For version 2 or older, the configure event for an xdg_popup is only
+// ever sent once for the initial configuration. Starting with version 3,
+// it may be sent again if the popup is setup with an xdg_positioner with
+// set_reactive requested, or in response to xdg_popup.reposition requests.
+//
+//	x: x position relative to parent surface window geometry
+//	y: y position relative to parent surface window geometry
+//	width: window geometry width
+//	height: window geometry height
+func (r *Popup) SendConfigure(x, y, width, height int32) error {
+	const opcode = 0
+	const _evBufLen = 8 + 4 + 4 + 4 + 4
+	var _evBuf [_evBufLen]byte
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(x))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(y))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(width))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(height))
+	l += 4
+	err := r.Client().WriteMsg(_evBuf[:], nil)
+	return err
+}
+
+// PopupPopupDoneSinceVersion : version of Popup that introduced PopupDone
+const PopupPopupDoneSinceVersion = 1
+
+// SendPopupDone : popup interaction is done
+//
+// The popup_done event is sent out when a popup is dismissed by the
+// compositor. The client should destroy the xdg_popup object at this
+// point.
+func (r *Popup) SendPopupDone() error {
+	const opcode = 1
+	const _evBufLen = 8
+	var _evBuf [_evBufLen]byte
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := r.Client().WriteMsg(_evBuf[:], nil)
+	return err
+}
+
+// PopupRepositionedSinceVersion : version of Popup that introduced Repositioned
+const PopupRepositionedSinceVersion = 3
+
+// SendRepositioned : signal the completion of a repositioned request
+//
+// The repositioned event is sent as part of a popup configuration
+// sequence, together with xdg_popup.configure and lastly
+// xdg_surface.configure to notify the completion of a reposition request.
+//
+// The repositioned event is to notify about the completion of a
+// xdg_popup.reposition request. The token argument is the token passed
+// in the xdg_popup.reposition request.
+//
+// Immediately after this event is emitted, xdg_popup.configure and
+// xdg_surface.configure will be sent with the updated size and position,
+// as well as a new configure serial.
+//
+// The client should optionally update the content of the popup, but must
+// acknowledge the new popup configuration for the new position to take
+// effect. See xdg_surface.ack_configure for details.
+//
+//	token: reposition request token
+func (r *Popup) SendRepositioned(token uint32) error {
+	if v := r.Version(); v < PopupRepositionedSinceVersion {
+		return &server.VersionError{Interface: PopupName, Event: "repositioned", Since: PopupRepositionedSinceVersion, Version: v}
+	}
+	const opcode = 2
+	const _evBufLen = 8 + 4
+	var _evBuf [_evBufLen]byte
+	l := 0
+	server.PutUint32(_evBuf[l:4], r.ID())
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(_evBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	server.PutUint32(_evBuf[l:l+4], uint32(token))
+	l += 4
+	err := r.Client().WriteMsg(_evBuf[:], nil)
+	return err
+}
+
+// Dispatch : decodes the request with the given opcode and calls the
+// handler, resources of destructor requests are destroyed afterwards
+func (r *Popup) Dispatch(opcode uint32, fd int, data []byte) error {
+	switch opcode {
+	case 0:
+		if r.handler == nil {
+			return r.Destroy()
+		}
+		r.handler.Destroy(r)
+		return r.Destroy()
+	case 1:
+		l := 0
+		seat, err := server.Object[*server.Seat](r.Client(), server.Uint32(data[l:l+4]), false)
+		if err != nil {
+			return err
+		}
+		l += 4
+		serial := server.Uint32(data[l : l+4])
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.Grab(r, seat, serial)
+		return nil
+	case 2:
+		l := 0
+		positioner, err := server.Object[*Positioner](r.Client(), server.Uint32(data[l:l+4]), false)
+		if err != nil {
+			return err
+		}
+		l += 4
+		token := server.Uint32(data[l : l+4])
+		l += 4
+		if r.handler == nil {
+			return nil
+		}
+		r.handler.Reposition(r, positioner, token)
+		return nil
+	default:
+		return &server.OpcodeError{Interface: PopupName, Opcode: opcode}
+	}
+}
+
+func init() {
+	server.RegisterInterface(WmBaseInterface)
+	server.RegisterInterface(PositionerInterface)
+	server.RegisterInterface(SurfaceInterface)
+	server.RegisterInterface(ToplevelInterface)
+	server.RegisterInterface(PopupInterface)
+}
@@ -0,0 +1,35 @@
+package server
+
+import "github.com/hempflower/go-wayland/wayland/internal/wire"
+
+func Uint32(src []byte) uint32 {
+	return wire.Uint32(src)
+}
+
+func PutUint32(dst []byte, v uint32) {
+	wire.PutUint32(dst, v)
+}
+
+func String(src []byte) string {
+	return wire.String(src)
+}
+
+func PutString(dst []byte, v string, l int) {
+	wire.PutString(dst, v, l)
+}
+
+func PutArray(dst []byte, a []byte) {
+	wire.PutArray(dst, a)
+}
+
+func Fixed(src []byte) float64 {
+	return wire.Fixed(src)
+}
+
+func PutFixed(dst []byte, f float64) {
+	wire.PutFixed(dst, f)
+}
+
+func PaddedLen(l int) int {
+	return wire.PaddedLen(l)
+}
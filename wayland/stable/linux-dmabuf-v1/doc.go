@@ -0,0 +1,3 @@
+// Package linux_dmabuf is Go binding of the stable linux-dmabuf-v1 protocol,
+// used to create wl_buffers from dmabufs.
+package linux_dmabuf
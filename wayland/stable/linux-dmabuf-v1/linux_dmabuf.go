@@ -0,0 +1,1473 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : stable/linux-dmabuf/linux-dmabuf-v1.xml
+// XML sha256 : 976703daf59f2b83f4a432a7a8a20e0368c6329ebc149ef88c3e35019e91fc90
+//
+// linux_dmabuf_v1 Protocol Copyright:
+//
+// Copyright © 2014, 2015 Collabora, Ltd.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a
+// copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice (including the next
+// paragraph) shall be included in all copies or substantial portions of the
+// Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.  IN NO EVENT SHALL
+// THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package linux_dmabuf
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hempflower/go-wayland/wayland/client"
+	"golang.org/x/sys/unix"
+)
+
+// DmabufName : factory for creating dmabuf-based wl_buffers
+const DmabufName = "zwp_linux_dmabuf_v1"
+
+// DmabufInterface : metadata of the zwp_linux_dmabuf_v1 interface
+var DmabufInterface = &client.Interface{
+	Name:    DmabufName,
+	Version: 5,
+	New:     func(ctx *client.Context) client.Proxy { return NewDmabuf(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "create_params",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "params_id", Type: client.ArgTypeNewID, Interface: "zwp_linux_buffer_params_v1"},
+			},
+		},
+		{
+			Name:  "get_default_feedback",
+			Since: 4,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwp_linux_dmabuf_feedback_v1"},
+			},
+		},
+		{
+			Name:  "get_surface_feedback",
+			Since: 4,
+			Args: []client.Arg{
+				{Name: "id", Type: client.ArgTypeNewID, Interface: "zwp_linux_dmabuf_feedback_v1"},
+				{Name: "surface", Type: client.ArgTypeObject, Interface: "wl_surface"},
+			},
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "format",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "format", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "modifier",
+			Since: 3,
+			Args: []client.Arg{
+				{Name: "format", Type: client.ArgTypeUint},
+				{Name: "modifier_hi", Type: client.ArgTypeUint},
+				{Name: "modifier_lo", Type: client.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns DmabufInterface
+func (i *Dmabuf) Interface() *client.Interface {
+	return DmabufInterface
+}
+
+// Dmabuf : factory for creating dmabuf-based wl_buffers
+//
+// Following the interfaces from:
+// https://www.khronos.org/registry/egl/extensions/EXT/EGL_EXT_image_dma_buf_import.txt
+// https://www.khronos.org/registry/EGL/extensions/EXT/EGL_EXT_image_dma_buf_import_modifiers.txt
+// and the Linux DRM sub-system's AddFb2 ioctl.
+//
+// This interface offers ways to create generic dmabuf-based wl_buffers.
+//
+// For all DRM formats and unless specified in another protocol extension,
+// pre-multiplied alpha is used for pixel values.
+//
+// Unless specified otherwise in another protocol extension, implicit
+// synchronization is used. In other words, compositors and clients must
+// wait and signal fences implicitly passed via the DMA-BUF's reservation
+// mechanism.
+//
+// Clients can use the get_default_feedback or get_surface_feedback
+// requests to get dmabuf feedback, which describes the formats and
+// modifiers supported by the compositor together with the devices they
+// are allocated on.
+//
+// To create a wl_buffer from one or more dmabufs, a client creates a
+// zwp_linux_dmabuf_params_v1 object with a zwp_linux_dmabuf_v1.create_params
+// request. All planes required by the intended format are added with
+// the 'add' request. Finally, a 'create' or 'create_immed' request is
+// issued, which has the following outcome depending on the import success.
+//
+// The 'create' request,
+// - on success, triggers a 'created' event which provides the final
+// wl_buffer to the client.
+// - on failure, triggers a 'failed' event to convey that the server
+// cannot use the dmabufs received from the client.
+//
+// For the 'create_immed' request,
+// - on success, the server immediately imports the added dmabufs to
+// create a wl_buffer. No event is sent from the server in this case.
+// - on failure, the server can choose to either:
+// - terminate the client by raising a fatal error.
+// - mark the wl_buffer as failed, and send a 'failed' event to the
+// client. If the client uses a failed wl_buffer as an argument to any
+// request, the behaviour is compositor implementation-defined.
+//
+// For all DRM formats and unless specified in another protocol extension,
+// pre-multiplied alpha is used for pixel values.
+type Dmabuf struct {
+	client.BaseProxy
+	formatHandler   DmabufFormatHandlerFunc
+	modifierHandler DmabufModifierHandlerFunc
+}
+
+// NewDmabuf : factory for creating dmabuf-based wl_buffers
+//
+// Following the interfaces from:
+// https://www.khronos.org/registry/egl/extensions/EXT/EGL_EXT_image_dma_buf_import.txt
+// https://www.khronos.org/registry/EGL/extensions/EXT/EGL_EXT_image_dma_buf_import_modifiers.txt
+// and the Linux DRM sub-system's AddFb2 ioctl.
+//
+// This interface offers ways to create generic dmabuf-based wl_buffers.
+//
+// For all DRM formats and unless specified in another protocol extension,
+// pre-multiplied alpha is used for pixel values.
+//
+// Unless specified otherwise in another protocol extension, implicit
+// synchronization is used. In other words, compositors and clients must
+// wait and signal fences implicitly passed via the DMA-BUF's reservation
+// mechanism.
+//
+// Clients can use the get_default_feedback or get_surface_feedback
+// requests to get dmabuf feedback, which describes the formats and
+// modifiers supported by the compositor together with the devices they
+// are allocated on.
+//
+// To create a wl_buffer from one or more dmabufs, a client creates a
+// zwp_linux_dmabuf_params_v1 object with a zwp_linux_dmabuf_v1.create_params
+// request. All planes required by the intended format are added with
+// the 'add' request. Finally, a 'create' or 'create_immed' request is
+// issued, which has the following outcome depending on the import success.
+//
+// The 'create' request,
+// - on success, triggers a 'created' event which provides the final
+// wl_buffer to the client.
+// - on failure, triggers a 'failed' event to convey that the server
+// cannot use the dmabufs received from the client.
+//
+// For the 'create_immed' request,
+// - on success, the server immediately imports the added dmabufs to
+// create a wl_buffer. No event is sent from the server in this case.
+// - on failure, the server can choose to either:
+// - terminate the client by raising a fatal error.
+// - mark the wl_buffer as failed, and send a 'failed' event to the
+// client. If the client uses a failed wl_buffer as an argument to any
+// request, the behaviour is compositor implementation-defined.
+//
+// For all DRM formats and unless specified in another protocol extension,
+// pre-multiplied alpha is used for pixel values.
+func NewDmabuf(ctx *client.Context) *Dmabuf {
+	zwpLinuxDmabufV1 := &Dmabuf{}
+	ctx.Register(zwpLinuxDmabufV1)
+	return zwpLinuxDmabufV1
+}
+
+// DmabufDestroySinceVersion : version of Dmabuf that introduced Destroy
+const DmabufDestroySinceVersion = 1
+
+// Destroy : unbind the factory
+//
+// Objects created through this interface, especially wl_buffers, will
+// remain valid.
+func (i *Dmabuf) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// DmabufCreateParamsSinceVersion : version of Dmabuf that introduced CreateParams
+const DmabufCreateParamsSinceVersion = 1
+
+// CreateParams : create a temporary object for buffer parameters
+//
+// This temporary object is used to collect multiple dmabuf handles into
+// a single batch to create a wl_buffer. It can only be used once and
+// should be destroyed after a 'created' or 'failed' event has been
+// received.
+func (i *Dmabuf) CreateParams() (*BufferParams, error) {
+	paramsId := NewBufferParams(i.Context())
+	paramsId.SetVersion(i.Version())
+	const opcode = 1
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], paramsId.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return paramsId, err
+}
+
+// DmabufGetDefaultFeedbackSinceVersion : version of Dmabuf that introduced GetDefaultFeedback
+const DmabufGetDefaultFeedbackSinceVersion = 4
+
+// GetDefaultFeedback : get default feedback
+//
+// This request creates a new wp_linux_dmabuf_feedback object not bound
+// to a particular surface. This object will deliver feedback about dmabuf
+// parameters to use if the client doesn't support per-surface feedback
+// (see get_surface_feedback).
+func (i *Dmabuf) GetDefaultFeedback() (*DmabufFeedback, error) {
+	if v := i.Version(); v < DmabufGetDefaultFeedbackSinceVersion {
+		return nil, &client.VersionError{Interface: DmabufName, Request: "get_default_feedback", Since: DmabufGetDefaultFeedbackSinceVersion, Version: v}
+	}
+	id := NewDmabufFeedback(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 2
+	const _reqBufLen = 8 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+// DmabufGetSurfaceFeedbackSinceVersion : version of Dmabuf that introduced GetSurfaceFeedback
+const DmabufGetSurfaceFeedbackSinceVersion = 4
+
+// GetSurfaceFeedback : get feedback for a surface
+//
+// This request creates a new wp_linux_dmabuf_feedback object for the
+// specified wl_surface. This object will deliver feedback about dmabuf
+// parameters to use for buffers attached to this surface.
+//
+// If the surface is destroyed before the wp_linux_dmabuf_feedback object,
+// the feedback object becomes inert.
+func (i *Dmabuf) GetSurfaceFeedback(surface *client.Surface) (*DmabufFeedback, error) {
+	if v := i.Version(); v < DmabufGetSurfaceFeedbackSinceVersion {
+		return nil, &client.VersionError{Interface: DmabufName, Request: "get_surface_feedback", Since: DmabufGetSurfaceFeedbackSinceVersion, Version: v}
+	}
+	id := NewDmabufFeedback(i.Context())
+	id.SetVersion(i.Version())
+	const opcode = 3
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], id.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], surface.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return id, err
+}
+
+// DmabufFormatEvent : supported buffer format
+//
+// This event advertises one buffer format that the server supports.
+// All the supported formats are advertised once when the client
+// binds to this interface. A roundtrip after binding guarantees
+// that the client has received all supported formats.
+//
+// For the definition of the format codes, see the
+// zwp_linux_buffer_params_v1::create request.
+//
+// Starting version 4, the format event is deprecated and must not be
+// sent by compositors. Instead, use get_default_feedback or
+// get_surface_feedback.
+type DmabufFormatEvent struct {
+	Format uint32
+}
+
+// DmabufFormatSinceVersion : version of Dmabuf that introduced DmabufFormatEvent
+const DmabufFormatSinceVersion = 1
+
+type DmabufFormatHandlerFunc func(DmabufFormatEvent)
+
+// SetFormatHandler : sets handler for DmabufFormatEvent
+func (i *Dmabuf) SetFormatHandler(f DmabufFormatHandlerFunc) {
+	i.formatHandler = f
+}
+
+// DmabufModifierEvent : supported buffer format modifier
+//
+// This event advertises the formats that the server supports, along with
+// the modifiers supported for each format. All the supported modifiers
+// for all the supported formats are advertised once when the client
+// binds to this interface. A roundtrip after binding guarantees that
+// the client has received all supported format-modifier pairs.
+//
+// For legacy support, DRM_FORMAT_MOD_INVALID (that is, modifier_hi ==
+// 0x00ffffff and modifier_lo == 0xffffffff) is allowed in this event.
+// It indicates that the server can support the format with an implicit
+// modifier. When a plane has DRM_FORMAT_MOD_INVALID as its modifier, it
+// is as if no explicit modifier is specified. The effective modifier
+// will be derived from the dmabuf.
+//
+// A compositor that sends valid modifiers and DRM_FORMAT_MOD_INVALID for
+// a given format supports both explicit modifiers and implicit modifiers.
+//
+// For the definition of the format and modifier codes, see the
+// zwp_linux_buffer_params_v1::create and zwp_linux_buffer_params_v1::add
+// requests.
+//
+// Starting version 4, the modifier event is deprecated and must not be
+// sent by compositors. Instead, use get_default_feedback or
+// get_surface_feedback.
+type DmabufModifierEvent struct {
+	Format     uint32
+	ModifierHi uint32
+	ModifierLo uint32
+}
+
+// DmabufModifierSinceVersion : version of Dmabuf that introduced DmabufModifierEvent
+const DmabufModifierSinceVersion = 3
+
+type DmabufModifierHandlerFunc func(DmabufModifierEvent)
+
+// SetModifierHandler : sets handler for DmabufModifierEvent
+func (i *Dmabuf) SetModifierHandler(f DmabufModifierHandlerFunc) {
+	i.modifierHandler = f
+}
+
+func (i *Dmabuf) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.formatHandler == nil {
+			return
+		}
+		var e DmabufFormatEvent
+		l := 0
+		e.Format = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.formatHandler(e)
+	case 1:
+		if i.modifierHandler == nil {
+			return
+		}
+		var e DmabufModifierEvent
+		l := 0
+		e.Format = client.Uint32(data[l : l+4])
+		l += 4
+		e.ModifierHi = client.Uint32(data[l : l+4])
+		l += 4
+		e.ModifierLo = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.modifierHandler(e)
+	}
+}
+
+// BufferParamsName : parameters for creating a dmabuf-based wl_buffer
+const BufferParamsName = "zwp_linux_buffer_params_v1"
+
+// BufferParamsInterface : metadata of the zwp_linux_buffer_params_v1 interface
+var BufferParamsInterface = &client.Interface{
+	Name:    BufferParamsName,
+	Version: 5,
+	New:     func(ctx *client.Context) client.Proxy { return NewBufferParams(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "add",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "fd", Type: client.ArgTypeFd},
+				{Name: "plane_idx", Type: client.ArgTypeUint},
+				{Name: "offset", Type: client.ArgTypeUint},
+				{Name: "stride", Type: client.ArgTypeUint},
+				{Name: "modifier_hi", Type: client.ArgTypeUint},
+				{Name: "modifier_lo", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "create",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "width", Type: client.ArgTypeInt},
+				{Name: "height", Type: client.ArgTypeInt},
+				{Name: "format", Type: client.ArgTypeUint},
+				{Name: "flags", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "create_immed",
+			Since: 2,
+			Args: []client.Arg{
+				{Name: "buffer_id", Type: client.ArgTypeNewID, Interface: "wl_buffer"},
+				{Name: "width", Type: client.ArgTypeInt},
+				{Name: "height", Type: client.ArgTypeInt},
+				{Name: "format", Type: client.ArgTypeUint},
+				{Name: "flags", Type: client.ArgTypeUint},
+			},
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "created",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "buffer", Type: client.ArgTypeNewID, Interface: "wl_buffer"},
+			},
+		},
+		{
+			Name:  "failed",
+			Since: 1,
+		},
+	},
+}
+
+// Interface : returns BufferParamsInterface
+func (i *BufferParams) Interface() *client.Interface {
+	return BufferParamsInterface
+}
+
+// BufferParams : parameters for creating a dmabuf-based wl_buffer
+//
+// This temporary object is a collection of dmabufs and other
+// parameters that together form a single logical buffer. The temporary
+// object may eventually create one wl_buffer unless cancelled by
+// destroying it before requesting 'create'.
+//
+// Single-planar formats only require one dmabuf, however
+// multi-planar formats may require more than one dmabuf. For all
+// formats, an 'add' request must be called once per plane (even if the
+// underlying dmabuf fd is identical).
+//
+// You must use consecutive plane indices ('plane_idx' argument for 'add')
+// from zero to the number of planes used by the drm_fourcc format code.
+// All planes required by the format must be given exactly once, but can
+// be given in any order. Each plane index can only be set once; subsequent
+// calls with a plane index which has already been set will result in a
+// plane_set error being generated.
+type BufferParams struct {
+	client.BaseProxy
+	createdHandler BufferParamsCreatedHandlerFunc
+	failedHandler  BufferParamsFailedHandlerFunc
+}
+
+// NewBufferParams : parameters for creating a dmabuf-based wl_buffer
+//
+// This temporary object is a collection of dmabufs and other
+// parameters that together form a single logical buffer. The temporary
+// object may eventually create one wl_buffer unless cancelled by
+// destroying it before requesting 'create'.
+//
+// Single-planar formats only require one dmabuf, however
+// multi-planar formats may require more than one dmabuf. For all
+// formats, an 'add' request must be called once per plane (even if the
+// underlying dmabuf fd is identical).
+//
+// You must use consecutive plane indices ('plane_idx' argument for 'add')
+// from zero to the number of planes used by the drm_fourcc format code.
+// All planes required by the format must be given exactly once, but can
+// be given in any order. Each plane index can only be set once; subsequent
+// calls with a plane index which has already been set will result in a
+// plane_set error being generated.
+func NewBufferParams(ctx *client.Context) *BufferParams {
+	zwpLinuxBufferParamsV1 := &BufferParams{}
+	ctx.Register(zwpLinuxBufferParamsV1)
+	return zwpLinuxBufferParamsV1
+}
+
+// BufferParamsDestroySinceVersion : version of BufferParams that introduced Destroy
+const BufferParamsDestroySinceVersion = 1
+
+// Destroy : delete this object, used or not
+//
+// Cleans up the temporary data sent to the server for dmabuf-based
+// wl_buffer creation.
+func (i *BufferParams) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// BufferParamsAddSinceVersion : version of BufferParams that introduced Add
+const BufferParamsAddSinceVersion = 1
+
+// Add : add a dmabuf to the temporary set
+//
+// This request adds one dmabuf to the set in this
+// zwp_linux_buffer_params_v1.
+//
+// The 64-bit unsigned value combined from modifier_hi and modifier_lo
+// is the dmabuf layout modifier. DRM AddFB2 ioctl calls this the
+// fb modifier, which is defined in drm_mode.h of Linux UAPI.
+// This is an opaque token. Drivers use this token to express tiling,
+// compression, etc. driver-specific modifications to the base format
+// defined by the DRM fourcc code.
+//
+// Starting from version 4, the invalid_format protocol error is sent if
+// the format + modifier pair was not advertised as supported.
+//
+// Starting from version 5, the invalid_format protocol error is sent if
+// all planes don't use the same modifier.
+//
+// This request raises the PLANE_IDX error if plane_idx is too large.
+// The error PLANE_SET is raised if attempting to set a plane that
+// was already set.
+//
+//	fd: dmabuf fd
+//	planeIdx: plane index
+//	offset: offset in bytes
+//	stride: stride in bytes
+//	modifierHi: high 32 bits of layout modifier
+//	modifierLo: low 32 bits of layout modifier
+func (i *BufferParams) Add(fd int, planeIdx, offset, stride, modifierHi, modifierLo uint32) error {
+	const opcode = 1
+	const _reqBufLen = 8 + 4 + 4 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(planeIdx))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(offset))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(stride))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(modifierHi))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(modifierLo))
+	l += 4
+	oob := unix.UnixRights(int(fd))
+	err := i.Context().WriteMsg(_reqBuf[:], oob)
+	return err
+}
+
+// BufferParamsCreateSinceVersion : version of BufferParams that introduced Create
+const BufferParamsCreateSinceVersion = 1
+
+// Create : create a wl_buffer from the given dmabufs
+//
+// This asks for creation of a wl_buffer from the added dmabuf
+// buffers. The wl_buffer is not created immediately but returned via
+// the 'created' event if the dmabuf sharing succeeds. The sharing
+// may fail at runtime for reasons a client cannot predict, in
+// which case the 'failed' event is triggered.
+//
+// The 'format' argument is a DRM_FORMAT code, as defined by the
+// libdrm's drm_fourcc.h. The Linux kernel's DRM sub-system is the
+// authoritative source on how the format codes should work.
+//
+// The 'flags' is a bitfield of the flags defined in enum "flags".
+// 'y_invert' means the that the image needs to be y-flipped.
+//
+// Flag 'interlaced' means that the frame in the buffer is not
+// progressive as usual, but interlaced. An interlaced buffer as
+// supported here must always contain both top and bottom fields.
+// The top field always begins on the first pixel row. The temporal
+// ordering between the two fields is top field first, unless
+// 'bottom_first' is specified. It is undefined whether 'bottom_first'
+// is ignored if 'interlaced' is not set.
+//
+// This protocol does not convey any information about field rate,
+// duration, or timing, other than the relative ordering between the
+// two fields in one buffer. A compositor may have to estimate the
+// intended field rate from the incoming buffer rate. It is undefined
+// whether the time of receiving wl_surface.commit with a new buffer
+// attached, applying the wl_surface state, wl_surface.frame callback
+// trigger, presentation, or any other point in the compositor cycle
+// is used to measure the frame or field times. There is no support
+// for detecting missed or late frames/fields/buffers either, and
+// there is no support whatsoever for cooperative interlaced
+// compositing.
+//
+// Any argument errors, including non-positive width or height,
+// mismatch between the number of planes and the format, bad
+// format, bad offset or stride, may be indicated by fatal protocol
+// errors: INCOMPLETE, INVALID_FORMAT, INVALID_DIMENSIONS,
+// OUT_OF_BOUNDS.
+//
+// Dmabuf import errors in the server that are not obvious client
+// bugs are returned via the 'failed' event as non-fatal. This
+// allows attempting dmabuf sharing and falling back in the client
+// if it fails.
+//
+// This request can be sent only once in the object's lifetime, after
+// which the only legal request is destroy. This object should be
+// destroyed after issuing a 'create' request. Attempting to use this
+// object after issuing 'create' raises ALREADY_USED protocol error.
+//
+// It is not mandatory to issue 'create'. If a client wants to
+// cancel the buffer creation, it can just destroy this object.
+//
+//	width: base plane width in pixels
+//	height: base plane height in pixels
+//	format: DRM_FORMAT code
+//	flags: see enum flags
+func (i *BufferParams) Create(width, height int32, format uint32, flags BufferParamsFlags) error {
+	const opcode = 2
+	const _reqBufLen = 8 + 4 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(width))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(height))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(format))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(flags))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// BufferParamsCreateImmedSinceVersion : version of BufferParams that introduced CreateImmed
+const BufferParamsCreateImmedSinceVersion = 2
+
+// CreateImmed : immediately create a wl_buffer from the given dmabufs
+//
+// This asks for immediate creation of a wl_buffer by importing the
+// added dmabufs.
+//
+// In case of import success, no event is sent from the server, and the
+// wl_buffer is ready to be used by the client.
+//
+// Upon import failure, either of the following may happen, as seen fit
+// by the implementation:
+// - the client is terminated with one of the following fatal protocol
+// errors:
+// - INCOMPLETE, INVALID_FORMAT, INVALID_DIMENSIONS, OUT_OF_BOUNDS,
+// in case of argument errors such as mismatch between the number
+// of planes and the format, bad format, non-positive width or
+// height, or bad offset or stride.
+// - INVALID_WL_BUFFER, in case the cause for failure is unknown or
+// platform specific.
+// - the server creates an invalid wl_buffer, marks it as failed and
+// sends a 'failed' event to the client. The result of using this
+// invalid wl_buffer as an argument in any request by the client is
+// defined by the compositor implementation.
+//
+// This takes the same arguments as a 'create' request, and obeys the
+// same restrictions.
+//
+//	width: base plane width in pixels
+//	height: base plane height in pixels
+//	format: DRM_FORMAT code
+//	flags: see enum flags
+func (i *BufferParams) CreateImmed(width, height int32, format uint32, flags BufferParamsFlags) (*client.Buffer, error) {
+	if v := i.Version(); v < BufferParamsCreateImmedSinceVersion {
+		return nil, &client.VersionError{Interface: BufferParamsName, Request: "create_immed", Since: BufferParamsCreateImmedSinceVersion, Version: v}
+	}
+	bufferId := client.NewBuffer(i.Context())
+	bufferId.SetVersion(i.Version())
+	const opcode = 3
+	const _reqBufLen = 8 + 4 + 4 + 4 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], bufferId.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(width))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(height))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(format))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(flags))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return bufferId, err
+}
+
+type BufferParamsError uint32
+
+// BufferParamsError :
+const (
+	// BufferParamsErrorAlreadyUsed : the dmabuf_batch object has already been used to create a wl_buffer
+	BufferParamsErrorAlreadyUsed BufferParamsError = 0
+	// BufferParamsErrorPlaneIdx : plane index out of bounds
+	BufferParamsErrorPlaneIdx BufferParamsError = 1
+	// BufferParamsErrorPlaneSet : the plane index was already set
+	BufferParamsErrorPlaneSet BufferParamsError = 2
+	// BufferParamsErrorIncomplete : missing or too many planes to create a buffer
+	BufferParamsErrorIncomplete BufferParamsError = 3
+	// BufferParamsErrorInvalidFormat : format not supported
+	BufferParamsErrorInvalidFormat BufferParamsError = 4
+	// BufferParamsErrorInvalidDimensions : invalid width or height
+	BufferParamsErrorInvalidDimensions BufferParamsError = 5
+	// BufferParamsErrorOutOfBounds : offset + stride * height goes out of dmabuf bounds
+	BufferParamsErrorOutOfBounds BufferParamsError = 6
+	// BufferParamsErrorInvalidWlBuffer : invalid wl_buffer resulted from importing dmabufs via the create_immed request on given buffer_params
+	BufferParamsErrorInvalidWlBuffer BufferParamsError = 7
+)
+
+func (e BufferParamsError) Name() string {
+	switch e {
+	case BufferParamsErrorAlreadyUsed:
+		return "already_used"
+	case BufferParamsErrorPlaneIdx:
+		return "plane_idx"
+	case BufferParamsErrorPlaneSet:
+		return "plane_set"
+	case BufferParamsErrorIncomplete:
+		return "incomplete"
+	case BufferParamsErrorInvalidFormat:
+		return "invalid_format"
+	case BufferParamsErrorInvalidDimensions:
+		return "invalid_dimensions"
+	case BufferParamsErrorOutOfBounds:
+		return "out_of_bounds"
+	case BufferParamsErrorInvalidWlBuffer:
+		return "invalid_wl_buffer"
+	default:
+		return ""
+	}
+}
+
+func (e BufferParamsError) Value() string {
+	switch e {
+	case BufferParamsErrorAlreadyUsed:
+		return "0"
+	case BufferParamsErrorPlaneIdx:
+		return "1"
+	case BufferParamsErrorPlaneSet:
+		return "2"
+	case BufferParamsErrorIncomplete:
+		return "3"
+	case BufferParamsErrorInvalidFormat:
+		return "4"
+	case BufferParamsErrorInvalidDimensions:
+		return "5"
+	case BufferParamsErrorOutOfBounds:
+		return "6"
+	case BufferParamsErrorInvalidWlBuffer:
+		return "7"
+	default:
+		return ""
+	}
+}
+
+func (e BufferParamsError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type BufferParamsFlags uint32
+
+// BufferParamsFlags :
+const (
+	// BufferParamsFlagsYInvert : contents are y-inverted
+	BufferParamsFlagsYInvert BufferParamsFlags = 1
+	// BufferParamsFlagsInterlaced : content is interlaced
+	BufferParamsFlagsInterlaced BufferParamsFlags = 2
+	// BufferParamsFlagsBottomFirst : bottom field first
+	BufferParamsFlagsBottomFirst BufferParamsFlags = 4
+)
+
+func (e BufferParamsFlags) Name() string {
+	switch e {
+	case BufferParamsFlagsYInvert:
+		return "y_invert"
+	case BufferParamsFlagsInterlaced:
+		return "interlaced"
+	case BufferParamsFlagsBottomFirst:
+		return "bottom_first"
+	default:
+		return ""
+	}
+}
+
+func (e BufferParamsFlags) Value() string {
+	switch e {
+	case BufferParamsFlagsYInvert:
+		return "1"
+	case BufferParamsFlagsInterlaced:
+		return "2"
+	case BufferParamsFlagsBottomFirst:
+		return "4"
+	default:
+		return ""
+	}
+}
+
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e BufferParamsFlags) Has(f BufferParamsFlags) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e BufferParamsFlags) Set(f BufferParamsFlags) BufferParamsFlags {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e BufferParamsFlags) Clear(f BufferParamsFlags) BufferParamsFlags {
+	return e &^ f
+}
+
+func (e BufferParamsFlags) String() string {
+	if e == 0 {
+		return "0"
+	}
+	var flags []string
+	if e.Has(BufferParamsFlagsYInvert) {
+		flags = append(flags, "y_invert")
+		e = e.Clear(BufferParamsFlagsYInvert)
+	}
+	if e.Has(BufferParamsFlagsInterlaced) {
+		flags = append(flags, "interlaced")
+		e = e.Clear(BufferParamsFlagsInterlaced)
+	}
+	if e.Has(BufferParamsFlagsBottomFirst) {
+		flags = append(flags, "bottom_first")
+		e = e.Clear(BufferParamsFlagsBottomFirst)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
+}
+
+// BufferParamsCreatedEvent : buffer creation succeeded
+//
+// This event indicates that the attempted buffer creation was
+// successful. It provides the new wl_buffer referencing the dmabuf(s).
+//
+// Upon receiving this event, the client should destroy the
+// zwp_linux_buffer_params_v1 object.
+type BufferParamsCreatedEvent struct {
+	Buffer *client.Buffer
+}
+
+// BufferParamsCreatedSinceVersion : version of BufferParams that introduced BufferParamsCreatedEvent
+const BufferParamsCreatedSinceVersion = 1
+
+type BufferParamsCreatedHandlerFunc func(BufferParamsCreatedEvent)
+
+// SetCreatedHandler : sets handler for BufferParamsCreatedEvent
+func (i *BufferParams) SetCreatedHandler(f BufferParamsCreatedHandlerFunc) {
+	i.createdHandler = f
+}
+
+// BufferParamsFailedEvent : buffer creation failed
+//
+// This event indicates that the attempted buffer creation has
+// failed. It usually means that one of the dmabuf constraints
+// has not been fulfilled.
+//
+// Upon receiving this event, the client should destroy the
+// zwp_linux_buffer_params_v1 object.
+type BufferParamsFailedEvent struct{}
+
+// BufferParamsFailedSinceVersion : version of BufferParams that introduced BufferParamsFailedEvent
+const BufferParamsFailedSinceVersion = 1
+
+type BufferParamsFailedHandlerFunc func(BufferParamsFailedEvent)
+
+// SetFailedHandler : sets handler for BufferParamsFailedEvent
+func (i *BufferParams) SetFailedHandler(f BufferParamsFailedHandlerFunc) {
+	i.failedHandler = f
+}
+
+func (i *BufferParams) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		var e BufferParamsCreatedEvent
+		l := 0
+		buffer := &client.Buffer{}
+		i.Context().SetProxy(client.Uint32(data[l:l+4]), buffer)
+		buffer.SetVersion(i.Version())
+		e.Buffer = buffer
+		l += 4
+		if i.createdHandler == nil {
+			return
+		}
+
+		i.createdHandler(e)
+	case 1:
+		if i.failedHandler == nil {
+			return
+		}
+		var e BufferParamsFailedEvent
+
+		i.failedHandler(e)
+	}
+}
+
+// DmabufFeedbackName : dmabuf feedback
+const DmabufFeedbackName = "zwp_linux_dmabuf_feedback_v1"
+
+// DmabufFeedbackInterface : metadata of the zwp_linux_dmabuf_feedback_v1 interface
+var DmabufFeedbackInterface = &client.Interface{
+	Name:    DmabufFeedbackName,
+	Version: 5,
+	New:     func(ctx *client.Context) client.Proxy { return NewDmabufFeedback(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+	},
+	Events: []client.Message{
+		{
+			Name:  "done",
+			Since: 1,
+		},
+		{
+			Name:  "format_table",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "fd", Type: client.ArgTypeFd},
+				{Name: "size", Type: client.ArgTypeUint},
+			},
+		},
+		{
+			Name:  "main_device",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "device", Type: client.ArgTypeArray},
+			},
+		},
+		{
+			Name:  "tranche_done",
+			Since: 1,
+		},
+		{
+			Name:  "tranche_target_device",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "device", Type: client.ArgTypeArray},
+			},
+		},
+		{
+			Name:  "tranche_formats",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "indices", Type: client.ArgTypeArray},
+			},
+		},
+		{
+			Name:  "tranche_flags",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "flags", Type: client.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns DmabufFeedbackInterface
+func (i *DmabufFeedback) Interface() *client.Interface {
+	return DmabufFeedbackInterface
+}
+
+// DmabufFeedback : dmabuf feedback
+//
+// This object advertises dmabuf parameters feedback. This includes the
+// preferred devices and the supported formats/modifiers.
+//
+// The parameters are sent once when this object is created and whenever they
+// change. The done event is always sent once after all parameters have been
+// sent. When a single parameter changes, all parameters are re-sent by the
+// compositor.
+//
+// Compositors can re-send the parameters when the current client buffer
+// allocations are sub-optimal. Compositors should not re-send the
+// parameters if re-allocating the buffers would not result in a more optimal
+// configuration. In particular, compositors should avoid sending the exact
+// same parameters multiple times in a row.
+//
+// The tranche_target_device and tranche_formats events are grouped by
+// tranches of preference. For each tranche, a tranche_target_device, one
+// tranche_flags and one or more tranche_formats events are sent, followed
+// by a tranche_done event finishing the list. The tranches are sent in
+// descending order of preference. All formats and modifiers in the same
+// tranche have the same preference.
+//
+// To send parameters, the compositor sends one main_device event, tranches
+// (each consisting of one tranche_target_device event, one tranche_flags
+// event, tranche_formats events and then a tranche_done event), then one
+// done event.
+type DmabufFeedback struct {
+	client.BaseProxy
+	doneHandler                DmabufFeedbackDoneHandlerFunc
+	formatTableHandler         DmabufFeedbackFormatTableHandlerFunc
+	mainDeviceHandler          DmabufFeedbackMainDeviceHandlerFunc
+	trancheDoneHandler         DmabufFeedbackTrancheDoneHandlerFunc
+	trancheTargetDeviceHandler DmabufFeedbackTrancheTargetDeviceHandlerFunc
+	trancheFormatsHandler      DmabufFeedbackTrancheFormatsHandlerFunc
+	trancheFlagsHandler        DmabufFeedbackTrancheFlagsHandlerFunc
+}
+
+// NewDmabufFeedback : dmabuf feedback
+//
+// This object advertises dmabuf parameters feedback. This includes the
+// preferred devices and the supported formats/modifiers.
+//
+// The parameters are sent once when this object is created and whenever they
+// change. The done event is always sent once after all parameters have been
+// sent. When a single parameter changes, all parameters are re-sent by the
+// compositor.
+//
+// Compositors can re-send the parameters when the current client buffer
+// allocations are sub-optimal. Compositors should not re-send the
+// parameters if re-allocating the buffers would not result in a more optimal
+// configuration. In particular, compositors should avoid sending the exact
+// same parameters multiple times in a row.
+//
+// The tranche_target_device and tranche_formats events are grouped by
+// tranches of preference. For each tranche, a tranche_target_device, one
+// tranche_flags and one or more tranche_formats events are sent, followed
+// by a tranche_done event finishing the list. The tranches are sent in
+// descending order of preference. All formats and modifiers in the same
+// tranche have the same preference.
+//
+// To send parameters, the compositor sends one main_device event, tranches
+// (each consisting of one tranche_target_device event, one tranche_flags
+// event, tranche_formats events and then a tranche_done event), then one
+// done event.
+func NewDmabufFeedback(ctx *client.Context) *DmabufFeedback {
+	zwpLinuxDmabufFeedbackV1 := &DmabufFeedback{}
+	ctx.Register(zwpLinuxDmabufFeedbackV1)
+	return zwpLinuxDmabufFeedbackV1
+}
+
+// DmabufFeedbackDestroySinceVersion : version of DmabufFeedback that introduced Destroy
+const DmabufFeedbackDestroySinceVersion = 1
+
+// Destroy : destroy the feedback object
+//
+// Using this request a client can tell the server that it is not going to
+// use the wp_linux_dmabuf_feedback object anymore.
+func (i *DmabufFeedback) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type DmabufFeedbackTrancheFlags uint32
+
+// DmabufFeedbackTrancheFlags :
+const (
+	// DmabufFeedbackTrancheFlagsScanout : direct scan-out tranche
+	DmabufFeedbackTrancheFlagsScanout DmabufFeedbackTrancheFlags = 1
+)
+
+func (e DmabufFeedbackTrancheFlags) Name() string {
+	switch e {
+	case DmabufFeedbackTrancheFlagsScanout:
+		return "scanout"
+	default:
+		return ""
+	}
+}
+
+func (e DmabufFeedbackTrancheFlags) Value() string {
+	switch e {
+	case DmabufFeedbackTrancheFlagsScanout:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+// Has : reports whether all flags of f are set in e, f of 0 is never
+// set
+func (e DmabufFeedbackTrancheFlags) Has(f DmabufFeedbackTrancheFlags) bool {
+	return f != 0 && e&f == f
+}
+
+// Set : returns e with the flags of f set
+func (e DmabufFeedbackTrancheFlags) Set(f DmabufFeedbackTrancheFlags) DmabufFeedbackTrancheFlags {
+	return e | f
+}
+
+// Clear : returns e with the flags of f cleared
+func (e DmabufFeedbackTrancheFlags) Clear(f DmabufFeedbackTrancheFlags) DmabufFeedbackTrancheFlags {
+	return e &^ f
+}
+
+func (e DmabufFeedbackTrancheFlags) String() string {
+	if e == 0 {
+		return "0"
+	}
+	var flags []string
+	if e.Has(DmabufFeedbackTrancheFlagsScanout) {
+		flags = append(flags, "scanout")
+		e = e.Clear(DmabufFeedbackTrancheFlagsScanout)
+	}
+	if e != 0 {
+		flags = append(flags, "0x"+strconv.FormatUint(uint64(e), 16))
+	}
+	return strings.Join(flags, "|")
+}
+
+// DmabufFeedbackDoneEvent : all feedback has been sent
+//
+// This event is sent after all parameters of a wp_linux_dmabuf_feedback
+// object have been sent.
+//
+// This allows changes to the wp_linux_dmabuf_feedback parameters to be
+// seen as atomic, even if they happen via multiple events.
+type DmabufFeedbackDoneEvent struct{}
+
+// DmabufFeedbackDoneSinceVersion : version of DmabufFeedback that introduced DmabufFeedbackDoneEvent
+const DmabufFeedbackDoneSinceVersion = 1
+
+type DmabufFeedbackDoneHandlerFunc func(DmabufFeedbackDoneEvent)
+
+// SetDoneHandler : sets handler for DmabufFeedbackDoneEvent
+func (i *DmabufFeedback) SetDoneHandler(f DmabufFeedbackDoneHandlerFunc) {
+	i.doneHandler = f
+}
+
+// DmabufFeedbackFormatTableEvent : format and modifier table
+//
+// This event provides a file descriptor which can be memory-mapped to
+// access the format and modifier table.
+//
+// The table contains a tightly packed array of consecutive format +
+// modifier pairs. Each pair is 16 bytes wide. It contains a format as a
+// 32-bit unsigned integer, followed by 4 bytes of unused padding, and a
+// modifier as a 64-bit unsigned integer. The native endianness is used.
+//
+// The client must map the file descriptor in read-only private mode.
+//
+// Compositors are not allowed to mutate the table file contents once this
+// event has been sent. Instead, compositors must create a new, separate
+// table file and re-send feedback parameters. Compositors are allowed to
+// store duplicate format + modifier pairs in the table.
+type DmabufFeedbackFormatTableEvent struct {
+	Fd   int
+	Size uint32
+}
+
+// DmabufFeedbackFormatTableSinceVersion : version of DmabufFeedback that introduced DmabufFeedbackFormatTableEvent
+const DmabufFeedbackFormatTableSinceVersion = 1
+
+type DmabufFeedbackFormatTableHandlerFunc func(DmabufFeedbackFormatTableEvent)
+
+// SetFormatTableHandler : sets handler for DmabufFeedbackFormatTableEvent
+func (i *DmabufFeedback) SetFormatTableHandler(f DmabufFeedbackFormatTableHandlerFunc) {
+	i.formatTableHandler = f
+}
+
+// DmabufFeedbackMainDeviceEvent : preferred main device
+//
+// This event advertises the main device that the server prefers to use
+// when direct scan-out to the target device isn't possible. The
+// advertised main device may be different for each
+// wp_linux_dmabuf_feedback object, and may change over time.
+//
+// There is exactly one main device. The compositor must send at least
+// one preference tranche with tranche_target_device equal to main_device.
+//
+// Clients need to create buffers that the main device can import and
+// read from, otherwise creating the dmabuf wl_buffer will fail (see the
+// wp_linux_buffer_params.create and create_immed requests for details).
+// The main device will also likely be kept active by the compositor,
+// so clients can use it instead of waking up another device for power
+// savings.
+//
+// In general the device is a DRM node. The DRM node type (primary vs.
+// render) is unspecified. Clients must not rely on the compositor sending
+// a particular node type. Clients cannot check two devices for equality
+// by comparing the dev_t value.
+//
+// If explicit modifiers are not supported and the client performs buffer
+// allocations on a different device than the main device, then the client
+// must force the buffer to have a linear layout.
+type DmabufFeedbackMainDeviceEvent struct {
+	Device []byte
+}
+
+// DmabufFeedbackMainDeviceSinceVersion : version of DmabufFeedback that introduced DmabufFeedbackMainDeviceEvent
+const DmabufFeedbackMainDeviceSinceVersion = 1
+
+type DmabufFeedbackMainDeviceHandlerFunc func(DmabufFeedbackMainDeviceEvent)
+
+// SetMainDeviceHandler : sets handler for DmabufFeedbackMainDeviceEvent
+func (i *DmabufFeedback) SetMainDeviceHandler(f DmabufFeedbackMainDeviceHandlerFunc) {
+	i.mainDeviceHandler = f
+}
+
+// DmabufFeedbackTrancheDoneEvent : a preference tranche has been sent
+//
+// This event splits tranche_target_device and tranche_formats events in
+// preference tranches. It is sent after a set of tranche_target_device
+// and tranche_formats events; it represents the end of a tranche. The
+// next tranche will have a lower preference.
+type DmabufFeedbackTrancheDoneEvent struct{}
+
+// DmabufFeedbackTrancheDoneSinceVersion : version of DmabufFeedback that introduced DmabufFeedbackTrancheDoneEvent
+const DmabufFeedbackTrancheDoneSinceVersion = 1
+
+type DmabufFeedbackTrancheDoneHandlerFunc func(DmabufFeedbackTrancheDoneEvent)
+
+// SetTrancheDoneHandler : sets handler for DmabufFeedbackTrancheDoneEvent
+func (i *DmabufFeedback) SetTrancheDoneHandler(f DmabufFeedbackTrancheDoneHandlerFunc) {
+	i.trancheDoneHandler = f
+}
+
+// DmabufFeedbackTrancheTargetDeviceEvent : target device
+//
+// This event advertises the target device that the server prefers to use
+// for a buffer created given this tranche. The advertised target device
+// may be different for each preference tranche, and may change over time.
+//
+// There is exactly one target device per tranche.
+//
+// The target device may be a scan-out device, for example if the
+// compositor prefers to directly scan-out a buffer created given this
+// tranche. The target device may be a rendering device, for example if
+// the compositor prefers to texture from said buffer.
+//
+// The client can use this hint to allocate the buffer in a way that makes
+// it accessible from the target device, ideally directly. The buffer must
+// still be accessible from the main device, either through direct import
+// or through a potentially more expensive fallback path. If the buffer
+// can't be directly imported from the main device then clients must be
+// prepared for the compositor changing the tranche priority or making
+// wl_buffer creation fail (see the wp_linux_buffer_params.create and
+// create_immed requests for details).
+//
+// If the device is a DRM node, the DRM node type (primary vs. render) is
+// unspecified. Clients must not rely on the compositor sending a
+// particular node type. Clients cannot check two devices for equality by
+// comparing the dev_t value.
+//
+// This event is tied to a preference tranche, see the tranche_done event.
+type DmabufFeedbackTrancheTargetDeviceEvent struct {
+	Device []byte
+}
+
+// DmabufFeedbackTrancheTargetDeviceSinceVersion : version of DmabufFeedback that introduced DmabufFeedbackTrancheTargetDeviceEvent
+const DmabufFeedbackTrancheTargetDeviceSinceVersion = 1
+
+type DmabufFeedbackTrancheTargetDeviceHandlerFunc func(DmabufFeedbackTrancheTargetDeviceEvent)
+
+// SetTrancheTargetDeviceHandler : sets handler for DmabufFeedbackTrancheTargetDeviceEvent
+func (i *DmabufFeedback) SetTrancheTargetDeviceHandler(f DmabufFeedbackTrancheTargetDeviceHandlerFunc) {
+	i.trancheTargetDeviceHandler = f
+}
+
+// DmabufFeedbackTrancheFormatsEvent : supported buffer format modifier
+//
+// This event advertises the format + modifier combinations that the
+// compositor supports.
+//
+// It carries an array of indices, each referring to a format + modifier
+// pair in the last received format table (see the format_table event).
+// Each index is a 16-bit unsigned integer in native endianness.
+//
+// For legacy support, DRM_FORMAT_MOD_INVALID is an allowed modifier.
+// It indicates that the server can support the format with an implicit
+// modifier. When a buffer has DRM_FORMAT_MOD_INVALID as its modifier, it
+// is as if no explicit modifier is specified. The effective modifier
+// will be derived from the dmabuf.
+//
+// A compositor that sends valid modifiers and DRM_FORMAT_MOD_INVALID for
+// a given format supports both explicit modifiers and implicit modifiers.
+//
+// Compositors must not send duplicate format + modifier pairs within the
+// same tranche or across two different tranches with the same target
+// device and flags.
+//
+// This event is tied to a preference tranche, see the tranche_done event.
+//
+// For the definition of the format and modifier codes, see the
+// wp_linux_buffer_params.create request.
+type DmabufFeedbackTrancheFormatsEvent struct {
+	Indices []byte
+}
+
+// DmabufFeedbackTrancheFormatsSinceVersion : version of DmabufFeedback that introduced DmabufFeedbackTrancheFormatsEvent
+const DmabufFeedbackTrancheFormatsSinceVersion = 1
+
+type DmabufFeedbackTrancheFormatsHandlerFunc func(DmabufFeedbackTrancheFormatsEvent)
+
+// SetTrancheFormatsHandler : sets handler for DmabufFeedbackTrancheFormatsEvent
+func (i *DmabufFeedback) SetTrancheFormatsHandler(f DmabufFeedbackTrancheFormatsHandlerFunc) {
+	i.trancheFormatsHandler = f
+}
+
+// DmabufFeedbackTrancheFlagsEvent : tranche flags
+//
+// This event sets tranche-specific flags.
+//
+// The scanout flag is a hint that direct scan-out may be attempted by the
+// compositor on the target device if the client appropriately allocates a
+// buffer. How to allocate a buffer that can be scanned out on the target
+// device is implementation-defined.
+//
+// This event is tied to a preference tranche, see the tranche_done event.
+type DmabufFeedbackTrancheFlagsEvent struct {
+	Flags DmabufFeedbackTrancheFlags
+}
+
+// DmabufFeedbackTrancheFlagsSinceVersion : version of DmabufFeedback that introduced DmabufFeedbackTrancheFlagsEvent
+const DmabufFeedbackTrancheFlagsSinceVersion = 1
+
+type DmabufFeedbackTrancheFlagsHandlerFunc func(DmabufFeedbackTrancheFlagsEvent)
+
+// SetTrancheFlagsHandler : sets handler for DmabufFeedbackTrancheFlagsEvent
+func (i *DmabufFeedback) SetTrancheFlagsHandler(f DmabufFeedbackTrancheFlagsHandlerFunc) {
+	i.trancheFlagsHandler = f
+}
+
+func (i *DmabufFeedback) Dispatch(opcode uint32, fd int, data []byte) {
+	switch opcode {
+	case 0:
+		if i.doneHandler == nil {
+			return
+		}
+		var e DmabufFeedbackDoneEvent
+
+		i.doneHandler(e)
+	case 1:
+		if i.formatTableHandler == nil {
+			if fd != -1 {
+				unix.Close(fd)
+			}
+			return
+		}
+		var e DmabufFeedbackFormatTableEvent
+		l := 0
+		e.Fd = fd
+		e.Size = client.Uint32(data[l : l+4])
+		l += 4
+
+		i.formatTableHandler(e)
+	case 2:
+		if i.mainDeviceHandler == nil {
+			return
+		}
+		var e DmabufFeedbackMainDeviceEvent
+		l := 0
+		deviceLen := int(client.Uint32(data[l : l+4]))
+		l += 4
+		e.Device = make([]byte, deviceLen)
+		copy(e.Device, data[l:l+deviceLen])
+		l += deviceLen
+
+		i.mainDeviceHandler(e)
+	case 3:
+		if i.trancheDoneHandler == nil {
+			return
+		}
+		var e DmabufFeedbackTrancheDoneEvent
+
+		i.trancheDoneHandler(e)
+	case 4:
+		if i.trancheTargetDeviceHandler == nil {
+			return
+		}
+		var e DmabufFeedbackTrancheTargetDeviceEvent
+		l := 0
+		deviceLen := int(client.Uint32(data[l : l+4]))
+		l += 4
+		e.Device = make([]byte, deviceLen)
+		copy(e.Device, data[l:l+deviceLen])
+		l += deviceLen
+
+		i.trancheTargetDeviceHandler(e)
+	case 5:
+		if i.trancheFormatsHandler == nil {
+			return
+		}
+		var e DmabufFeedbackTrancheFormatsEvent
+		l := 0
+		indicesLen := int(client.Uint32(data[l : l+4]))
+		l += 4
+		e.Indices = make([]byte, indicesLen)
+		copy(e.Indices, data[l:l+indicesLen])
+		l += indicesLen
+
+		i.trancheFormatsHandler(e)
+	case 6:
+		if i.trancheFlagsHandler == nil {
+			return
+		}
+		var e DmabufFeedbackTrancheFlagsEvent
+		l := 0
+		e.Flags = DmabufFeedbackTrancheFlags(client.Uint32(data[l : l+4]))
+		l += 4
+
+		i.trancheFlagsHandler(e)
+	}
+}
+
+func init() {
+	client.RegisterInterface(DmabufInterface)
+	client.RegisterInterface(BufferParamsInterface)
+	client.RegisterInterface(DmabufFeedbackInterface)
+}
@@ -0,0 +1,3 @@
+// Package presentation_time is Go binding of the stable presentation-time
+// protocol, reporting when surface contents were presented.
+package presentation_time
@@ -0,0 +1,3 @@
+// Package tablet is Go binding of the stable tablet-v2 protocol, giving
+// access to graphics tablets, their tools and pads.
+package tablet
@@ -0,0 +1,3 @@
+// Package viewporter is Go binding of the stable viewporter protocol,
+// used to crop and scale surface contents.
+package viewporter
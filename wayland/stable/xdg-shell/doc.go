@@ -0,0 +1,3 @@
+// Package xdg_shell is Go binding of the stable xdg-shell protocol,
+// turning wl_surfaces into desktop-style windows and popups.
+package xdg_shell
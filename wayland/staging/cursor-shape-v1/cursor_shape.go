@@ -0,0 +1,554 @@
+// Generated by go-wayland-scanner
+// https://github.com/hempflower/go-wayland/cmd/go-wayland-scanner
+// Scanner version : 0.2.1
+// XML file : staging/cursor-shape/cursor-shape-v1.xml
+// XML sha256 : e1f7f0aa3953984b43dd595e441048b225d7335eca62fc88bced5154e2a09536
+//
+// cursor_shape_v1 Protocol Copyright:
+//
+// Copyright 2018 The Chromium Authors
+// Copyright 2023 Simon Ser
+//
+// Permission is hereby granted, free of charge, to any person obtaining a
+// copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+// The above copyright notice and this permission notice (including the next
+// paragraph) shall be included in all copies or substantial portions of the
+// Software.
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.  IN NO EVENT SHALL
+// THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package cursor_shape
+
+import (
+	"github.com/hempflower/go-wayland/wayland/client"
+	tablet "github.com/hempflower/go-wayland/wayland/stable/tablet-v2"
+)
+
+// ManagerName : cursor shape manager
+const ManagerName = "wp_cursor_shape_manager_v1"
+
+// ManagerInterface : metadata of the wp_cursor_shape_manager_v1 interface
+var ManagerInterface = &client.Interface{
+	Name:    ManagerName,
+	Version: 1,
+	New:     func(ctx *client.Context) client.Proxy { return NewManager(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "get_pointer",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "cursor_shape_device", Type: client.ArgTypeNewID, Interface: "wp_cursor_shape_device_v1"},
+				{Name: "pointer", Type: client.ArgTypeObject, Interface: "wl_pointer"},
+			},
+		},
+		{
+			Name:  "get_tablet_tool_v2",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "cursor_shape_device", Type: client.ArgTypeNewID, Interface: "wp_cursor_shape_device_v1"},
+				{Name: "tablet_tool", Type: client.ArgTypeObject, Interface: "zwp_tablet_tool_v2"},
+			},
+		},
+	},
+}
+
+// Interface : returns ManagerInterface
+func (i *Manager) Interface() *client.Interface {
+	return ManagerInterface
+}
+
+// Manager : cursor shape manager
+//
+// This global offers an alternative, optional way to set cursor images. This
+// new way uses enumerated cursors instead of a wl_surface like
+// wl_pointer.set_cursor does.
+//
+// Warning! The protocol described in this file is currently in the testing
+// phase. Backward compatible changes may be added together with the
+// corresponding interface version bump. Backward incompatible changes can
+// only be done by creating a new major version of the extension.
+type Manager struct {
+	client.BaseProxy
+}
+
+// NewManager : cursor shape manager
+//
+// This global offers an alternative, optional way to set cursor images. This
+// new way uses enumerated cursors instead of a wl_surface like
+// wl_pointer.set_cursor does.
+//
+// Warning! The protocol described in this file is currently in the testing
+// phase. Backward compatible changes may be added together with the
+// corresponding interface version bump. Backward incompatible changes can
+// only be done by creating a new major version of the extension.
+func NewManager(ctx *client.Context) *Manager {
+	wpCursorShapeManagerV1 := &Manager{}
+	ctx.Register(wpCursorShapeManagerV1)
+	return wpCursorShapeManagerV1
+}
+
+// ManagerDestroySinceVersion : version of Manager that introduced Destroy
+const ManagerDestroySinceVersion = 1
+
+// Destroy : destroy the manager
+//
+// Destroy the cursor shape manager.
+func (i *Manager) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// ManagerGetPointerSinceVersion : version of Manager that introduced GetPointer
+const ManagerGetPointerSinceVersion = 1
+
+// GetPointer : manage the cursor shape of a pointer device
+//
+// Obtain a wp_cursor_shape_device_v1 for a wl_pointer object.
+//
+// When the pointer capability is removed from the wl_seat, the
+// wp_cursor_shape_device_v1 object becomes inert.
+func (i *Manager) GetPointer(pointer *client.Pointer) (*Device, error) {
+	cursorShapeDevice := NewDevice(i.Context())
+	cursorShapeDevice.SetVersion(i.Version())
+	const opcode = 1
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], cursorShapeDevice.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], pointer.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return cursorShapeDevice, err
+}
+
+// ManagerGetTabletToolV2SinceVersion : version of Manager that introduced GetTabletToolV2
+const ManagerGetTabletToolV2SinceVersion = 1
+
+// GetTabletToolV2 : manage the cursor shape of a tablet tool device
+//
+// Obtain a wp_cursor_shape_device_v1 for a zwp_tablet_tool_v2 object.
+//
+// When the zwp_tablet_tool_v2 is removed, the wp_cursor_shape_device_v1
+// object becomes inert.
+func (i *Manager) GetTabletToolV2(tabletTool *tablet.TabletTool) (*Device, error) {
+	cursorShapeDevice := NewDevice(i.Context())
+	cursorShapeDevice.SetVersion(i.Version())
+	const opcode = 2
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], cursorShapeDevice.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], tabletTool.ID())
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return cursorShapeDevice, err
+}
+
+// DeviceName : cursor shape for a device
+const DeviceName = "wp_cursor_shape_device_v1"
+
+// DeviceInterface : metadata of the wp_cursor_shape_device_v1 interface
+var DeviceInterface = &client.Interface{
+	Name:    DeviceName,
+	Version: 1,
+	New:     func(ctx *client.Context) client.Proxy { return NewDevice(ctx) },
+	Requests: []client.Message{
+		{
+			Name:  "destroy",
+			Since: 1,
+		},
+		{
+			Name:  "set_shape",
+			Since: 1,
+			Args: []client.Arg{
+				{Name: "serial", Type: client.ArgTypeUint},
+				{Name: "shape", Type: client.ArgTypeUint},
+			},
+		},
+	},
+}
+
+// Interface : returns DeviceInterface
+func (i *Device) Interface() *client.Interface {
+	return DeviceInterface
+}
+
+// Device : cursor shape for a device
+//
+// This interface allows clients to set the cursor shape.
+type Device struct {
+	client.BaseProxy
+}
+
+// NewDevice : cursor shape for a device
+//
+// This interface allows clients to set the cursor shape.
+func NewDevice(ctx *client.Context) *Device {
+	wpCursorShapeDeviceV1 := &Device{}
+	ctx.Register(wpCursorShapeDeviceV1)
+	return wpCursorShapeDeviceV1
+}
+
+// DeviceDestroySinceVersion : version of Device that introduced Destroy
+const DeviceDestroySinceVersion = 1
+
+// Destroy : destroy the cursor shape device
+//
+// Destroy the cursor shape device.
+//
+// The device cursor shape remains unchanged.
+func (i *Device) Destroy() error {
+	defer i.Context().Unregister(i)
+	const opcode = 0
+	const _reqBufLen = 8
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+// DeviceSetShapeSinceVersion : version of Device that introduced SetShape
+const DeviceSetShapeSinceVersion = 1
+
+// SetShape : set device cursor to the shape
+//
+// Sets the device cursor to the specified shape. The compositor will
+// change the cursor image based on the specified shape.
+//
+// The cursor actually changes only if the input device focus is one of
+// the requesting client's surfaces. If any, the previous cursor image
+// (surface or shape) is replaced.
+//
+// The "shape" argument must be a valid enum entry, otherwise the
+// invalid_shape protocol error is raised.
+//
+// This is similar to the wl_pointer.set_cursor and
+// zwp_tablet_tool_v2.set_cursor requests, but this request accepts a
+// shape instead of contents in the form of a surface. Clients can mix
+// set_cursor and set_shape requests.
+//
+// The serial parameter must match the latest wl_pointer.enter or
+// zwp_tablet_tool_v2.proximity_in serial number sent to the client.
+// Otherwise the request will be ignored.
+//
+//	serial: serial number of the enter event
+func (i *Device) SetShape(serial uint32, shape DeviceShape) error {
+	const opcode = 1
+	const _reqBufLen = 8 + 4 + 4
+	var _reqBuf [_reqBufLen]byte
+	l := 0
+	client.PutUint32(_reqBuf[l:4], i.ID())
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(_reqBufLen<<16|opcode&0x0000ffff))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(serial))
+	l += 4
+	client.PutUint32(_reqBuf[l:l+4], uint32(shape))
+	l += 4
+	err := i.Context().WriteMsg(_reqBuf[:], nil)
+	return err
+}
+
+type DeviceShape uint32
+
+// DeviceShape : cursor shapes
+//
+// This enum describes cursor shapes.
+//
+// The names are taken from the CSS W3C specification:
+// https://w3c.github.io/csswg-drafts/css-ui/#cursor
+const (
+	// DeviceShapeDefault : default cursor
+	DeviceShapeDefault DeviceShape = 1
+	// DeviceShapeContextMenu : a context menu is available for the object under the cursor
+	DeviceShapeContextMenu DeviceShape = 2
+	// DeviceShapeHelp : help is available for the object under the cursor
+	DeviceShapeHelp DeviceShape = 3
+	// DeviceShapePointer : pointer that indicates a link or another interactive element
+	DeviceShapePointer DeviceShape = 4
+	// DeviceShapeProgress : progress indicator
+	DeviceShapeProgress DeviceShape = 5
+	// DeviceShapeWait : program is busy, user should wait
+	DeviceShapeWait DeviceShape = 6
+	// DeviceShapeCell : a cell or set of cells may be selected
+	DeviceShapeCell DeviceShape = 7
+	// DeviceShapeCrosshair : simple crosshair
+	DeviceShapeCrosshair DeviceShape = 8
+	// DeviceShapeText : text may be selected
+	DeviceShapeText DeviceShape = 9
+	// DeviceShapeVerticalText : vertical text may be selected
+	DeviceShapeVerticalText DeviceShape = 10
+	// DeviceShapeAlias : drag-and-drop: alias of/shortcut to something is to be created
+	DeviceShapeAlias DeviceShape = 11
+	// DeviceShapeCopy : drag-and-drop: something is to be copied
+	DeviceShapeCopy DeviceShape = 12
+	// DeviceShapeMove : drag-and-drop: something is to be moved
+	DeviceShapeMove DeviceShape = 13
+	// DeviceShapeNoDrop : drag-and-drop: the dragged item cannot be dropped at the current cursor location
+	DeviceShapeNoDrop DeviceShape = 14
+	// DeviceShapeNotAllowed : drag-and-drop: the requested action will not be carried out
+	DeviceShapeNotAllowed DeviceShape = 15
+	// DeviceShapeGrab : drag-and-drop: something can be grabbed
+	DeviceShapeGrab DeviceShape = 16
+	// DeviceShapeGrabbing : drag-and-drop: something is being grabbed
+	DeviceShapeGrabbing DeviceShape = 17
+	// DeviceShapeEResize : resizing: the east border is to be moved
+	DeviceShapeEResize DeviceShape = 18
+	// DeviceShapeNResize : resizing: the north border is to be moved
+	DeviceShapeNResize DeviceShape = 19
+	// DeviceShapeNeResize : resizing: the north-east corner is to be moved
+	DeviceShapeNeResize DeviceShape = 20
+	// DeviceShapeNwResize : resizing: the north-west corner is to be moved
+	DeviceShapeNwResize DeviceShape = 21
+	// DeviceShapeSResize : resizing: the south border is to be moved
+	DeviceShapeSResize DeviceShape = 22
+	// DeviceShapeSeResize : resizing: the south-east corner is to be moved
+	DeviceShapeSeResize DeviceShape = 23
+	// DeviceShapeSwResize : resizing: the south-west corner is to be moved
+	DeviceShapeSwResize DeviceShape = 24
+	// DeviceShapeWResize : resizing: the west border is to be moved
+	DeviceShapeWResize DeviceShape = 25
+	// DeviceShapeEwResize : resizing: the east and west borders are to be moved
+	DeviceShapeEwResize DeviceShape = 26
+	// DeviceShapeNsResize : resizing: the north and south borders are to be moved
+	DeviceShapeNsResize DeviceShape = 27
+	// DeviceShapeNeswResize : resizing: the north-east and south-west corners are to be moved
+	DeviceShapeNeswResize DeviceShape = 28
+	// DeviceShapeNwseResize : resizing: the north-west and south-east corners are to be moved
+	DeviceShapeNwseResize DeviceShape = 29
+	// DeviceShapeColResize : resizing: that the item/column can be resized horizontally
+	DeviceShapeColResize DeviceShape = 30
+	// DeviceShapeRowResize : resizing: that the item/row can be resized vertically
+	DeviceShapeRowResize DeviceShape = 31
+	// DeviceShapeAllScroll : something can be scrolled in any direction
+	DeviceShapeAllScroll DeviceShape = 32
+	// DeviceShapeZoomIn : something can be zoomed in
+	DeviceShapeZoomIn DeviceShape = 33
+	// DeviceShapeZoomOut : something can be zoomed out
+	DeviceShapeZoomOut DeviceShape = 34
+)
+
+func (e DeviceShape) Name() string {
+	switch e {
+	case DeviceShapeDefault:
+		return "default"
+	case DeviceShapeContextMenu:
+		return "context_menu"
+	case DeviceShapeHelp:
+		return "help"
+	case DeviceShapePointer:
+		return "pointer"
+	case DeviceShapeProgress:
+		return "progress"
+	case DeviceShapeWait:
+		return "wait"
+	case DeviceShapeCell:
+		return "cell"
+	case DeviceShapeCrosshair:
+		return "crosshair"
+	case DeviceShapeText:
+		return "text"
+	case DeviceShapeVerticalText:
+		return "vertical_text"
+	case DeviceShapeAlias:
+		return "alias"
+	case DeviceShapeCopy:
+		return "copy"
+	case DeviceShapeMove:
+		return "move"
+	case DeviceShapeNoDrop:
+		return "no_drop"
+	case DeviceShapeNotAllowed:
+		return "not_allowed"
+	case DeviceShapeGrab:
+		return "grab"
+	case DeviceShapeGrabbing:
+		return "grabbing"
+	case DeviceShapeEResize:
+		return "e_resize"
+	case DeviceShapeNResize:
+		return "n_resize"
+	case DeviceShapeNeResize:
+		return "ne_resize"
+	case DeviceShapeNwResize:
+		return "nw_resize"
+	case DeviceShapeSResize:
+		return "s_resize"
+	case DeviceShapeSeResize:
+		return "se_resize"
+	case DeviceShapeSwResize:
+		return "sw_resize"
+	case DeviceShapeWResize:
+		return "w_resize"
+	case DeviceShapeEwResize:
+		return "ew_resize"
+	case DeviceShapeNsResize:
+		return "ns_resize"
+	case DeviceShapeNeswResize:
+		return "nesw_resize"
+	case DeviceShapeNwseResize:
+		return "nwse_resize"
+	case DeviceShapeColResize:
+		return "col_resize"
+	case DeviceShapeRowResize:
+		return "row_resize"
+	case DeviceShapeAllScroll:
+		return "all_scroll"
+	case DeviceShapeZoomIn:
+		return "zoom_in"
+	case DeviceShapeZoomOut:
+		return "zoom_out"
+	default:
+		return ""
+	}
+}
+
+func (e DeviceShape) Value() string {
+	switch e {
+	case DeviceShapeDefault:
+		return "1"
+	case DeviceShapeContextMenu:
+		return "2"
+	case DeviceShapeHelp:
+		return "3"
+	case DeviceShapePointer:
+		return "4"
+	case DeviceShapeProgress:
+		return "5"
+	case DeviceShapeWait:
+		return "6"
+	case DeviceShapeCell:
+		return "7"
+	case DeviceShapeCrosshair:
+		return "8"
+	case DeviceShapeText:
+		return "9"
+	case DeviceShapeVerticalText:
+		return "10"
+	case DeviceShapeAlias:
+		return "11"
+	case DeviceShapeCopy:
+		return "12"
+	case DeviceShapeMove:
+		return "13"
+	case DeviceShapeNoDrop:
+		return "14"
+	case DeviceShapeNotAllowed:
+		return "15"
+	case DeviceShapeGrab:
+		return "16"
+	case DeviceShapeGrabbing:
+		return "17"
+	case DeviceShapeEResize:
+		return "18"
+	case DeviceShapeNResize:
+		return "19"
+	case DeviceShapeNeResize:
+		return "20"
+	case DeviceShapeNwResize:
+		return "21"
+	case DeviceShapeSResize:
+		return "22"
+	case DeviceShapeSeResize:
+		return "23"
+	case DeviceShapeSwResize:
+		return "24"
+	case DeviceShapeWResize:
+		return "25"
+	case DeviceShapeEwResize:
+		return "26"
+	case DeviceShapeNsResize:
+		return "27"
+	case DeviceShapeNeswResize:
+		return "28"
+	case DeviceShapeNwseResize:
+		return "29"
+	case DeviceShapeColResize:
+		return "30"
+	case DeviceShapeRowResize:
+		return "31"
+	case DeviceShapeAllScroll:
+		return "32"
+	case DeviceShapeZoomIn:
+		return "33"
+	case DeviceShapeZoomOut:
+		return "34"
+	default:
+		return ""
+	}
+}
+
+func (e DeviceShape) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+type DeviceError uint32
+
+// DeviceError :
+const (
+	// DeviceErrorInvalidShape : the specified shape value is invalid
+	DeviceErrorInvalidShape DeviceError = 1
+)
+
+func (e DeviceError) Name() string {
+	switch e {
+	case DeviceErrorInvalidShape:
+		return "invalid_shape"
+	default:
+		return ""
+	}
+}
+
+func (e DeviceError) Value() string {
+	switch e {
+	case DeviceErrorInvalidShape:
+		return "1"
+	default:
+		return ""
+	}
+}
+
+func (e DeviceError) String() string {
+	return e.Name() + "=" + e.Value()
+}
+
+func init() {
+	client.RegisterInterface(ManagerInterface)
+	client.RegisterInterface(DeviceInterface)
+}
@@ -0,0 +1,7 @@
+// Package cursor_shape is Go binding of the staging cursor-shape-v1
+// protocol, used to set cursor images from a predefined set of shapes.
+//
+// Stability: staging. The protocol is in the testing phase of
+// wayland-protocols, backward incompatible changes require a new major
+// version of the extension.
+package cursor_shape
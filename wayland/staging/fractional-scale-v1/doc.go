@@ -0,0 +1,7 @@
+// Package fractional_scale is Go binding of the staging fractional-scale-v1
+// protocol, used to receive the preferred fractional scale of a surface.
+//
+// Stability: staging. The protocol is in the testing phase of
+// wayland-protocols, backward incompatible changes require a new major
+// version of the extension.
+package fractional_scale
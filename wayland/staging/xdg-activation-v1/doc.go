@@ -0,0 +1,7 @@
+// Package xdg_activation is Go binding of the staging xdg-activation-v1
+// protocol, used to pass focus between toplevels of different clients.
+//
+// Stability: staging. The protocol is in the testing phase of
+// wayland-protocols, backward incompatible changes require a new major
+// version of the extension.
+package xdg_activation
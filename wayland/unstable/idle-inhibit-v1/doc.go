@@ -0,0 +1,7 @@
+// Package idle_inhibit is Go binding of the unstable idle-inhibit-v1
+// protocol, used to prevent the display from idling while a surface is visible.
+//
+// Stability: unstable. The protocol is experimental, backward incompatible
+// changes are done by bumping the version in the interface names, which
+// will be reflected by a new package.
+package idle_inhibit
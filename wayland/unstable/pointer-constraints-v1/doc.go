@@ -0,0 +1,7 @@
+// Package pointer_constraints is Go binding of the unstable
+// pointer-constraints-v1 protocol, used to lock or confine the pointer.
+//
+// Stability: unstable. The protocol is experimental, backward incompatible
+// changes are done by bumping the version in the interface names, which
+// will be reflected by a new package.
+package pointer_constraints
@@ -0,0 +1,7 @@
+// Package relative_pointer is Go binding of the unstable relative-pointer-v1
+// protocol, used to receive unaccelerated relative pointer motion.
+//
+// Stability: unstable. The protocol is experimental, backward incompatible
+// changes are done by bumping the version in the interface names, which
+// will be reflected by a new package.
+package relative_pointer
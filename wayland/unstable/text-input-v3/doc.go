@@ -0,0 +1,7 @@
+// Package text_input is Go binding of the unstable text-input-v3 protocol,
+// used to compose text with an input method.
+//
+// Stability: unstable. The protocol is experimental, backward incompatible
+// changes are done by bumping the version in the interface names, which
+// will be reflected by a new package.
+package text_input
@@ -0,0 +1,7 @@
+// Package xdg_decoration is Go binding of the unstable xdg-decoration-v1
+// protocol, used to negotiate server-side window decorations.
+//
+// Stability: unstable. The protocol is experimental, backward incompatible
+// changes are done by bumping the version in the interface names, which
+// will be reflected by a new package.
+package xdg_decoration